@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Blob storage adapter selection for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/middleware"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewFileBlobStore creates a BlobPort storing each blob as a file under root.
+func NewFileBlobStore(root string) api.BlobPort {
+	return adapter.NewFileBlobStore(root)
+}
+
+// NewS3BlobStore creates a BlobPort for an S3-compatible bucket, addressed
+// path-style at endpoint (e.g. "https://s3.eu-west-1.amazonaws.com").
+func NewS3BlobStore(endpoint, region, bucket, accessKey, secretKey string) api.BlobPort {
+	return adapter.NewS3BlobStore(endpoint, region, bucket, accessKey, secretKey)
+}
+
+// NewEncryptedBlobStore wraps blobs so objects are envelope-encrypted at
+// rest: a fresh data key per object, wrapped by crypto.
+func NewEncryptedBlobStore(blobs api.BlobPort, crypto api.CryptoPort) api.BlobPort {
+	return adapter.NewEncryptedBlobStore(blobs, crypto)
+}
+
+// NewAESGCMCrypto creates a CryptoPort encrypting under keys[current]
+// (16, 24 or 32 bytes each); the other keys still decrypt after a rotation.
+func NewAESGCMCrypto(current string, keys map[string][]byte) *adapter.AESGCMCrypto {
+	return adapter.NewAESGCMCrypto(current, keys)
+}
+
+// NewCompressedBlobStore wraps blobs so objects are gzip-compressed at rest
+// and decompressed on read; objects stored uncompressed stay readable.
+func NewCompressedBlobStore(blobs api.BlobPort) api.BlobPort {
+	return adapter.NewCompressedBlobStore(blobs)
+}
+
+// NewHedgedBlobStore reads from replica as well when primary has not
+// answered within the given percentile of its recent latency (e.g. 0.95),
+// spending at most budget extra reads per read (e.g. 0.1). Writes go to
+// primary only.
+func NewHedgedBlobStore(primary, replica api.BlobPort, percentile, budget float64) api.BlobPort {
+	return middleware.NewHedgedBlobStore(primary, replica, percentile, budget)
+}
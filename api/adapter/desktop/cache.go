@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Cache adapter selection for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewMemoryCache creates a process-local cache.
+// This is the default cache for single-instance desktop apps.
+func NewMemoryCache() api.CachePort {
+	return adapter.NewMemoryCache()
+}
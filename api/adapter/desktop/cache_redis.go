@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Redis cache selection (requires the "redis" build tag)
+
+//go:build redis
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewRedisCache creates a cache backed by the Redis server at addr
+// ("host:port"). Available only when built with -tags redis.
+func NewRedisCache(addr string) api.CachePort {
+	return adapter.NewRedisCache(addr)
+}
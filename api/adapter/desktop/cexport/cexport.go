@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: main (cexport)
+// Description: C-ABI export of the greet facade for Ada and other C consumers
+
+//go:build cgo
+
+// Command cexport builds the library as a C shared/static library so the
+// Ada sibling project (or any C-ABI consumer) can call it directly.
+//
+// Build:
+//
+//	cd api/adapter/desktop
+//	go build -buildmode=c-shared -o libhybrid.so ./cexport   # also emits libhybrid.h
+//	go build -buildmode=c-archive -o libhybrid.a ./cexport
+//
+// Exported functions:
+//
+//	int Greet(const char *name);   /* returns a HYBRID_* code */
+//
+// Mapping to Ada:
+//   - Ada: function Greet (Name : chars_ptr) return int
+//     with Import, Convention => C, External_Name => "Greet";
+//   - Result codes map ErrorKind one-to-one (see errorCode)
+//
+// Ownership: the caller keeps ownership of name; it is copied before use.
+package main
+
+/*
+#define HYBRID_OK                    0
+#define HYBRID_VALIDATION_ERROR      1
+#define HYBRID_INFRASTRUCTURE_ERROR  2
+#define HYBRID_OVERLOADED_ERROR      3
+#define HYBRID_STALE_VERSION_ERROR   4
+#define HYBRID_NULL_ARGUMENT        -1
+*/
+import "C"
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/api/adapter/desktop"
+)
+
+// greeter is created once; the desktop Greeter is stateless and safe to share.
+var greeter = desktop.NewGreeter()
+
+// Greet greets name on the console and returns a HYBRID_* code.
+//
+//export Greet
+func Greet(name *C.char) C.int {
+	if name == nil {
+		return C.int(codeNullArgument)
+	}
+	result := greeter.Execute(context.Background(), api.NewGreetCommand(C.GoString(name)))
+	return C.int(errorCode(result))
+}
+
+// main is required by -buildmode=c-shared/c-archive and never runs.
+func main() {}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: main (cexport)
+// Description: Result to C return code mapping
+
+//go:build cgo
+
+package main
+
+import "github.com/abitofhelp/hybrid_lib_go/api"
+
+// Return codes shared with the C header (HYBRID_* defines in cexport.go).
+// Values are part of the ABI: never renumber, only append.
+const (
+	codeOK                  = 0
+	codeValidationError     = 1
+	codeInfrastructureError = 2
+	codeOverloadedError     = 3
+	codeStaleVersionError   = 4
+	codeNullArgument        = -1
+)
+
+// errorCode maps a Result to its C return code.
+func errorCode(result api.Result[api.Unit]) int {
+	if result.IsOk() {
+		return codeOK
+	}
+	switch result.ErrorInfo().Kind {
+	case api.ValidationError:
+		return codeValidationError
+	case api.OverloadedError:
+		return codeOverloadedError
+	case api.StaleVersionError:
+		return codeStaleVersionError
+	default:
+		return codeInfrastructureError
+	}
+}
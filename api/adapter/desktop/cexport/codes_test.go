@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+//go:build cgo
+
+package main
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestAPICExportErrorCode tests the Result to C return code mapping.
+func TestAPICExportErrorCode(t *testing.T) {
+	tf := test.New("API.CExport.ErrorCode")
+
+	tf.RunTest("Ok - HYBRID_OK", errorCode(api.Ok(api.Unit{})) == codeOK)
+	tf.RunTest("ValidationError - HYBRID_VALIDATION_ERROR",
+		errorCode(api.Err[api.Unit](api.ErrorType{Kind: api.ValidationError, Message: "bad"})) == codeValidationError)
+	tf.RunTest("InfrastructureError - HYBRID_INFRASTRUCTURE_ERROR",
+		errorCode(api.Err[api.Unit](api.ErrorType{Kind: api.InfrastructureError, Message: "io"})) == codeInfrastructureError)
+	tf.RunTest("OverloadedError - HYBRID_OVERLOADED_ERROR",
+		errorCode(api.Err[api.Unit](api.ErrorType{Kind: api.OverloadedError, Message: "busy"})) == codeOverloadedError)
+	tf.RunTest("StaleVersionError - HYBRID_STALE_VERSION_ERROR",
+		errorCode(api.Err[api.Unit](api.ErrorType{Kind: api.StaleVersionError, Message: "stale"})) == codeStaleVersionError)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Differential output wiring for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/middleware"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewMemoryChangeDetector creates a process-local change detector.
+func NewMemoryChangeDetector() api.ChangeDetectorPort {
+	return adapter.NewMemoryChangeDetector()
+}
+
+// NewChangeDetectingWriter wraps w so that a message identical to the last
+// one written under key is skipped; receipts of skipped writes have
+// Skipped set. Options set the clock stamping receipts synthesized for a w
+// without its own.
+func NewChangeDetectingWriter(
+	w api.WriterPort, detector api.ChangeDetectorPort, key string, opts ...Option,
+) api.ReceiptWriterPort {
+	return middleware.NewChangeDetectingWriter(w, detector, key, newSettings(opts).clock)
+}
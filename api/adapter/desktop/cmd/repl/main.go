@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: main (repl)
+// Description: Interactive greeter for demos and manual testing
+
+// Command repl runs the interactive REPL against the desktop greeter.
+//
+// Run:
+//
+//	cd api/adapter/desktop
+//	go run ./cmd/repl
+//	go run ./cmd/repl --version
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/abitofhelp/hybrid_lib_go/api/adapter/desktop"
+	"github.com/abitofhelp/hybrid_lib_go/api/adapter/repl"
+	"github.com/abitofhelp/hybrid_lib_go/version"
+)
+
+func main() {
+	showVersion := flag.Bool("version", false, "print build information and exit")
+	flag.Parse()
+	if *showVersion {
+		fmt.Println(version.Get())
+		return
+	}
+
+	// Ctrl-C keeps its default behaviour (exit) while the REPL waits for input.
+	result := repl.New(desktop.NewColorGreeter(), os.Stdin, os.Stdout).Run(context.Background())
+	if result.IsError() {
+		fmt.Fprintln(os.Stderr, result.ErrorInfo().Error())
+		os.Exit(1)
+	}
+}
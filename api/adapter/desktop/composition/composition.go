@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: composition
+// Description: Dependency graph of a composed application, as DOT or Mermaid
+
+// Package composition shows what an application actually assembled: Graph
+// walks a composed value (a desktop greeter, a decorator chain, the
+// application's own struct holding them) and returns its dependency graph
+// - use cases, decorators and adapters, linked by the fields wiring them.
+// The graph renders to Graphviz DOT or Mermaid, and Handler serves it as a
+// debug endpoint.
+//
+// The walk uses reflection and needs no registration: factories and
+// decorators are discovered through struct fields, interfaces, pointers,
+// slices and maps. A value reached twice through the same pointer is one
+// node. Standard library values (writers, clients, mutexes) are leaves
+// and not shown; other types of this module (models, configuration,
+// unexported helpers) are looked through.
+//
+// Usage:
+//
+//	greeter := desktop.NewOfflineGreeter(desktop.NewGreeter(), spool)
+//	fmt.Println(composition.Graph(greeter).Mermaid())
+//
+//	mux.Handle("/debug/composition", composition.Handler(app))
+package composition
+
+import (
+	"cmp"
+	"fmt"
+	"go/token"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// modulePrefix is the import path prefix of this module's packages.
+const modulePrefix = "github.com/abitofhelp/hybrid_lib_go/"
+
+// maxDepth bounds the walk through deeply nested values.
+const maxDepth = 64
+
+// Role classifies a node by the layer its type belongs to.
+type Role int
+
+const (
+	// RoleComponent is a type from outside this module (the application's
+	// own wrappers, third-party adapters).
+	RoleComponent Role = iota
+	// RoleFacade is a ready-to-use type from the desktop composition root.
+	RoleFacade
+	// RoleUseCase is an application use case.
+	RoleUseCase
+	// RoleDecorator is an application middleware decorator.
+	RoleDecorator
+	// RoleAdapter is an infrastructure adapter.
+	RoleAdapter
+)
+
+// String returns the role's name.
+func (r Role) String() string {
+	switch r {
+	case RoleFacade:
+		return "facade"
+	case RoleUseCase:
+		return "use case"
+	case RoleDecorator:
+		return "decorator"
+	case RoleAdapter:
+		return "adapter"
+	default:
+		return "component"
+	}
+}
+
+// rolePackages maps package paths (relative to the module) to roles.
+var rolePackages = map[string]Role{
+	"api/adapter/desktop":    RoleFacade,
+	"application/usecase":    RoleUseCase,
+	"application/middleware": RoleDecorator,
+	"infrastructure/adapter": RoleAdapter,
+}
+
+// Node is one wired component.
+type Node struct {
+	ID   string // n1, n2, ... in discovery order
+	Type string // package-qualified type name without type arguments
+	Role Role
+}
+
+// Edge is a dependency: From holds To in the field Label.
+type Edge struct {
+	From, To string
+	Label    string
+}
+
+// Dependencies is a composed application's dependency graph. The first
+// node is the root passed to Graph.
+type Dependencies struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// Graph returns the dependency graph of root. Call it once wiring is done
+// and before the composed values are in concurrent use: the walk reads
+// their fields without locking.
+func Graph(root any) *Dependencies {
+	b := &builder{g: &Dependencies{}, seen: map[pointerKey]string{}}
+	b.walk(reflect.ValueOf(root), "", "", 0)
+	return b.g
+}
+
+// Mermaid renders the graph as a Mermaid flowchart.
+func (g *Dependencies) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("graph LR\n")
+	for _, n := range g.Nodes {
+		open, close := mermaidShape(n.Role)
+		fmt.Fprintf(&b, "  %s%s\"%s<br/><i>%s</i>\"%s\n", n.ID, open, n.Type, n.Role, close)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", e.From, e.Label, e.To)
+	}
+	return b.String()
+}
+
+// DOT renders the graph in the Graphviz DOT language.
+func (g *Dependencies) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph composition {\n  rankdir=LR;\n  node [fontname=\"Helvetica\"];\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%q, shape=%s];\n", n.ID, n.Type+"\n"+n.Role.String(), dotShape(n.Role))
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%q];\n", e.From, e.To, e.Label)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Handler serves root's dependency graph: Mermaid by default, DOT with
+// ?format=dot. The graph is computed once, when Handler is called.
+//
+// Mount it on a debug-only listener; it discloses the application's
+// internal structure.
+func Handler(root any) http.Handler {
+	g := Graph(root)
+	mermaid, dot := g.Mermaid(), g.DOT()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		switch r.URL.Query().Get("format") {
+		case "", "mermaid":
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			fmt.Fprint(w, mermaid)
+		case "dot":
+			w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+			fmt.Fprint(w, dot)
+		default:
+			http.Error(w, "format must be mermaid or dot", http.StatusBadRequest)
+		}
+	})
+}
+
+// ============================================================================
+// Walk
+// ============================================================================
+
+// pointerKey identifies a value reached through a pointer; the type is
+// part of the key because a struct and its first field share an address.
+// Stateless (zero-size) values such as SystemClock have no identity and
+// are keyed by type alone, so they appear once.
+type pointerKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+type builder struct {
+	g    *Dependencies
+	seen map[pointerKey]string
+}
+
+// walk visits v, linking the nodes it finds to parent under label.
+func (b *builder) walk(v reflect.Value, parent, label string, depth int) {
+	if !v.IsValid() || depth > maxDepth {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			b.walk(v.Elem(), parent, label, depth+1)
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		if v.Elem().Kind() != reflect.Struct {
+			b.walk(v.Elem(), parent, label, depth+1)
+			return
+		}
+		key := pointerKey{addr: v.Pointer(), typ: v.Elem().Type()}
+		if id, ok := b.seen[key]; ok {
+			b.link(parent, id, label)
+			return
+		}
+		b.visitStruct(v.Elem(), parent, label, depth, &key)
+	case reflect.Struct:
+		if v.Type().Size() != 0 {
+			b.visitStruct(v, parent, label, depth, nil)
+			return
+		}
+		key := pointerKey{typ: v.Type()}
+		if id, ok := b.seen[key]; ok {
+			b.link(parent, id, label)
+			return
+		}
+		b.visitStruct(v, parent, label, depth, &key)
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			b.walk(v.Index(i), parent, fmt.Sprintf("%s[%d]", label, i), depth+1)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		})
+		for _, k := range keys {
+			b.walk(v.MapIndex(k), parent, fmt.Sprintf("%s[%v]", label, k), depth+1)
+		}
+	}
+}
+
+// visitStruct adds a node for v (or looks through it) and walks its fields.
+func (b *builder) visitStruct(v reflect.Value, parent, label string, depth int, key *pointerKey) {
+	t := v.Type()
+	role, shown := roleOf(t)
+	if !shown {
+		if !isStdlib(t) { // a model or config type of this module
+			for i := range v.NumField() {
+				b.walk(v.Field(i), parent, joinLabel(label, t.Field(i).Name), depth+1)
+			}
+		}
+		return
+	}
+
+	id := fmt.Sprintf("n%d", len(b.g.Nodes)+1)
+	b.g.Nodes = append(b.g.Nodes, Node{ID: id, Type: typeName(t), Role: role})
+	if key != nil {
+		b.seen[*key] = id
+	}
+	b.link(parent, id, label)
+	for i := range v.NumField() {
+		b.walk(v.Field(i), id, t.Field(i).Name, depth+1)
+	}
+}
+
+// link adds an edge unless from is the root's (empty) parent.
+func (b *builder) link(from, to, label string) {
+	if from != "" {
+		b.g.Edges = append(b.g.Edges, Edge{From: from, To: to, Label: label})
+	}
+}
+
+// roleOf classifies t; shown is false for standard library types, for
+// this module's types outside the composed layers and for its unexported
+// helper types.
+func roleOf(t reflect.Type) (role Role, shown bool) {
+	pkg := t.PkgPath()
+	switch {
+	case isStdlib(t):
+		return 0, false
+	case strings.HasPrefix(pkg, modulePrefix):
+		if !token.IsExported(t.Name()) {
+			return 0, false // an internal helper of a decorator or adapter
+		}
+		role, shown = rolePackages[strings.TrimPrefix(pkg, modulePrefix)]
+		return role, shown
+	default:
+		return RoleComponent, true
+	}
+}
+
+// isStdlib reports whether t is unnamed or from the standard library,
+// taken to be import paths with no dot in their first element (other
+// than package main).
+func isStdlib(t reflect.Type) bool {
+	if t.PkgPath() == "" {
+		return true
+	}
+	first, _, _ := strings.Cut(t.PkgPath(), "/")
+	return first != "main" && !strings.Contains(first, ".")
+}
+
+// typeName returns t's package-qualified name without type arguments.
+func typeName(t reflect.Type) string {
+	name, _, _ := strings.Cut(t.String(), "[")
+	return name
+}
+
+func joinLabel(label, field string) string {
+	if label == "" {
+		return field
+	}
+	return label + "." + field
+}
+
+func mermaidShape(r Role) (string, string) {
+	switch r {
+	case RoleUseCase:
+		return "([", "])"
+	case RoleDecorator:
+		return "{{", "}}"
+	case RoleAdapter:
+		return "[(", ")]"
+	default:
+		return "[", "]"
+	}
+}
+
+func dotShape(r Role) string {
+	switch r {
+	case RoleUseCase:
+		return "ellipse"
+	case RoleDecorator:
+		return "hexagon"
+	case RoleAdapter:
+		return "cylinder"
+	default:
+		return "box"
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package composition
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/api/adapter/desktop"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// auditWriter is a writer wrapping library writers.
+type auditWriter struct {
+	next  api.WriterPort
+	extra []api.WriterPort
+}
+
+func (a *auditWriter) Write(ctx context.Context, message string) api.Result[api.Unit] {
+	return a.next.Write(ctx, message)
+}
+
+// app is an application struct holding what it composed.
+type app struct {
+	greeter api.GreetPort
+	sync    api.SyncPort
+}
+
+// TestAPIDesktopCompositionGraph tests the dependency graph walk.
+func TestAPIDesktopCompositionGraph(t *testing.T) {
+	tf := test.New("API.Desktop.Composition.Graph")
+
+	// ========================================================================
+	// Test: Decorator over a facade
+	// ========================================================================
+
+	spool := desktop.NewFileSpool(t.TempDir() + "/spool.jsonl")
+	greeter := desktop.NewGreeter()
+	g := Graph(desktop.NewOfflineGreeter(greeter, spool))
+	types := func(g *Dependencies) []string {
+		var out []string
+		for _, n := range g.Nodes {
+			out = append(out, n.Type+"/"+n.Role.String())
+		}
+		return out
+	}
+	tf.RunTest("Offline greeter - nodes in discovery order", strings.Join(types(g), ",") ==
+		"middleware.Spooling/decorator,desktop.Greeter/facade,usecase.GreetUseCase/use case,"+
+			"adapter.ConsoleWriter/adapter,adapter.SystemClock/adapter,adapter.FileSpool/adapter,"+
+			"adapter.UUIDv7Generator/adapter")
+	tf.RunTest("Offline greeter - root first, edges labeled by field",
+		len(g.Edges) == 9 && g.Edges[0] == Edge{From: "n1", To: "n2", Label: "next"} &&
+			g.Edges[2] == Edge{From: "n3", To: "n4", Label: "writer"})
+
+	// ========================================================================
+	// Test: Shared values, application types and collections
+	// ========================================================================
+
+	shared := desktop.NewFileSpool(t.TempDir() + "/shared.jsonl")
+	composed := &app{
+		greeter: desktop.NewOfflineGreeter(greeter, shared),
+		sync:    desktop.NewGreetSync(greeter, shared),
+	}
+	g = Graph(composed)
+	count := func(typ string) int {
+		n := 0
+		for _, node := range g.Nodes {
+			if node.Type == typ {
+				n++
+			}
+		}
+		return n
+	}
+	tf.RunTest("Shared pointer - one node", count("adapter.FileSpool") == 1 && count("desktop.Greeter") == 1)
+	tf.RunTest("Unexported root - looked through", g.Nodes[0].Type == "middleware.Spooling" && count("composition.app") == 0)
+
+	console := adapter.NewWriter(io.Discard)
+	audit := &auditWriter{next: console, extra: []api.WriterPort{console, adapter.NewWriter(io.Discard)}}
+	g = Graph(desktop.GreeterWithWriter(audit))
+	var labels []string
+	for _, e := range g.Edges {
+		labels = append(labels, e.Label)
+	}
+	tf.RunTest("Looked-through fields - joined labels, slices indexed",
+		strings.Join(labels, ",") == "useCase,writer.next,clock,writer.extra[0],writer.extra[1],clock,clock")
+	tf.RunTest("Looked-through fields - shared writer linked twice", len(g.Nodes) == 5)
+	tf.RunTest("Stateless value - one node", count("adapter.SystemClock") == 1)
+
+	// ========================================================================
+	// Test: Rendering
+	// ========================================================================
+
+	mermaid := g.Mermaid()
+	tf.RunTest("Mermaid - header and shapes", strings.HasPrefix(mermaid, "graph LR\n") &&
+		strings.Contains(mermaid, `n2(["usecase.GreetUseCase<br/><i>use case</i>"])`) &&
+		strings.Contains(mermaid, `n3[("adapter.ConsoleWriter<br/><i>adapter</i>")]`))
+	tf.RunTest("Mermaid - labeled edges", strings.Contains(mermaid, "  n2 -->|writer.next| n3\n"))
+	dot := g.DOT()
+	tf.RunTest("DOT - nodes and edges", strings.HasPrefix(dot, "digraph composition {") &&
+		strings.Contains(dot, `n1 [label="desktop.GreeterCustom\nfacade", shape=box];`) &&
+		strings.Contains(dot, `n2 -> n3 [label="writer.next"];`))
+	tf.RunTest("Nil root - empty graph", len(Graph(nil).Nodes) == 0)
+
+	tf.Summary(t)
+}
+
+// TestAPIDesktopCompositionHandler tests the debug endpoint.
+func TestAPIDesktopCompositionHandler(t *testing.T) {
+	tf := test.New("API.Desktop.Composition.Handler")
+	h := Handler(desktop.NewGreeter())
+	get := func(method, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+		return rec
+	}
+
+	// ========================================================================
+	// Test: Formats
+	// ========================================================================
+
+	rec := get(http.MethodGet, "/debug/composition")
+	tf.RunTest("Default - Mermaid", rec.Code == http.StatusOK && strings.HasPrefix(rec.Body.String(), "graph LR"))
+	rec = get(http.MethodGet, "/debug/composition?format=dot")
+	tf.RunTest("format=dot - DOT", rec.Code == http.StatusOK &&
+		rec.Header().Get("Content-Type") == "text/vnd.graphviz; charset=utf-8" &&
+		strings.HasPrefix(rec.Body.String(), "digraph"))
+	tf.RunTest("Unknown format - 400", get(http.MethodGet, "/?format=svg").Code == http.StatusBadRequest)
+	tf.RunTest("POST - 405", get(http.MethodPost, "/").Code == http.StatusMethodNotAllowed)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package composition
+
+import (
+	"os"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestMain is the test runner for the composition package.
+func TestMain(m *testing.M) {
+	test.Reset()
+	code := m.Run()
+
+	test.PrintCategorySummary("UNIT TESTS",
+		test.GrandTotalTests(),
+		test.GrandTotalPassed())
+
+	os.Exit(code)
+}
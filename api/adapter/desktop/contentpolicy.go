@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Content policy selection for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewDenyListPolicy creates a static deny-list content policy.
+// With mask true denied words are masked; otherwise names are rejected.
+func NewDenyListPolicy(words []string, mask bool) api.ContentPolicyPort {
+	return adapter.NewDenyListPolicy(words, mask)
+}
@@ -44,9 +44,10 @@ type Greeter struct {
 
 // NewGreeter creates a new Greeter with console output.
 // This is the recommended way to create a ready-to-use greeter for desktop apps.
-func NewGreeter() *Greeter {
-	writer := adapter.NewConsoleWriter()
-	uc := usecase.NewGreetUseCase[*adapter.ConsoleWriter](writer)
+func NewGreeter(opts ...Option) *Greeter {
+	s := newSettings(opts)
+	writer := adapter.NewConsoleWriter(adapter.WithConsoleClock(s.clock))
+	uc := usecase.NewGreetUseCaseWithClock[*adapter.ConsoleWriter](writer, s.clock)
 	return &Greeter{useCase: uc}
 }
 
@@ -55,10 +56,35 @@ func (g *Greeter) Execute(ctx context.Context, cmd api.GreetCommand) api.Result[
 	return g.useCase.Execute(ctx, cmd)
 }
 
+// NewNotificationGreeter creates a greeter that shows greetings as desktop
+// notifications with the given title. On platforms without notification
+// support (or if showing one fails) greetings are written to the console.
+func NewNotificationGreeter(title string, opts ...Option) *GreeterCustom[*adapter.NotificationWriter] {
+	return GreeterWithWriter(adapter.NewNotificationWriter(title), opts...)
+}
+
+// NewColorGreeter creates a console greeter that colors greetings green and
+// wraps them to the terminal width when stdout is a terminal. NO_COLOR and
+// FORCE_COLOR are honored.
+func NewColorGreeter(opts ...Option) *GreeterCustom[*adapter.ConsoleWriter] {
+	writer := adapter.NewConsoleWriter(adapter.WithColor(adapter.ColorAuto), adapter.WithWrap(0),
+		adapter.WithConsoleClock(newSettings(opts).clock))
+	return GreeterWithWriter(writer, opts...)
+}
+
+// NewSyslogGreeter creates a greeter that sends greetings to the local
+// syslog daemon (journald on systemd hosts) under appName, facility user.
+func NewSyslogGreeter(appName string, opts ...Option) *GreeterCustom[*adapter.SyslogWriter] {
+	writer := adapter.NewLocalSyslogWriter(appName, adapter.FacilityUser, adapter.WithDialClock(newSettings(opts).clock))
+	return GreeterWithWriter(writer, opts...)
+}
+
 // GreeterWithWriter creates a Greeter with a custom writer.
 // Use this when you need to redirect output (e.g., to a buffer for testing).
-func GreeterWithWriter[W api.WriterPort](writer W) *GreeterCustom[W] {
-	uc := usecase.NewGreetUseCase[W](writer)
+// Options apply to the use case (synthesized receipt times); configure
+// writer's own clock with its option (e.g. adapter.WithConsoleClock).
+func GreeterWithWriter[W api.WriterPort](writer W, opts ...Option) *GreeterCustom[W] {
+	uc := usecase.NewGreetUseCaseWithClock[W](writer, newSettings(opts).clock)
 	return &GreeterCustom[W]{useCase: uc}
 }
 
@@ -71,3 +97,19 @@ type GreeterCustom[W api.WriterPort] struct {
 func (g *GreeterCustom[W]) Execute(ctx context.Context, cmd api.GreetCommand) api.Result[api.Unit] {
 	return g.useCase.Execute(ctx, cmd)
 }
+
+// ExecuteAll greets every command in one batch; writers implementing
+// api.BatchWriterPort (such as the console) receive a single WriteAll.
+func (g *GreeterCustom[W]) ExecuteAll(ctx context.Context, cmds []api.GreetCommand) api.Result[api.BatchReceipt] {
+	return g.useCase.ExecuteAll(ctx, cmds)
+}
+
+// NewSalutationGreeter creates a console greeter that picks each greeting's
+// salutation at random from salutations ("Hello" if none are given). The
+// choice uses WithRandom's RandomPort, so testmode.Deterministic() makes
+// the sequence reproducible.
+func NewSalutationGreeter(salutations []string, opts ...Option) api.GreetPort {
+	s := newSettings(opts)
+	writer := adapter.NewConsoleWriter(adapter.WithConsoleClock(s.clock))
+	return usecase.NewSalutationGreetUseCase(writer, s.randomPort(), salutations...)
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Error reporter selection for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+	"github.com/abitofhelp/hybrid_lib_go/version"
+)
+
+// NewSentryReporter creates an error reporter for a Sentry-compatible DSN.
+// Events carry the build's release (see version.Get). Wrap use cases with
+// middleware.NewReportErrors to feed it.
+func NewSentryReporter(dsn string, opts ...Option) api.ErrorReporterPort {
+	s := newSettings(opts)
+	httpOpts := []adapter.HTTPOption{
+		adapter.WithHTTPClock(s.clock),
+		adapter.WithSentryRelease(version.Get().Release()),
+	}
+	if s.entropy != nil {
+		httpOpts = append(httpOpts, adapter.WithHTTPEntropy(s.entropy))
+	}
+	return adapter.NewSentryReporter(dsn, httpOpts...)
+}
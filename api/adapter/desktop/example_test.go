@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package desktop_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/api/adapter/desktop"
+	"github.com/abitofhelp/hybrid_lib_go/api/adapter/desktop/testmode"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// shoutWriter is a custom api.WriterPort printing messages in upper case.
+type shoutWriter struct{}
+
+func (shoutWriter) Write(_ context.Context, message string) api.Result[api.Unit] {
+	fmt.Println(strings.ToUpper(message))
+	return api.Ok(api.Unit{})
+}
+
+func ExampleNewGreeter() {
+	greeter := desktop.NewGreeter()
+
+	result := greeter.Execute(context.Background(), api.NewGreetCommand("Alice"))
+	fmt.Println(result.IsOk())
+
+	invalid := greeter.Execute(context.Background(), api.NewGreetCommand(""))
+	fmt.Println(invalid.ErrorInfo().Kind)
+	// Output:
+	// Hello, Alice!
+	// true
+	// ValidationError
+}
+
+func ExampleGreeterWithWriter() {
+	greeter := desktop.GreeterWithWriter(shoutWriter{})
+
+	greeter.Execute(context.Background(), api.NewGreetCommand("Alice"))
+	// Output: HELLO, ALICE!
+}
+
+func ExampleNewULIDGenerator() {
+	ids := desktop.NewULIDGenerator(testmode.Deterministic())
+
+	fmt.Println(ids.NewID().Value())
+	fmt.Println(ids.NewID().Value())
+	// Output:
+	// 01JGFJJZ000000000000000000
+	// 01JGFJJZ000000000000000001
+}
+
+func ExampleNewSalutationGreeter() {
+	greeter := desktop.NewSalutationGreeter([]string{"Hello", "Hi", "Welcome"}, testmode.Deterministic())
+
+	for _, name := range []string{"Alice", "Bob", "Carol", "Dave"} {
+		greeter.Execute(context.Background(), api.NewGreetCommand(name))
+	}
+	// Output:
+	// Hello, Alice!
+	// Welcome, Bob!
+	// Hi, Carol!
+	// Hi, Dave!
+}
+
+func ExampleOptions() {
+	clock := adapter.NewFrozenClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	frozen := desktop.Options(desktop.WithClock(clock), desktop.WithEntropy(bytes.NewReader(make([]byte, 64))))
+
+	ids := desktop.NewUUIDv7Generator(frozen)
+	fmt.Println(ids.NewID().Value())
+	// Output: 01972b5c-ee00-7000-8000-000000000000
+}
+
+func ExampleNewFailoverWriter() {
+	w := desktop.NewFailoverWriter(shoutWriter{}, shoutWriter{}, testmode.Deterministic())
+
+	receipt := w.WriteWithReceipt(context.Background(), "Hello, Alice!")
+	fmt.Println(receipt.Value().WrittenAt)
+	// Output:
+	// HELLO, ALICE!
+	// 2025-01-01 00:00:00 +0000 UTC
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Failover output wiring for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/middleware"
+)
+
+// NewFailoverWriter wraps primary so that, while it fails with an
+// InfrastructureError, messages go to secondary (e.g. a ConsoleWriter over
+// a local file) and are replayed to primary, in order, once it recovers.
+// Call Replay to flush them without waiting for the next write. Options
+// set the clock stamping receipts synthesized for sinks without their own.
+func NewFailoverWriter(
+	primary, secondary api.WriterPort, opts ...Option,
+) *middleware.FailoverWriter[api.WriterPort, api.WriterPort] {
+	return middleware.NewFailoverWriter(primary, secondary, newSettings(opts).clock)
+}
@@ -3,6 +3,7 @@ module github.com/abitofhelp/hybrid_lib_go/api/adapter/desktop
 go 1.23.0
 
 require (
+	github.com/abitofhelp/hybrid_lib_go v0.0.0
 	github.com/abitofhelp/hybrid_lib_go/api v0.0.0
 	github.com/abitofhelp/hybrid_lib_go/application v0.0.0
 	github.com/abitofhelp/hybrid_lib_go/domain v0.0.0
@@ -10,6 +11,7 @@ require (
 )
 
 replace (
+	github.com/abitofhelp/hybrid_lib_go => ../../../
 	github.com/abitofhelp/hybrid_lib_go/api => ../../
 	github.com/abitofhelp/hybrid_lib_go/application => ../../../application
 	github.com/abitofhelp/hybrid_lib_go/domain => ../../../domain
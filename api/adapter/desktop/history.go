@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Greeting history export wiring for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewMemoryHistory creates an in-process greeting history that satisfies
+// both HistoryPort and HistoryReaderPort.
+func NewMemoryHistory() *adapter.MemoryHistory {
+	return adapter.NewMemoryHistory()
+}
+
+// NewHistoryExporter wires the history export use case to history and
+// blobs, e.g. NewHistoryExporter(NewMemoryHistory(), NewFileBlobStore(dir)).
+func NewHistoryExporter(history api.HistoryReaderPort, blobs api.BlobPort) api.ExportHistoryPort {
+	return usecase.NewExportHistoryUseCase(history, blobs)
+}
+
+// NewHistoryQuery wires the history query use case to history. Its
+// Iterate method streams records lazily for range-over-func loops.
+func NewHistoryQuery(history api.HistoryReaderPort) *usecase.QueryHistoryUseCase[api.HistoryReaderPort] {
+	return usecase.NewQueryHistoryUseCase(history)
+}
+
+// NewSubjectEraser wires the erase subject use case to history, e.g.
+// NewSubjectEraser(NewMemoryHistory()), for right-to-erasure requests.
+func NewSubjectEraser(history interface {
+	api.HistoryPort
+	api.HistoryReaderPort
+}) api.EraseSubjectPort {
+	return usecase.NewEraseSubjectUseCase(history)
+}
+
+// NewExportVerifier checks exports stored in blobs against their
+// ".sha256" sidecars: Execute(ctx, key) returns the verified digest.
+func NewExportVerifier(blobs api.BlobPort) *usecase.VerifyExportUseCase[api.BlobPort] {
+	return usecase.NewVerifyExportUseCase(blobs)
+}
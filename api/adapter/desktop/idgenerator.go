@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: ID generator selection for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewUUIDv7Generator creates a time-ordered UUIDv7 generator.
+// The default choice for correlation and record IDs.
+func NewUUIDv7Generator(opts ...Option) api.IDGeneratorPort {
+	return adapter.NewUUIDv7Generator(newSettings(opts).idOptions()...)
+}
+
+// NewULIDGenerator creates a monotonic ULID generator.
+// Use when IDs should be compact (26 chars) and case-insensitive.
+func NewULIDGenerator(opts ...Option) api.IDGeneratorPort {
+	return adapter.NewULIDGenerator(newSettings(opts).idOptions()...)
+}
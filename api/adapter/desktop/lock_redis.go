@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Redis lock selection (requires the "redis" build tag)
+
+//go:build redis
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewRedisLock creates a lock adapter backed by the Redis server at addr
+// ("host:port"), for coordinating multiple replicas.
+// Available only when built with -tags redis.
+func NewRedisLock(addr string) api.LockPort {
+	return adapter.NewRedisLock(addr)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Slack and SMS greeter wiring for desktop applications
+
+package desktop
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewSlackGreeter creates a greeter that posts greetings to Slack through
+// the incoming webhook URL stored in secrets under key (e.g. with
+// NewEnvSecrets("GREETER_") and key "slack/webhook", the variable
+// GREETER_SLACK_WEBHOOK).
+//
+// Wrap the greeter in middleware.NewRetry to honor Slack's rate limits.
+func NewSlackGreeter(ctx context.Context, secrets api.SecretPort, key string) api.Result[*GreeterCustom[*adapter.SlackWriter]] {
+	webhook := secrets.Get(ctx, key)
+	if webhook.IsError() {
+		return api.Err[*GreeterCustom[*adapter.SlackWriter]](webhook.ErrorInfo())
+	}
+	defer webhook.Value().Release()
+	return api.Ok(GreeterWithWriter(adapter.NewSlackWriter(webhook.Value())))
+}
+
+// NewSMSGreeter creates a greeter that texts greetings from one number to
+// another through a Twilio-compatible API at baseURL, reading the auth
+// token from secrets under tokenKey.
+func NewSMSGreeter(
+	ctx context.Context, secrets api.SecretPort, tokenKey, baseURL, accountSID, from, to string,
+) api.Result[*GreeterCustom[*adapter.SMSWriter]] {
+	token := secrets.Get(ctx, tokenKey)
+	if token.IsError() {
+		return api.Err[*GreeterCustom[*adapter.SMSWriter]](token.ErrorInfo())
+	}
+	defer token.Value().Release()
+	return api.Ok(GreeterWithWriter(adapter.NewSMSWriter(baseURL, accountSID, token.Value(), from, to)))
+}
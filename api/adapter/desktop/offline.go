@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Offline mode (spool-and-forward) wiring for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/middleware"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewFileSpool creates a spool of undelivered commands kept as JSON Lines
+// in the file at path (e.g. under os.UserCacheDir()).
+func NewFileSpool(path string) api.SpoolPort {
+	return adapter.NewFileSpool(path)
+}
+
+// NewOfflineGreeter wraps greeter so greetings that cannot be delivered
+// (an InfrastructureError, e.g. Slack unreachable) are spooled and
+// reported as Ok. Each greeting carries a UUIDv7 idempotency key.
+// Options set the clock stamping spool entries and the key generator's
+// clock and entropy.
+//
+// Usage:
+//
+//	spool := desktop.NewFileSpool(path)
+//	greeter := desktop.NewOfflineGreeter(slack, spool)
+//	sync := desktop.NewGreetSync(slack, spool) // run when back online
+func NewOfflineGreeter(greeter api.GreetPort, spool api.SpoolPort, opts ...Option) api.GreetPort {
+	s := newSettings(opts)
+	ids := adapter.NewUUIDv7Generator(s.idOptions()...)
+	return middleware.NewSpooling[command.GreetCommand](greeter, spool, ids, s.clock)
+}
+
+// NewGreetSync creates the sync use case replaying spooled greetings to
+// greeter, oldest first, with their original idempotency keys. Pass the
+// unwrapped greeter, not the one from NewOfflineGreeter.
+func NewGreetSync(greeter api.GreetPort, spool api.SpoolPort) api.SyncPort {
+	return usecase.NewSyncUseCase[command.GreetCommand](greeter, spool)
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Composition options for desktop factories
+
+package desktop
+
+import (
+	"io"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// Option configures the time and randomness a desktop factory wires into
+// what it builds. Factories that stamp output, generate IDs or make random
+// choices accept options; the defaults are the system clock, crypto/rand
+// and an unseeded adapter.MathRandom.
+//
+// For tests and golden files, testmode.Deterministic() freezes all three.
+//
+// Not covered, deliberately: encryption nonces and keys, lock tokens, and
+// latency or TTL measurements always use crypto/rand and the real clock.
+type Option func(*settings)
+
+type settings struct {
+	clock   api.ClockPort
+	entropy io.Reader      // nil: the ID generators' default (crypto/rand)
+	random  api.RandomPort // nil: an unseeded adapter.MathRandom
+}
+
+// WithClock stamps output (receipts, log timestamps, error reports, spool
+// entries) and time-ordered IDs with clock.
+func WithClock(clock api.ClockPort) Option {
+	return func(s *settings) { s.clock = clock }
+}
+
+// WithEntropy draws the random part of generated IDs (and Sentry event
+// IDs) from r.
+func WithEntropy(r io.Reader) Option {
+	return func(s *settings) { s.entropy = r }
+}
+
+// WithRandom makes random choices (e.g. NewSalutationGreeter's salutation)
+// with r; pass adapter.NewSeededRandom for a reproducible sequence.
+func WithRandom(r api.RandomPort) Option {
+	return func(s *settings) { s.random = r }
+}
+
+// Options combines opts into one Option, applied in order.
+func Options(opts ...Option) Option {
+	return func(s *settings) {
+		for _, opt := range opts {
+			opt(s)
+		}
+	}
+}
+
+func newSettings(opts []Option) settings {
+	s := settings{clock: adapter.NewSystemClock()}
+	Options(opts...)(&s)
+	if s.clock == nil {
+		s.clock = adapter.NewSystemClock()
+	}
+	return s
+}
+
+// randomPort returns the configured RandomPort, or a fresh unseeded one.
+func (s settings) randomPort() api.RandomPort {
+	if s.random == nil {
+		return adapter.NewMathRandom()
+	}
+	return s.random
+}
+
+// idOptions configures an ID generator from s.
+func (s settings) idOptions() []adapter.IDOption {
+	opts := []adapter.IDOption{adapter.WithClock(s.clock)}
+	if s.entropy != nil {
+		opts = append(opts, adapter.WithEntropy(s.entropy))
+	}
+	return opts
+}
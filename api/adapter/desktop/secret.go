@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: desktop
+// Description: Secret adapter selection for desktop applications
+
+package desktop
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// NewEnvSecrets creates a SecretPort reading environment variables named
+// prefix + KEY (e.g. "GREETER_" + "smtp/password" -> GREETER_SMTP_PASSWORD).
+func NewEnvSecrets(prefix string) api.SecretPort {
+	return adapter.NewEnvSecrets(prefix)
+}
+
+// NewVaultSecrets creates a SecretPort reading HashiCorp Vault KV v2 secrets
+// from the given mount, authenticating with token.
+func NewVaultSecrets(addr, token, mount string) api.SecretPort {
+	return adapter.NewVaultSecrets(addr, token, mount)
+}
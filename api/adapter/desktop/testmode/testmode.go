@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: testmode
+// Description: Deterministic composition for tests and golden files
+
+// Package testmode provides desktop composition options that make output
+// reproducible: a frozen clock, zero ID entropy and a seeded RandomPort,
+// so timestamps, generated IDs, Sentry event IDs and random choices are
+// the same on every run.
+//
+// Usage:
+//
+//	greeter := desktop.NewGreeter(testmode.Deterministic())
+//	ids := desktop.NewULIDGenerator(testmode.Deterministic())
+//	// ids: 01JGFJJZ000000000000000000, ...0001, ...0002 on every run
+package testmode
+
+import (
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/api/adapter/desktop"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// Epoch is the instant Deterministic freezes the clock at.
+var Epoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Deterministic returns a desktop option freezing the clock at Epoch,
+// drawing ID entropy from zeros and making random choices with
+// adapter.NewSeededRandom(0). Each call has its own clock and random
+// source: factories given separate Deterministic() options stay
+// independent.
+func Deterministic() desktop.Option {
+	return DeterministicAt(adapter.NewFrozenClock(Epoch))
+}
+
+// DeterministicAt is Deterministic with a caller-held clock, for tests
+// that move time with Set or Advance.
+func DeterministicAt(clock *adapter.FrozenClock) desktop.Option {
+	return desktop.Options(desktop.WithClock(clock), desktop.WithEntropy(zeros{}),
+		desktop.WithRandom(adapter.NewSeededRandom(0)))
+}
+
+// zeros is an endless source of zero bytes.
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
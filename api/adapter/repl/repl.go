@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: repl
+// Description: Interactive line-oriented adapter for exploratory use
+
+// Package repl provides an interactive read-eval-print loop over the public
+// API, for demos and for trying out use cases by hand.
+//
+// Commands:
+//
+//	greet <name>   run the greet use case
+//	history        list previous commands, numbered
+//	!<n>           re-run history entry n; !! re-runs the last one
+//	help           list commands
+//	exit, quit     leave (end of input also leaves)
+//
+// The REPL depends on api ports only; the composition root decides what
+// greeter it drives (see api/adapter/desktop/cmd/repl).
+//
+// Usage:
+//
+//	r := repl.New(desktop.NewGreeter(), os.Stdin, os.Stdout)
+//	result := r.Run(ctx)
+package repl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Prompt is printed before each command is read.
+const Prompt = "hybrid> "
+
+const helpText = `commands:
+  greet <name>   greet someone
+  history        list previous commands
+  !<n>, !!       re-run history entry n, or the last command
+  help           show this help
+  exit, quit     leave`
+
+// REPL reads commands from an input stream and dispatches them to a greeter.
+//
+// Concurrency: a REPL is driven by one goroutine (Run); not safe for
+// concurrent use.
+type REPL struct {
+	greeter api.GreetPort
+	in      *bufio.Scanner
+	out     io.Writer
+	history []string
+}
+
+// New creates a REPL reading commands from in and printing to out.
+func New(greeter api.GreetPort, in io.Reader, out io.Writer) *REPL {
+	return &REPL{greeter: greeter, in: bufio.NewScanner(in), out: out}
+}
+
+// History returns the commands run so far, oldest first.
+func (r *REPL) History() []string {
+	return append([]string(nil), r.history...)
+}
+
+// Run reads and executes commands until exit, end of input or ctx is done.
+//
+// Contract:
+//   - Returns Ok(Unit) on exit/quit or end of input
+//   - Returns Err(InfrastructureError) if ctx is cancelled or input fails
+//   - Command failures (e.g. an invalid name) are printed, not returned
+func (r *REPL) Run(ctx context.Context) api.Result[api.Unit] {
+	for {
+		if err := ctx.Err(); err != nil {
+			return api.Err[api.Unit](domerr.NewInfrastructureError(
+				fmt.Sprintf("repl cancelled: %v", context.Cause(ctx))))
+		}
+		fmt.Fprint(r.out, Prompt)
+		if !r.in.Scan() {
+			fmt.Fprintln(r.out)
+			if err := r.in.Err(); err != nil {
+				return api.Err[api.Unit](domerr.NewInfrastructureError(
+					fmt.Sprintf("repl input failed: %v", err)))
+			}
+			return api.Ok(model.UnitValue)
+		}
+
+		line := strings.TrimSpace(r.in.Text())
+		if strings.HasPrefix(line, "!") {
+			recalled, ok := r.recall(line)
+			if !ok {
+				fmt.Fprintf(r.out, "no history entry %q\n", line)
+				continue
+			}
+			fmt.Fprintln(r.out, recalled)
+			line = recalled
+		}
+		if line == "" {
+			continue
+		}
+		r.history = append(r.history, line)
+
+		if !r.eval(ctx, line) {
+			return api.Ok(model.UnitValue)
+		}
+	}
+}
+
+// eval executes one command line; it returns false when the REPL should stop.
+func (r *REPL) eval(ctx context.Context, line string) bool {
+	verb, arg, _ := strings.Cut(line, " ")
+	switch verb {
+	case "greet":
+		r.print(r.greeter.Execute(ctx, api.NewGreetCommand(strings.TrimSpace(arg))))
+	case "history":
+		for i, entry := range r.history {
+			fmt.Fprintf(r.out, "%4d  %s\n", i+1, entry)
+		}
+	case "help":
+		fmt.Fprintln(r.out, helpText)
+	case "exit", "quit":
+		return false
+	default:
+		fmt.Fprintf(r.out, "unknown command %q (try help)\n", verb)
+	}
+	return true
+}
+
+// recall expands "!!" or "!<n>" from history.
+func (r *REPL) recall(line string) (string, bool) {
+	if len(r.history) == 0 {
+		return "", false
+	}
+	if line == "!!" {
+		return r.history[len(r.history)-1], true
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 1 || n > len(r.history) {
+		return "", false
+	}
+	return r.history[n-1], true
+}
+
+// print reports a command Result: "ok" or the error kind and message.
+func (r *REPL) print(result api.Result[api.Unit]) {
+	if result.IsOk() {
+		fmt.Fprintln(r.out, "ok")
+		return
+	}
+	fmt.Fprintf(r.out, "error: %s\n", result.ErrorInfo().Error())
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package repl
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// namesGreeter records the names it was asked to greet and rejects "".
+type namesGreeter struct{ names []string }
+
+func (g *namesGreeter) Execute(_ context.Context, cmd api.GreetCommand) api.Result[api.Unit] {
+	if cmd.GetName() == "" {
+		return api.Err[api.Unit](domerr.NewValidationError("Person name cannot be empty"))
+	}
+	g.names = append(g.names, cmd.GetName())
+	return api.Ok(model.UnitValue)
+}
+
+// TestAPIAdapterREPL tests command parsing, history and result printing.
+func TestAPIAdapterREPL(t *testing.T) {
+	tf := test.New("API.Adapter.REPL")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Commands
+	// ========================================================================
+
+	g := &namesGreeter{}
+	var out bytes.Buffer
+	r := New(g, strings.NewReader("greet Alice\ngreet   Bob Smith \ngreet\nbogus\nhelp\n"), &out)
+	result := r.Run(ctx)
+	text := out.String()
+	tf.RunTest("End of input - IsOk", result.IsOk())
+	tf.RunTest("greet - dispatched with trimmed name", len(g.names) == 2 && g.names[1] == "Bob Smith")
+	tf.RunTest("greet - ok printed", strings.Count(text, "ok\n") == 2)
+	tf.RunTest("greet - error printed with kind",
+		strings.Contains(text, "error: ValidationError: Person name cannot be empty\n"))
+	tf.RunTest("Unknown command - hint", strings.Contains(text, `unknown command "bogus" (try help)`))
+	tf.RunTest("help - lists commands", strings.Contains(text, "greet <name>"))
+	tf.RunTest("Prompt - printed", strings.HasPrefix(text, Prompt))
+
+	g2 := &namesGreeter{}
+	r2 := New(g2, strings.NewReader("quit\ngreet Alice\n"), &bytes.Buffer{}).Run(ctx)
+	tf.RunTest("quit - stops before later input", r2.IsOk() && len(g2.names) == 0)
+
+	// ========================================================================
+	// Test: History and recall
+	// ========================================================================
+
+	var out3 bytes.Buffer
+	g3 := &namesGreeter{}
+	r3 := New(g3, strings.NewReader("greet Ann\ngreet Bea\n!1\n!!\n!9\nhistory\n"), &out3)
+	r3.Run(ctx)
+	tf.RunTest("Recall - !1 and !! re-run", strings.Join(g3.names, ",") == "Ann,Bea,Ann,Ann")
+	tf.RunTest("Recall - missing entry reported", strings.Contains(out3.String(), `no history entry "!9"`))
+	tf.RunTest("History - expanded commands recorded",
+		strings.Join(r3.History(), "|") == "greet Ann|greet Bea|greet Ann|greet Ann|history")
+	tf.RunTest("History - numbered listing", strings.Contains(out3.String(), "   2  greet Bea\n"))
+
+	// ========================================================================
+	// Test: Cancellation
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	r4 := New(&namesGreeter{}, strings.NewReader("greet Alice\n"), &bytes.Buffer{}).Run(cancelled)
+	tf.RunTest("Cancelled - InfrastructureError", r4.IsError() && r4.ErrorInfo().Kind == domerr.InfrastructureError)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: main (wasm)
+// Description: Browser (GOOS=js GOARCH=wasm) composition root
+
+//go:build js && wasm
+
+// Command wasm runs the greet use case in WebAssembly hosts.
+//
+// Browser (GOOS=js GOARCH=wasm) - exports a global JS function:
+//
+//	greet(name, [callback]) -> {ok: true} | {ok: false, kind: "...", message: "..."}
+//
+// The greeting goes to callback(message) when given (e.g. to update the DOM),
+// otherwise to console.log.
+//
+// WASI (GOOS=wasip1 GOARCH=wasm) - a command that greets os.Args[1] on stdout.
+//
+// Build:
+//
+//	cd api/adapter/wasm
+//	GOOS=js GOARCH=wasm go build -o greet.wasm .
+//	cp "$(go env GOROOT)/lib/wasm/wasm_exec.js" .
+//	GOOS=wasip1 GOARCH=wasm go build -o greet-wasi.wasm .
+//	wasmtime greet-wasi.wasm Alice
+//
+// Architecture Notes:
+//   - Platform-specific composition root, sibling of api/adapter/desktop
+//   - Wires infrastructure adapter.JSWriter into the application use case
+package main
+
+import (
+	"context"
+	"syscall/js"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+func main() {
+	greet := js.FuncOf(func(_ js.Value, args []js.Value) any {
+		var name string
+		callback := js.Undefined()
+		if len(args) > 0 {
+			name = args[0].String()
+		}
+		if len(args) > 1 {
+			callback = args[1]
+		}
+
+		uc := usecase.NewGreetUseCase[*adapter.JSWriter](adapter.NewJSWriter(callback))
+		result := uc.Execute(context.Background(), api.NewGreetCommand(name))
+		if result.IsOk() {
+			return map[string]any{"ok": true}
+		}
+		info := result.ErrorInfo()
+		return map[string]any{"ok": false, "kind": info.Kind.String(), "message": info.Message}
+	})
+	js.Global().Set("greet", greet)
+
+	// Keep the Go runtime alive so JS can keep calling greet.
+	select {}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: main (wasm)
+// Description: WASI (GOOS=wasip1 GOARCH=wasm) composition root
+
+//go:build wasip1
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/abitofhelp/hybrid_lib_go/api"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+// main greets os.Args[1] on stdout; exit code 1 on validation error,
+// 2 on infrastructure error, 3 when overloaded, 4 on a stale version
+// (same codes as the C export).
+func main() {
+	name := ""
+	if len(os.Args) > 1 {
+		name = os.Args[1]
+	}
+
+	uc := usecase.NewGreetUseCase[*adapter.ConsoleWriter](adapter.NewConsoleWriter())
+	result := uc.Execute(context.Background(), api.NewGreetCommand(name))
+	if result.IsOk() {
+		return
+	}
+
+	info := result.ErrorInfo()
+	fmt.Fprintf(os.Stderr, "%s: %s\n", info.Kind, info.Message)
+	switch info.Kind {
+	case api.ValidationError:
+		os.Exit(1)
+	case api.OverloadedError:
+		os.Exit(3)
+	case api.StaleVersionError:
+		os.Exit(4)
+	default:
+		os.Exit(2)
+	}
+}
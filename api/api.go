@@ -36,6 +36,7 @@ import (
 	"github.com/abitofhelp/hybrid_lib_go/application/model"
 	"github.com/abitofhelp/hybrid_lib_go/application/port/inbound"
 	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/query"
 	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
 	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
 )
@@ -57,10 +58,19 @@ type ErrorKind = domerr.ErrorKind
 // Person is an immutable value object representing a person's name.
 type Person = valueobject.Person
 
+// Option represents a value that may or may not be present.
+type Option[T any] = valueobject.Option[T]
+
+// Versioned pairs an entity with the version it was read at (optimistic
+// concurrency token).
+type Versioned[T any] = valueobject.Versioned[T]
+
 // Error kind constants
 const (
 	ValidationError     = domerr.ValidationError
 	InfrastructureError = domerr.InfrastructureError
+	OverloadedError     = domerr.OverloadedError
+	StaleVersionError   = domerr.StaleVersionError
 )
 
 // Ok creates a successful Result containing the given value.
@@ -73,11 +83,23 @@ func Err[T any](err ErrorType) Result[T] {
 	return domerr.Err[T](err)
 }
 
+// SetErrorTraceDepth enables stack trace capture (up to depth frames) for
+// errors created with NewInfrastructureErrorTrace; 0 disables it.
+// Call once from the composition root; the setting is process-wide.
+func SetErrorTraceDepth(depth int) {
+	domerr.SetTraceDepth(depth)
+}
+
 // CreatePerson creates a new Person value object with validation.
 func CreatePerson(name string) Result[Person] {
 	return valueobject.CreatePerson(name)
 }
 
+// NewVersioned wraps value as read at version (0 = never stored).
+func NewVersioned[T any](value T, version uint64) Versioned[T] {
+	return valueobject.NewVersioned(value, version)
+}
+
 // MaxNameLength is the maximum allowed length for a person's name.
 const MaxNameLength = valueobject.MaxNameLength
 
@@ -88,6 +110,21 @@ const MaxNameLength = valueobject.MaxNameLength
 // Unit represents a void/unit type for operations that return no value.
 type Unit = model.Unit
 
+// Lease is proof of ownership of an exclusive lock.
+type Lease = model.Lease
+
+// PolicyDecision is the allow/mask/reject verdict of a ContentPolicyPort.
+type PolicyDecision = model.PolicyDecision
+
+// GreetingRecord is the read model describing one delivered greeting.
+type GreetingRecord = model.GreetingRecord
+
+// ErrorReport describes an unexpected failure or recovered panic for error tracking.
+type ErrorReport = model.ErrorReport
+
+// Secret holds sensitive bytes that are redacted when printed and zeroized on Release.
+type Secret = model.Secret
+
 // GreetCommand is a command DTO for the greet use case.
 type GreetCommand = command.GreetCommand
 
@@ -101,3 +138,129 @@ type GreetPort = inbound.GreetPort
 
 // WriterPort is the output port interface for writing messages.
 type WriterPort = outbound.WriterPort
+
+// CachePort is the output port interface for key/value caching.
+type CachePort = outbound.CachePort
+
+// LockPort is the output port interface for exclusive, time-bounded locks.
+type LockPort = outbound.LockPort
+
+// SecretPort is the output port interface for fetching credentials.
+type SecretPort = outbound.SecretPort
+
+// IDGeneratorPort is the output port interface for generating unique IDs.
+type IDGeneratorPort = outbound.IDGeneratorPort
+
+// ContentPolicyPort is the output port interface for moderating user-supplied text.
+type ContentPolicyPort = outbound.ContentPolicyPort
+
+// ErrorReporterPort is the output port interface for reporting unexpected errors.
+type ErrorReporterPort = outbound.ErrorReporterPort
+
+// HistoryPort is the output port interface for persisting greeting history.
+type HistoryPort = outbound.HistoryPort
+
+// EventPublisherPort is the output port interface for publishing greeting events.
+type EventPublisherPort = outbound.EventPublisherPort
+
+// WriteReceipt is delivery evidence returned by writers that support receipts.
+type WriteReceipt = model.WriteReceipt
+
+// ReceiptWriterPort is the optional WriterPort extension that returns a WriteReceipt.
+type ReceiptWriterPort = outbound.ReceiptWriterPort
+
+// BatchReceipt is delivery evidence for a batch written with WriteAll.
+type BatchReceipt = model.BatchReceipt
+
+// BatchWriterPort is the optional WriterPort extension that writes many messages at once.
+type BatchWriterPort = outbound.BatchWriterPort
+
+// BlobPort is the output port interface for streaming blob (object) storage.
+type BlobPort = outbound.BlobPort
+
+// BlobInfo describes one stored blob, as returned by BlobPort.List.
+type BlobInfo = model.BlobInfo
+
+// HistoryReaderPort is the output port interface for streaming greeting history.
+type HistoryReaderPort = outbound.HistoryReaderPort
+
+// ExportSummary describes a completed history export.
+type ExportSummary = model.ExportSummary
+
+// ExportHistoryCommand is a command DTO for the history export use case.
+type ExportHistoryCommand = command.ExportHistoryCommand
+
+// NewExportHistoryCommand creates an ExportHistoryCommand for format ("csv" or "json") and blob key.
+func NewExportHistoryCommand(format, key string) ExportHistoryCommand {
+	return command.NewExportHistoryCommand(format, key)
+}
+
+// ExportHistoryPort is the input port interface for the history export use case.
+type ExportHistoryPort = inbound.ExportHistoryPort
+
+// ErasureReport lists, per store, how many records of a data subject were erased.
+type ErasureReport = model.ErasureReport
+
+// StoreErasure is the erasure outcome for one store.
+type StoreErasure = model.StoreErasure
+
+// EraseSubjectCommand is a command DTO for the erase subject use case.
+type EraseSubjectCommand = command.EraseSubjectCommand
+
+// NewEraseSubjectCommand creates an EraseSubjectCommand for subject (a greeted name).
+func NewEraseSubjectCommand(subject string) EraseSubjectCommand {
+	return command.NewEraseSubjectCommand(subject)
+}
+
+// EraseSubjectPort is the input port interface for erasing a data subject's records.
+type EraseSubjectPort = inbound.EraseSubjectPort
+
+// SpoolPort is the output port interface for the local spool of undelivered commands.
+type SpoolPort = outbound.SpoolPort
+
+// SpoolEntry is a command held in the spool under its idempotency key.
+type SpoolEntry = model.SpoolEntry
+
+// SyncReport counts the spooled commands replayed, rejected and remaining after a sync.
+type SyncReport = model.SyncReport
+
+// SyncCommand is a command DTO for the spool sync use case.
+type SyncCommand = command.SyncCommand
+
+// NewSyncCommand creates a SyncCommand replaying every spooled command.
+func NewSyncCommand() SyncCommand {
+	return command.NewSyncCommand()
+}
+
+// SyncPort is the input port interface for replaying spooled commands.
+type SyncPort = inbound.SyncPort
+
+// ChangeDetectorPort is the output port interface for skipping unchanged output.
+type ChangeDetectorPort = outbound.ChangeDetectorPort
+
+// ClockPort is the output port interface for the current time.
+type ClockPort = outbound.ClockPort
+
+// RandomPort is the output port interface for seedable pseudo-random choices.
+type RandomPort = outbound.RandomPort
+
+// CryptoPort is the output port interface for authenticated encryption with managed keys.
+type CryptoPort = outbound.CryptoPort
+
+// Ciphertext is CryptoPort output tagged with the ID of the key that encrypted it.
+type Ciphertext = model.Ciphertext
+
+// Query bundles pagination and filtering for read use cases.
+type Query = query.Query
+
+// Filter restricts query results by field, operator and value.
+type Filter = query.Filter
+
+// PageRequest asks for at most Limit items after a cursor.
+type PageRequest = query.PageRequest
+
+// HistoryPage is one page of greeting history.
+type HistoryPage = query.Page[model.GreetingRecord]
+
+// QueryHistoryPort is the input port interface for paging through greeting history.
+type QueryHistoryPort = inbound.QueryHistoryPort
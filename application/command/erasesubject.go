@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: DTO for the erase subject use case
+
+package command
+
+// EraseSubjectCommand is a Data Transfer Object for the erase subject use case.
+//
+// Subject is the person's name as it was greeted; it is validated by the
+// use case with the domain Person rules.
+type EraseSubjectCommand struct {
+	Subject string
+}
+
+// NewEraseSubjectCommand creates a new EraseSubjectCommand DTO.
+func NewEraseSubjectCommand(subject string) EraseSubjectCommand {
+	return EraseSubjectCommand{Subject: subject}
+}
+
+// GetSubject returns the data subject to erase.
+func (c EraseSubjectCommand) GetSubject() string {
+	return c.Subject
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: DTO for the export history use case
+
+package command
+
+// ExportHistoryCommand is a Data Transfer Object for the export history use case.
+//
+// Format is "csv" or "json"; Key is the BlobPort key the export is stored
+// under. Both are validated by the use case.
+type ExportHistoryCommand struct {
+	Format string
+	Key    string
+}
+
+// NewExportHistoryCommand creates a new ExportHistoryCommand DTO.
+func NewExportHistoryCommand(format, key string) ExportHistoryCommand {
+	return ExportHistoryCommand{Format: format, Key: key}
+}
+
+// GetFormat returns the requested export format.
+func (c ExportHistoryCommand) GetFormat() string {
+	return c.Format
+}
+
+// GetKey returns the destination blob key.
+func (c ExportHistoryCommand) GetKey() string {
+	return c.Key
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: Idempotency key carried alongside a command
+
+package command
+
+import "context"
+
+// idempotencyKey is the context key for a command's idempotency key.
+type idempotencyKey struct{}
+
+// WithIdempotencyKey returns ctx carrying key for the command executed
+// with it. Adapters that talk to remote APIs forward the key (e.g. as an
+// Idempotency-Key header) so a repeated delivery is applied once.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKey returns the idempotency key carried by ctx, if any.
+func IdempotencyKey(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKey{}).(string)
+	return key, ok && key != ""
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: DTO for the spool sync use case
+
+package command
+
+// SyncCommand is a Data Transfer Object for the spool sync use case.
+//
+// Limit caps the entries replayed in one pass; 0 replays them all.
+type SyncCommand struct {
+	Limit int
+}
+
+// NewSyncCommand creates a SyncCommand replaying every spooled entry.
+func NewSyncCommand() SyncCommand {
+	return SyncCommand{}
+}
+
+// GetLimit returns the maximum number of entries to replay (0 = all).
+func (c SyncCommand) GetLimit() int {
+	return c.Limit
+}
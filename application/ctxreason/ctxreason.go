@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: ctxreason
+// Description: Structured reasons for context cancellation
+
+// Package ctxreason records why a context was cancelled, so the errors it
+// causes can tell a server shutdown from a client that went away or a
+// deadline that passed.
+//
+// How reasons reach errors:
+//   - The reason is stored as the context's cancellation cause
+//     (context.WithCancelCause / WithTimeoutCause)
+//   - Adapters report context.Cause(ctx) in their "... cancelled: ..."
+//     messages, so the reason is part of the resulting ErrorType
+//   - FromError recovers the Reason from such an ErrorType, for
+//     dashboards and logs
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer
+//   - Depends only on domain types and the standard library
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/application/ctxreason"
+//
+//	ctx, cancel := ctxreason.WithCancel(context.Background())
+//	go func() { <-sigterm; cancel(ctxreason.Shutdown) }()
+//
+//	result := greeter.Execute(ctx, cmd)
+//	if result.IsError() && ctxreason.FromError(result.ErrorInfo()) == ctxreason.Shutdown {
+//	    // expected during shutdown; do not alert
+//	}
+package ctxreason
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Reason says why a context ended.
+type Reason string
+
+// Reasons. Applications may define their own; any non-empty string works.
+const (
+	// None means the context is not done (or the error is not a cancellation).
+	None Reason = ""
+
+	// Shutdown is the server stopping (signal, orchestrator).
+	Shutdown Reason = "shutdown"
+
+	// ClientDisconnect is the caller going away before the work finished.
+	ClientDisconnect Reason = "client_disconnect"
+
+	// Deadline is a timeout or deadline passing.
+	Deadline Reason = "deadline"
+
+	// Cancelled is a cancellation without a recorded reason.
+	Cancelled Reason = "cancelled"
+)
+
+// reasonPattern finds the reason in a cause's message.
+var reasonPattern = regexp.MustCompile(`\(reason: ([^)]+)\)`)
+
+// cause is the cancellation cause carrying a Reason. It matches
+// context.Canceled (or context.DeadlineExceeded for Deadline) with
+// errors.Is, so code checking those keeps working.
+type cause struct {
+	reason Reason
+}
+
+func (c *cause) Error() string {
+	if c.reason == Deadline {
+		return fmt.Sprintf("context deadline exceeded (reason: %s)", c.reason)
+	}
+	return fmt.Sprintf("context canceled (reason: %s)", c.reason)
+}
+
+func (c *cause) Is(target error) bool {
+	if c.reason == Deadline {
+		return target == context.DeadlineExceeded
+	}
+	return target == context.Canceled
+}
+
+// Err returns the cancellation cause for reason, for use with
+// context.WithCancelCause and friends.
+func Err(reason Reason) error {
+	return &cause{reason: reason}
+}
+
+// WithCancel returns a child of parent and a cancel function taking the
+// reason. Calling it again has no effect.
+func WithCancel(parent context.Context) (context.Context, func(Reason)) {
+	ctx, cancel := context.WithCancelCause(parent)
+	return ctx, func(reason Reason) { cancel(Err(reason)) }
+}
+
+// WithTimeout is context.WithTimeout with Deadline recorded as the reason
+// when the timeout fires.
+func WithTimeout(parent context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeoutCause(parent, d, Err(Deadline))
+}
+
+// Of returns why ctx ended, or None while it is live.
+//
+// Contract:
+//   - A reason recorded with WithCancel/Err is returned as-is
+//   - A plain deadline is Deadline; a plain cancellation is Cancelled
+func Of(ctx context.Context) Reason {
+	if ctx.Err() == nil {
+		return None
+	}
+	return fromCause(context.Cause(ctx))
+}
+
+// FromError returns the cancellation reason recorded in err's message by
+// an adapter, or None if err is not a cancellation.
+//
+// Only InfrastructureError is inspected: adapters report cancellation with
+// that kind, and other kinds may quote user input (a validation message
+// naming "context canceled") that must not be mistaken for a reason.
+func FromError(err domerr.ErrorType) Reason {
+	if err.Kind != domerr.InfrastructureError {
+		return None
+	}
+	if m := reasonPattern.FindStringSubmatch(err.Message); m != nil {
+		return Reason(m[1])
+	}
+	switch {
+	case strings.Contains(err.Message, context.DeadlineExceeded.Error()):
+		return Deadline
+	case strings.Contains(err.Message, context.Canceled.Error()):
+		return Cancelled
+	default:
+		return None
+	}
+}
+
+// fromCause classifies a context cause.
+func fromCause(err error) Reason {
+	var c *cause
+	switch {
+	case errors.As(err, &c):
+		return c.reason
+	case errors.Is(err, context.DeadlineExceeded):
+		return Deadline
+	default:
+		return Cancelled
+	}
+}
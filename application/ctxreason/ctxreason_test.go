@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package ctxreason
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationCtxReason tests cancellation reasons end to end.
+func TestApplicationCtxReason(t *testing.T) {
+	tf := test.New("Application.CtxReason")
+	bg := context.Background()
+
+	// adapterErr builds the error an adapter reports for a done ctx.
+	adapterErr := func(ctx context.Context) domerr.ErrorType {
+		return domerr.NewInfrastructureError(fmt.Sprintf("write cancelled: %v", context.Cause(ctx)))
+	}
+
+	// ========================================================================
+	// Test: Recording and reading reasons
+	// ========================================================================
+
+	ctx, cancel := WithCancel(bg)
+	tf.RunTest("Live context - None", Of(ctx) == None)
+	cancel(ClientDisconnect)
+	cancel(Shutdown)
+	tf.RunTest("Cancelled - first reason wins", Of(ctx) == ClientDisconnect)
+	tf.RunTest("Cancelled - still context.Canceled", errors.Is(ctx.Err(), context.Canceled) &&
+		errors.Is(context.Cause(ctx), context.Canceled))
+	tf.RunTest("Adapter error - reason recovered", FromError(adapterErr(ctx)) == ClientDisconnect)
+
+	child, stop := context.WithCancel(ctx)
+	defer stop()
+	tf.RunTest("Child context - inherits reason", Of(child) == ClientDisconnect)
+
+	tctx, tcancel := WithTimeout(bg, time.Millisecond)
+	defer tcancel()
+	<-tctx.Done()
+	tf.RunTest("Timeout - Deadline", Of(tctx) == Deadline && FromError(adapterErr(tctx)) == Deadline)
+	tf.RunTest("Timeout - matches DeadlineExceeded", errors.Is(context.Cause(tctx), context.DeadlineExceeded))
+
+	// ========================================================================
+	// Test: Contexts without reasons
+	// ========================================================================
+
+	plain, plainCancel := context.WithCancel(bg)
+	plainCancel()
+	tf.RunTest("Plain cancel - Cancelled", Of(plain) == Cancelled && FromError(adapterErr(plain)) == Cancelled)
+
+	expired, expiredCancel := context.WithDeadline(bg, time.Now().Add(-time.Second))
+	defer expiredCancel()
+	tf.RunTest("Plain deadline - Deadline", Of(expired) == Deadline && FromError(adapterErr(expired)) == Deadline)
+
+	tf.RunTest("Other error - None", FromError(domerr.NewInfrastructureError("disk full")) == None)
+	tf.RunTest("Other kind quoting a reason - None",
+		FromError(domerr.NewValidationError("name \"context canceled (reason: shutdown)\" is invalid")) == None)
+
+	tf.Summary(t)
+}
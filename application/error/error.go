@@ -44,6 +44,8 @@ type ErrorKind = domerr.ErrorKind
 const (
 	ValidationError     = domerr.ValidationError
 	InfrastructureError = domerr.InfrastructureError
+	OverloadedError     = domerr.OverloadedError
+	StaleVersionError   = domerr.StaleVersionError
 )
 
 // ErrorType is the concrete error type (re-exported from domain)
@@ -56,6 +58,9 @@ type Result[T any] = domerr.Result[T]
 
 // Constructor functions (re-exported from domain)
 var (
-	NewValidationError     = domerr.NewValidationError
-	NewInfrastructureError = domerr.NewInfrastructureError
+	NewValidationError          = domerr.NewValidationError
+	NewInfrastructureError      = domerr.NewInfrastructureError
+	NewInfrastructureErrorTrace = domerr.NewInfrastructureErrorTrace
+	NewOverloadedError          = domerr.NewOverloadedError
+	NewStaleVersionError        = domerr.NewStaleVersionError
 )
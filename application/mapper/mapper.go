@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: mapper
+// Description: Generic conversion helpers between domain models and DTOs
+
+// Package mapper provides generic helpers for converting between domain
+// entities/value objects and application DTOs (commands, responses), so use
+// cases do not accumulate hand-written loops and error plumbing.
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer
+//   - Pure functions; depends only on the domain layer
+//   - Fallible conversions return Result and stop at the first error,
+//     matching the railway-oriented style of the rest of the codebase
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/application/mapper"
+//
+//	// Validate a batch of raw names into domain Persons
+//	people := mapper.TraverseSlice(names, valueobject.CreatePerson)
+//
+//	// Field-wise DTO mapping
+//	toDTO := mapper.Fields[valueobject.Person, PersonDTO]().
+//	    Set(func(p valueobject.Person, d *PersonDTO) { d.Name = p.GetName() })
+//	dto := toDTO.Map(person) // Result[PersonDTO]
+package mapper
+
+import (
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MapSlice applies f to every element. A nil input yields a nil output.
+func MapSlice[T any, U any](items []T, f func(T) U) []U {
+	if items == nil {
+		return nil
+	}
+	out := make([]U, len(items))
+	for i, item := range items {
+		out[i] = f(item)
+	}
+	return out
+}
+
+// MapResult converts the Ok value of r with f; an Err is passed through.
+// Equivalent to domerr.MapTo, provided here so mapping code reads uniformly.
+func MapResult[T any, U any](r domerr.Result[T], f func(T) U) domerr.Result[U] {
+	return domerr.MapTo(r, f)
+}
+
+// TraverseSlice applies a fallible conversion to every element.
+//
+// Contract:
+//   - Returns Ok(all converted values, in order) if every call succeeds
+//   - Returns the first Err otherwise; later elements are not converted
+func TraverseSlice[T any, U any](items []T, f func(T) domerr.Result[U]) domerr.Result[[]U] {
+	out := make([]U, 0, len(items))
+	for _, item := range items {
+		r := f(item)
+		if r.IsError() {
+			return domerr.Err[[]U](r.ErrorInfo())
+		}
+		out = append(out, r.Value())
+	}
+	return domerr.Ok(out)
+}
+
+// Builder maps a source S to a destination D field by field.
+//
+// Steps run in the order they were added against a zero D. Set steps
+// cannot fail; Check steps may reject the source and stop the mapping.
+// A Builder is immutable once shared: Set and Check return a new Builder.
+type Builder[S any, D any] struct {
+	steps []func(S, *D) domerr.Result[model.Unit]
+}
+
+// Fields starts an empty Builder from S to D.
+func Fields[S any, D any]() Builder[S, D] {
+	return Builder[S, D]{}
+}
+
+// Set adds an infallible step that copies or derives fields.
+func (b Builder[S, D]) Set(step func(src S, dst *D)) Builder[S, D] {
+	return b.with(func(src S, dst *D) domerr.Result[model.Unit] {
+		step(src, dst)
+		return domerr.Ok(model.UnitValue)
+	})
+}
+
+// Check adds a fallible step; an Err aborts the mapping.
+func (b Builder[S, D]) Check(step func(src S, dst *D) domerr.Result[model.Unit]) Builder[S, D] {
+	return b.with(step)
+}
+
+// Map runs all steps, returning the mapped value or the first error.
+func (b Builder[S, D]) Map(src S) domerr.Result[D] {
+	var dst D
+	for _, step := range b.steps {
+		if r := step(src, &dst); r.IsError() {
+			return domerr.Err[D](r.ErrorInfo())
+		}
+	}
+	return domerr.Ok(dst)
+}
+
+// MapSlice maps every source with Map, stopping at the first error.
+func (b Builder[S, D]) MapSlice(items []S) domerr.Result[[]D] {
+	return TraverseSlice(items, b.Map)
+}
+
+// with returns a copy of b with step appended (no aliasing between copies).
+func (b Builder[S, D]) with(step func(S, *D) domerr.Result[model.Unit]) Builder[S, D] {
+	steps := make([]func(S, *D) domerr.Result[model.Unit], len(b.steps), len(b.steps)+1)
+	copy(steps, b.steps)
+	return Builder[S, D]{steps: append(steps, step)}
+}
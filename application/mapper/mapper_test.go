@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package mapper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/mapper"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// personDTO is a response DTO used to exercise Builder.
+type personDTO struct {
+	Name     string
+	Greeting string
+}
+
+// TestApplicationMapper tests the generic mapping helpers.
+func TestApplicationMapper(t *testing.T) {
+	tf := test.New("Application.Mapper")
+
+	// ========================================================================
+	// Test: MapSlice and MapResult
+	// ========================================================================
+
+	cmds := mapper.MapSlice([]string{"Alice", "Bob"}, command.NewGreetCommand)
+	tf.RunTest("MapSlice - converts in order", len(cmds) == 2 && cmds[1].Name == "Bob")
+	tf.RunTest("MapSlice - nil stays nil", mapper.MapSlice[string, int](nil, func(string) int { return 0 }) == nil)
+
+	r1 := mapper.MapResult(valueobject.CreatePerson("Alice"), valueobject.Person.GetName)
+	tf.RunTest("MapResult Ok - mapped", r1.IsOk() && r1.Value() == "Alice")
+	r2 := mapper.MapResult(valueobject.CreatePerson(""), valueobject.Person.GetName)
+	tf.RunTest("MapResult Err - passed through", r2.IsError())
+
+	// ========================================================================
+	// Test: TraverseSlice stops at first error
+	// ========================================================================
+
+	r3 := mapper.TraverseSlice([]string{"Alice", "Bob"}, valueobject.CreatePerson)
+	tf.RunTest("TraverseSlice all valid - IsOk", r3.IsOk() && len(r3.Value()) == 2)
+
+	calls := 0
+	r4 := mapper.TraverseSlice([]string{"Alice", "", "Bob"}, func(s string) domerr.Result[valueobject.Person] {
+		calls++
+		return valueobject.CreatePerson(s)
+	})
+	tf.RunTest("TraverseSlice invalid - IsError", r4.IsError())
+	tf.RunTest("TraverseSlice invalid - stops early", calls == 2)
+
+	// ========================================================================
+	// Test: Field-wise Builder
+	// ========================================================================
+
+	toDTO := mapper.Fields[valueobject.Person, personDTO]().
+		Set(func(p valueobject.Person, d *personDTO) { d.Name = p.GetName() }).
+		Set(func(p valueobject.Person, d *personDTO) { d.Greeting = p.GreetingMessage() })
+
+	alice := valueobject.CreatePerson("Alice").Value()
+	r5 := toDTO.Map(alice)
+	tf.RunTest("Builder Map - fields set", r5.IsOk() &&
+		r5.Value() == personDTO{Name: "Alice", Greeting: "Hello, Alice!"})
+
+	noB := toDTO.Check(func(p valueobject.Person, _ *personDTO) domerr.Result[model.Unit] {
+		if strings.HasPrefix(p.GetName(), "B") {
+			return domerr.Err[model.Unit](domerr.NewValidationError("B names not mapped"))
+		}
+		return domerr.Ok(model.UnitValue)
+	})
+	bob := valueobject.CreatePerson("Bob").Value()
+	tf.RunTest("Builder Check - rejects", noB.Map(bob).IsError())
+	tf.RunTest("Builder Check - does not alter original", toDTO.Map(bob).IsOk())
+
+	r6 := noB.MapSlice([]valueobject.Person{alice, bob})
+	tf.RunTest("Builder MapSlice - first error", r6.IsError() &&
+		r6.ErrorInfo().Message == "B names not mapped")
+
+	tf.Summary(t)
+}
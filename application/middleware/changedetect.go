@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Writer decorator that skips unchanged output
+
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ChangeDetectingWriter wraps an output port (not an inbound port like the
+// other decorators) and skips writes whose content equals what was last
+// written under the same key. Use it when output is regenerated
+// periodically and rewriting identical content is wasteful or noisy.
+//
+// Workflow:
+//  1. Hash the message (SHA-256)
+//  2. Ask the ChangeDetectorPort whether it differs from the last digest
+//  3. If unchanged, return a receipt with Skipped set; nothing is written
+//  4. Otherwise write, then record the new digest
+//
+// The key names the output being tracked (a file, a report, a channel);
+// one writer tracks one key. Concurrent writes to the same key may both
+// be written.
+//
+// Batches: it does not forward WriteAll, since each message is checked on
+// its own; a batch through it is written one message at a time.
+//
+// Implements: outbound.ReceiptWriterPort
+type ChangeDetectingWriter[W outbound.WriterPort, D outbound.ChangeDetectorPort] struct {
+	next     W
+	detector D
+	key      string
+	clock    outbound.ClockPort
+}
+
+// NewChangeDetectingWriter wraps next so that messages identical to the
+// last one written under key are skipped. clock stamps receipts
+// synthesized for a next without its own; if nil, writes return
+// NilClockError.
+func NewChangeDetectingWriter[W outbound.WriterPort, D outbound.ChangeDetectorPort](
+	next W, detector D, key string, clock outbound.ClockPort,
+) *ChangeDetectingWriter[W, D] {
+	return &ChangeDetectingWriter[W, D]{next: next, detector: detector, key: key, clock: clock}
+}
+
+// Write writes message unless it is unchanged. A skip is Ok.
+func (w *ChangeDetectingWriter[W, D]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.MapTo(w.WriteWithReceipt(ctx, message), func(model.WriteReceipt) model.Unit { return model.UnitValue })
+}
+
+// WriteWithReceipt writes message unless it is unchanged.
+//
+// Contract:
+//   - Returns Ok(receipt with Skipped) if message is unchanged
+//   - Returns Ok(the wrapped writer's receipt) after a write; for a writer
+//     without receipts one is synthesized from the message length and the
+//     completion time
+//   - Returns Err if the detector or the wrapped writer fails; nothing is
+//     recorded for a failed write
+//   - Returns Err if the write succeeded but recording failed; the next
+//     identical message is then written again
+func (w *ChangeDetectingWriter[W, D]) WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt] {
+	if w.clock == nil {
+		return domerr.Err[model.WriteReceipt](outbound.NilClockError("change-detecting writer"))
+	}
+	sum := sha256.Sum256([]byte(message))
+	digest := hex.EncodeToString(sum[:])
+
+	changed := w.detector.Changed(ctx, w.key, digest)
+	if changed.IsError() {
+		return domerr.Err[model.WriteReceipt](changed.ErrorInfo())
+	}
+	if !changed.Value() {
+		return domerr.Ok(model.WriteReceipt{Skipped: true})
+	}
+
+	written := outbound.WriteWithReceipt(ctx, w.next, message, w.clock)
+	if written.IsError() {
+		return written
+	}
+
+	if recorded := w.detector.Record(ctx, w.key, digest); recorded.IsError() {
+		return domerr.Err[model.WriteReceipt](domerr.NewInfrastructureError(fmt.Sprintf(
+			"output %q written but change not recorded: %s", w.key, recorded.ErrorInfo().Message)))
+	}
+	return written
+}
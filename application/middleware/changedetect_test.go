@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// fakeDetector keeps digests in a map.
+type fakeDetector struct {
+	digests    map[string]string
+	failRecord bool
+}
+
+func (d *fakeDetector) Changed(_ context.Context, key, digest string) domerr.Result[bool] {
+	prev, ok := d.digests[key]
+	return domerr.Ok(!ok || prev != digest)
+}
+
+func (d *fakeDetector) Record(_ context.Context, key, digest string) domerr.Result[model.Unit] {
+	if d.failRecord {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("store down"))
+	}
+	d.digests[key] = digest
+	return domerr.Ok(model.UnitValue)
+}
+
+// plainWriter is a WriterPort without receipts.
+type plainWriter struct {
+	lines []string
+	fail  bool
+}
+
+func (w *plainWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	if w.fail {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("disk full"))
+	}
+	w.lines = append(w.lines, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// receiptWriter is a WriterPort with receipts.
+type receiptWriter struct{ plainWriter }
+
+func (w *receiptWriter) WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt] {
+	return domerr.MapTo(w.Write(ctx, message), func(model.Unit) model.WriteReceipt {
+		return model.WriteReceipt{Bytes: len(message) + 1, Destination: "report.txt"}
+	})
+}
+
+// TestApplicationMiddlewareChangeDetectingWriter tests skipping unchanged output.
+func TestApplicationMiddlewareChangeDetectingWriter(t *testing.T) {
+	tf := test.New("Application.Middleware.ChangeDetectingWriter")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fixedClock{at}
+
+	// ========================================================================
+	// Test: Unchanged output is skipped
+	// ========================================================================
+
+	detector := &fakeDetector{digests: map[string]string{}}
+	inner := &receiptWriter{}
+	w := NewChangeDetectingWriter(inner, detector, "report", clock)
+
+	r1 := w.WriteWithReceipt(ctx, "Hello, Alice!")
+	tf.RunTest("First write - inner receipt", r1.IsOk() && !r1.Value().Skipped &&
+		r1.Value().Destination == "report.txt" && r1.Value().Bytes == 14)
+	r2 := w.WriteWithReceipt(ctx, "Hello, Alice!")
+	tf.RunTest("Same content - Skipped", r2.IsOk() && r2.Value().Skipped && r2.Value().Bytes == 0)
+	tf.RunTest("Same content - not written", len(inner.lines) == 1)
+	r3 := w.WriteWithReceipt(ctx, "Hello, Bob!")
+	tf.RunTest("Changed content - written", r3.IsOk() && !r3.Value().Skipped && len(inner.lines) == 2)
+	tf.RunTest("Write after skip - IsOk", w.Write(ctx, "Hello, Bob!").IsOk() && len(inner.lines) == 2)
+
+	other := NewChangeDetectingWriter(inner, detector, "other", clock)
+	tf.RunTest("Keys are independent", other.Write(ctx, "Hello, Bob!").IsOk() && len(inner.lines) == 3)
+
+	plain := &plainWriter{}
+	pw := NewChangeDetectingWriter(plain, &fakeDetector{digests: map[string]string{}}, "k", clock)
+	r4 := pw.WriteWithReceipt(ctx, "Hi")
+	tf.RunTest("Writer without receipts - synthesized from clock", r4.IsOk() && r4.Value().Bytes == 2 &&
+		r4.Value().WrittenAt.Equal(at))
+
+	// ========================================================================
+	// Test: Failures
+	// ========================================================================
+
+	failing := &plainWriter{fail: true}
+	fd := &fakeDetector{digests: map[string]string{}}
+	fw := NewChangeDetectingWriter(failing, fd, "k", clock)
+	tf.RunTest("Write failure - IsError", fw.Write(ctx, "Hi").IsError())
+	tf.RunTest("Write failure - nothing recorded", len(fd.digests) == 0)
+
+	rd := &fakeDetector{digests: map[string]string{}, failRecord: true}
+	rw := NewChangeDetectingWriter(&plainWriter{}, rd, "report", clock)
+	r5 := rw.Write(ctx, "Hi")
+	tf.RunTest("Record failure - IsError", r5.IsError() &&
+		strings.Contains(r5.ErrorInfo().Message, `"report" written but change not recorded: store down`))
+
+	// ========================================================================
+	// Test: Nil clock is misconfiguration
+	// ========================================================================
+
+	unclocked := &plainWriter{}
+	r6 := NewChangeDetectingWriter(unclocked, &fakeDetector{digests: map[string]string{}}, "k", nil).Write(ctx, "Hi")
+	tf.RunTest("Nil clock - InfrastructureError", r6.IsError() &&
+		r6.ErrorInfo().Message == "change-detecting writer misconfigured: clock is nil")
+	tf.RunTest("Nil clock - nothing written", len(unclocked.lines) == 0)
+
+	tf.Summary(t)
+}
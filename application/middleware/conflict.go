@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Retry-on-conflict decorator for optimistic concurrency
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// maxConflictJitter bounds the random pause between conflicting attempts.
+const maxConflictJitter = 10 * time.Millisecond
+
+// RetryOnConflict re-executes the wrapped handler when it fails with a
+// StaleVersionError, i.e. when another writer updated the entity between
+// the handler's read and its write (see valueobject.Versioned).
+//
+// Each attempt must re-read the entity: the handler, not this decorator,
+// performs the whole read-modify-write. Retrying a conflict is therefore
+// safe even for non-idempotent changes, because the losing write did not
+// happen.
+//
+// Between attempts it pauses a random 0-10ms, drawn from a RandomPort, so
+// that writers racing on the same entity do not collide again in lockstep;
+// there is no exponential backoff, as a conflict means the store is
+// healthy, only contended.
+//
+// Not retried: Ok results and every other error kind (wrap with Retry for
+// transient infrastructure failures).
+//
+// Implements: the same inbound port as H
+type RetryOnConflict[C any, T any, H Handler[C, T], R outbound.RandomPort] struct {
+	next     H
+	attempts int
+	random   R
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetryOnConflict wraps next with up to attempts total executions
+// (attempts < 1 is treated as 1), pausing for a jitter drawn from random
+// between them.
+//
+// Usage:
+//
+//	r := middleware.NewRetryOnConflict(handler, 5, adapter.NewMathRandom())
+func NewRetryOnConflict[C any, T any, H Handler[C, T], R outbound.RandomPort](
+	next H, attempts int, random R,
+) *RetryOnConflict[C, T, H, R] {
+	return &RetryOnConflict[C, T, H, R]{
+		next:     next,
+		attempts: max(attempts, 1),
+		random:   random,
+		sleep:    sleepContext,
+	}
+}
+
+// Execute runs the wrapped handler, retrying version conflicts.
+//
+// Contract:
+//   - Returns the first Result that is not a StaleVersionError unchanged
+//   - Returns the last StaleVersionError once attempts are exhausted or
+//     ctx is done
+func (r *RetryOnConflict[C, T, H, R]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	for attempt := 1; ; attempt++ {
+		result := r.next.Execute(ctx, cmd)
+		if result.IsOk() || result.ErrorInfo().Kind != domerr.StaleVersionError || attempt >= r.attempts {
+			return result
+		}
+		if ctx.Err() != nil {
+			return result
+		}
+		if err := r.sleep(ctx, r.jitter()); err != nil {
+			return result
+		}
+	}
+}
+
+// jitter returns a random pause in [0, maxConflictJitter).
+func (r *RetryOnConflict[C, T, H, R]) jitter() time.Duration {
+	return time.Duration(r.random.IntN(int(maxConflictJitter)))
+}
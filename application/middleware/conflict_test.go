@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// stepRandom is a RandomPort returning 0, 1, 2, ... modulo n.
+type stepRandom struct{ next int }
+
+func (r *stepRandom) IntN(n int) int {
+	v := r.next % n
+	r.next++
+	return v
+}
+
+// TestApplicationMiddlewareRetryOnConflict tests the RetryOnConflict decorator.
+func TestApplicationMiddlewareRetryOnConflict(t *testing.T) {
+	tf := test.New("Application.Middleware.RetryOnConflict")
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+	stale := domerr.Err[model.Unit](domerr.NewStaleVersionError("stale version: read 1, stored 2"))
+
+	newRetry := func(h *flakyHandler, attempts int) (*RetryOnConflict[command.GreetCommand, model.Unit, *flakyHandler, *stepRandom], *int) {
+		pauses := 0
+		r := NewRetryOnConflict(h, attempts, &stepRandom{})
+		r.sleep = func(_ context.Context, _ time.Duration) error {
+			pauses++
+			return nil
+		}
+		return r, &pauses
+	}
+
+	// ========================================================================
+	// Test: Conflicts are retried
+	// ========================================================================
+
+	h1 := &flakyHandler{results: []domerr.Result[model.Unit]{stale, stale}}
+	r1, pauses1 := newRetry(h1, 5)
+	tf.RunTest("Conflict - eventually IsOk", r1.Execute(ctx, cmd).IsOk())
+	tf.RunTest("Conflict - three attempts", h1.calls == 3)
+	tf.RunTest("Conflict - pause between attempts", *pauses1 == 2)
+
+	h2 := &flakyHandler{results: []domerr.Result[model.Unit]{stale, stale, stale}}
+	r2, _ := newRetry(h2, 2)
+	r2result := r2.Execute(ctx, cmd)
+	tf.RunTest("Attempts exhausted - last conflict returned",
+		r2result.IsError() && r2result.ErrorInfo().Kind == domerr.StaleVersionError)
+	tf.RunTest("Attempts exhausted - two calls", h2.calls == 2)
+
+	// ========================================================================
+	// Test: Other outcomes are not retried
+	// ========================================================================
+
+	h3 := &flakyHandler{results: []domerr.Result[model.Unit]{
+		domerr.Err[model.Unit](domerr.NewInfrastructureError("unavailable"))}}
+	r3, _ := newRetry(h3, 3)
+	tf.RunTest("InfrastructureError - not retried", r3.Execute(ctx, cmd).IsError() && h3.calls == 1)
+
+	h4 := &flakyHandler{}
+	r4, pauses4 := newRetry(h4, 3)
+	tf.RunTest("Ok - single call", r4.Execute(ctx, cmd).IsOk() && h4.calls == 1 && *pauses4 == 0)
+
+	h5 := &flakyHandler{results: []domerr.Result[model.Unit]{stale, stale}}
+	r5 := NewRetryOnConflict(h5, 3, &stepRandom{})
+	done, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled ctx - no retry", r5.Execute(done, cmd).IsError() && h5.calls == 1)
+
+	// ========================================================================
+	// Test: Jitter comes from the RandomPort and stays bounded
+	// ========================================================================
+
+	h6 := &flakyHandler{results: []domerr.Result[model.Unit]{stale, stale}}
+	r6 := NewRetryOnConflict(h6, 3, &stepRandom{next: 41})
+	var waits6 []time.Duration
+	r6.sleep = func(_ context.Context, d time.Duration) error {
+		waits6 = append(waits6, d)
+		return nil
+	}
+	r6.Execute(ctx, cmd)
+	tf.RunTest("Jitter - drawn from RandomPort", len(waits6) == 2 && waits6[0] == 41 && waits6[1] == 42)
+
+	r7 := NewRetryOnConflict(&flakyHandler{}, 3, &stepRandom{next: int(maxConflictJitter) - 1})
+	tf.RunTest("Jitter - within [0, 10ms)", r7.jitter() == maxConflictJitter-1 && r7.jitter() == 0)
+
+	tf.Summary(t)
+}
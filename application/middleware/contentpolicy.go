@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Content policy decorator backed by ContentPolicyPort
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ContentPolicy consults a ContentPolicyPort about a command field before
+// the wrapped handler runs, rejecting or masking disallowed text.
+//
+// Workflow:
+//  1. Read the field to check from the command
+//  2. Ask the policy; on PolicyReject return a ValidationError
+//  3. On PolicyMask replace the field with the masked text
+//  4. Execute the wrapped handler
+//
+// Implements: the same inbound port as H
+type ContentPolicy[C any, T any, H Handler[C, T], P outbound.ContentPolicyPort] struct {
+	next   H
+	policy P
+	get    func(C) string
+	set    func(C, string) C
+}
+
+// NewContentPolicy wraps next so the command field selected by get/set is
+// checked against policy first.
+//
+// Usage:
+//
+//	uc := middleware.NewContentPolicy(greetUC, denyList,
+//	    func(cmd command.GreetCommand) string { return cmd.Name },
+//	    func(cmd command.GreetCommand, s string) command.GreetCommand { cmd.Name = s; return cmd })
+func NewContentPolicy[C any, T any, H Handler[C, T], P outbound.ContentPolicyPort](
+	next H, policy P, get func(C) string, set func(C, string) C,
+) *ContentPolicy[C, T, H, P] {
+	return &ContentPolicy[C, T, H, P]{next: next, policy: policy, get: get, set: set}
+}
+
+// Execute applies the policy decision and delegates to the wrapped handler.
+//
+// Contract:
+//   - Returns Err(ValidationError) without calling next if the text is rejected
+//   - Returns the policy's Err (fail closed) without calling next if the
+//     policy backend fails
+//   - Otherwise returns next's Result unchanged
+func (p *ContentPolicy[C, T, H, P]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	decisionResult := p.policy.Check(ctx, p.get(cmd))
+	if decisionResult.IsError() {
+		return domerr.Err[T](decisionResult.ErrorInfo())
+	}
+
+	decision := decisionResult.Value()
+	switch decision.Action {
+	case model.PolicyReject:
+		reason := decision.Reason
+		if reason == "" {
+			reason = "input is not allowed"
+		}
+		return domerr.Err[T](domerr.NewValidationError(reason))
+	case model.PolicyMask:
+		cmd = p.set(cmd, decision.Text)
+	}
+
+	return p.next.Execute(ctx, cmd)
+}
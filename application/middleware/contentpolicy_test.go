@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// fakePolicy returns a fixed decision (or error) for every input.
+type fakePolicy struct {
+	decision model.PolicyDecision
+	fail     bool
+}
+
+func (p fakePolicy) Check(_ context.Context, _ string) domerr.Result[model.PolicyDecision] {
+	if p.fail {
+		return domerr.Err[model.PolicyDecision](domerr.NewInfrastructureError("moderation unavailable"))
+	}
+	return domerr.Ok(p.decision)
+}
+
+// TestApplicationMiddlewareContentPolicy tests the ContentPolicy decorator.
+func TestApplicationMiddlewareContentPolicy(t *testing.T) {
+	tf := test.New("Application.Middleware.ContentPolicy")
+	ctx := context.Background()
+
+	getName := func(cmd command.GreetCommand) string { return cmd.Name }
+	setName := func(cmd command.GreetCommand, name string) command.GreetCommand {
+		cmd.Name = name
+		return cmd
+	}
+	cmd := command.NewGreetCommand("Alice")
+
+	// ========================================================================
+	// Test: Allow passes the command through unchanged
+	// ========================================================================
+
+	inner1 := &recordingHandler{}
+	allow := fakePolicy{decision: model.PolicyDecision{Action: model.PolicyAllow, Text: "Alice"}}
+	r1 := NewContentPolicy(inner1, allow, getName, setName).Execute(ctx, cmd)
+	tf.RunTest("Allow - IsOk", r1.IsOk())
+	tf.RunTest("Allow - name unchanged", inner1.last.Name == "Alice")
+
+	// ========================================================================
+	// Test: Mask replaces the field
+	// ========================================================================
+
+	inner2 := &recordingHandler{}
+	mask := fakePolicy{decision: model.PolicyDecision{Action: model.PolicyMask, Text: "A****"}}
+	r2 := NewContentPolicy(inner2, mask, getName, setName).Execute(ctx, cmd)
+	tf.RunTest("Mask - IsOk", r2.IsOk())
+	tf.RunTest("Mask - inner sees masked name", inner2.last.Name == "A****")
+
+	// ========================================================================
+	// Test: Reject returns ValidationError without calling next
+	// ========================================================================
+
+	inner3 := &recordingHandler{}
+	reject := fakePolicy{decision: model.PolicyDecision{Action: model.PolicyReject, Reason: "name is not allowed"}}
+	r3 := NewContentPolicy(inner3, reject, getName, setName).Execute(ctx, cmd)
+	tf.RunTest("Reject - IsError", r3.IsError())
+	tf.RunTest("Reject - ValidationError kind",
+		r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Reject - reason as message",
+		r3.IsError() && r3.ErrorInfo().Message == "name is not allowed")
+	tf.RunTest("Reject - inner not called", inner3.last.Name == "")
+
+	// ========================================================================
+	// Test: Policy backend failure fails closed
+	// ========================================================================
+
+	inner4 := &recordingHandler{}
+	r4 := NewContentPolicy(inner4, fakePolicy{fail: true}, getName, setName).Execute(ctx, cmd)
+	tf.RunTest("Backend failure - IsError", r4.IsError())
+	tf.RunTest("Backend failure - InfrastructureError kind",
+		r4.IsError() && r4.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("Backend failure - inner not called", inner4.last.Name == "")
+
+	tf.Summary(t)
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Duplicate-request suppression decorator backed by CachePort
+
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// dedupKeyPrefix namespaces fingerprints in a shared cache.
+const dedupKeyPrefix = "dedup:"
+
+// Dedup drops a command that exactly repeats one received within the
+// window, for upstreams that resend in retry storms. Commands are compared
+// by a fingerprint (SHA-256) of the fields the caller selects, so
+// incidental fields such as timestamps can be left out.
+//
+// Workflow:
+//  1. Fingerprint the command
+//  2. If the fingerprint is in the cache, refresh it and drop the command:
+//     the window slides, so a steady storm stays suppressed
+//  3. Otherwise store it with the window as ttl and execute the wrapped
+//     handler; if that fails the fingerprint is deleted so a genuine
+//     retry is not dropped
+//
+// Best effort: the check and the store are separate cache calls, so two
+// identical commands arriving together may both run. Use Exclusive when
+// overlap must be impossible. Cache failures never block a command.
+//
+// Implements: the same inbound port as H
+type Dedup[C any, T any, H Handler[C, T], K outbound.CachePort] struct {
+	next   H
+	cache  K
+	fields func(C) []string
+	window time.Duration
+}
+
+// NewDedup wraps next so exact duplicates within window are dropped.
+//
+// Parameters:
+//   - next: the handler (use case or inner decorator)
+//   - cache: the CachePort adapter (in-memory for one process, Redis for many)
+//   - fields: the command fields that make two commands the same, e.g.
+//     func(cmd GreetCommand) []string { return []string{cmd.Name} }
+//   - window: how long after the last occurrence a repeat is dropped; must
+//     be > 0, otherwise Execute reports misconfiguration without running next
+func NewDedup[C any, T any, H Handler[C, T], K outbound.CachePort](
+	next H, cache K, fields func(C) []string, window time.Duration,
+) *Dedup[C, T, H, K] {
+	return &Dedup[C, T, H, K]{next: next, cache: cache, fields: fields, window: window}
+}
+
+// Execute runs the wrapped handler unless cmd duplicates a recent command.
+//
+// Contract:
+//   - Returns Err(InfrastructureError) without calling next if window is
+//     not positive
+//   - Returns Err(ValidationError) without calling next for a duplicate;
+//     Retry does not retry it
+//   - Otherwise returns next's Result unchanged
+//   - A cache failure is ignored: the command runs as if not seen before
+func (d *Dedup[C, T, H, K]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	if d.window <= 0 {
+		return domerr.Err[T](domerr.NewInfrastructureError(fmt.Sprintf(
+			"dedup decorator misconfigured: window must be > 0, got %s", d.window)))
+	}
+	key := dedupKeyPrefix + fingerprint(d.fields(cmd))
+
+	seen := d.cache.Get(ctx, key)
+	if seen.IsOk() && seen.Value().IsSome() {
+		d.cache.Set(ctx, key, []byte{1}, d.window)
+		return domerr.Err[T](domerr.NewValidationError(fmt.Sprintf(
+			"duplicate request dropped: identical request received within %s", d.window)))
+	}
+	d.cache.Set(ctx, key, []byte{1}, d.window)
+
+	result := d.next.Execute(ctx, cmd)
+	if result.IsError() {
+		d.cache.Delete(context.WithoutCancel(ctx), key)
+	}
+	return result
+}
+
+// fingerprint hashes fields unambiguously: each field is length-prefixed,
+// so ("ab", "c") and ("a", "bc") differ.
+func fingerprint(fields []string) string {
+	h := sha256.New()
+	for _, f := range fields {
+		fmt.Fprintf(h, "%d:%s", len(f), f)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// clockCache is a CachePort that expires entries against a settable clock.
+type clockCache struct {
+	now     time.Time
+	expires map[string]time.Time
+	fail    bool
+}
+
+func (c *clockCache) Get(_ context.Context, key string) domerr.Result[valueobject.Option[[]byte]] {
+	if c.fail {
+		return domerr.Err[valueobject.Option[[]byte]](domerr.NewInfrastructureError("cache down"))
+	}
+	if exp, ok := c.expires[key]; ok && c.now.Before(exp) {
+		return domerr.Ok(valueobject.Some([]byte{1}))
+	}
+	return domerr.Ok(valueobject.None[[]byte]())
+}
+
+func (c *clockCache) Set(_ context.Context, key string, _ []byte, ttl time.Duration) domerr.Result[model.Unit] {
+	if c.fail {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("cache down"))
+	}
+	c.expires[key] = c.now.Add(ttl)
+	return domerr.Ok(model.UnitValue)
+}
+
+func (c *clockCache) Delete(_ context.Context, key string) domerr.Result[model.Unit] {
+	delete(c.expires, key)
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationMiddlewareDedup tests the Dedup decorator.
+func TestApplicationMiddlewareDedup(t *testing.T) {
+	tf := test.New("Application.Middleware.Dedup")
+	ctx := context.Background()
+	byName := func(cmd command.GreetCommand) []string { return []string{cmd.Name} }
+	alice := command.NewGreetCommand("Alice")
+
+	// ========================================================================
+	// Test: Duplicates within the window are dropped
+	// ========================================================================
+
+	cache := &clockCache{now: time.Unix(0, 0), expires: map[string]time.Time{}}
+	h := &countingHandler{}
+	d := NewDedup(h, cache, byName, 10*time.Second)
+
+	tf.RunTest("First - IsOk", d.Execute(ctx, alice).IsOk())
+	dup := d.Execute(ctx, alice)
+	tf.RunTest("Duplicate - ValidationError", dup.IsError() && dup.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Duplicate - handler not called", h.calls == 1)
+	tf.RunTest("Different command - runs", d.Execute(ctx, command.NewGreetCommand("Bob")).IsOk() && h.calls == 2)
+
+	// ========================================================================
+	// Test: The window slides with each duplicate
+	// ========================================================================
+
+	cache.now = cache.now.Add(8 * time.Second)
+	tf.RunTest("Within window - dropped", d.Execute(ctx, alice).IsError())
+	cache.now = cache.now.Add(8 * time.Second) // 16s after first, 8s after last
+	tf.RunTest("Storm continues - still dropped", d.Execute(ctx, alice).IsError() && h.calls == 2)
+	cache.now = cache.now.Add(11 * time.Second)
+	tf.RunTest("Quiet past window - runs", d.Execute(ctx, alice).IsOk() && h.calls == 3)
+
+	// ========================================================================
+	// Test: Failures do not block a genuine retry
+	// ========================================================================
+
+	failing := &flakyHandler{results: []domerr.Result[model.Unit]{
+		domerr.Err[model.Unit](domerr.NewInfrastructureError("unavailable")),
+	}}
+	d2 := NewDedup(failing, cache, byName, 10*time.Second)
+	eve := command.NewGreetCommand("Eve")
+	tf.RunTest("Failed first - IsError", d2.Execute(ctx, eve).IsError())
+	tf.RunTest("Retry after failure - runs", d2.Execute(ctx, eve).IsOk() && failing.calls == 2)
+
+	// ========================================================================
+	// Test: Cache failures fail open; fingerprints are unambiguous
+	// ========================================================================
+
+	down := &clockCache{expires: map[string]time.Time{}, fail: true}
+	h3 := &countingHandler{}
+	d3 := NewDedup(h3, down, byName, 10*time.Second)
+	d3.Execute(ctx, alice)
+	tf.RunTest("Cache down - every command runs", d3.Execute(ctx, alice).IsOk() && h3.calls == 2)
+
+	tf.RunTest("Fingerprint - field boundaries matter",
+		fingerprint([]string{"ab", "c"}) != fingerprint([]string{"a", "bc"}))
+
+	// ========================================================================
+	// Test: A non-positive window is misconfiguration
+	// ========================================================================
+
+	for _, window := range []time.Duration{0, -time.Second} {
+		h4 := &countingHandler{}
+		r4 := NewDedup(h4, &clockCache{expires: map[string]time.Time{}}, byName, window).Execute(ctx, alice)
+		tf.RunTest(fmt.Sprintf("Window %s - InfrastructureError", window), r4.IsError() &&
+			r4.ErrorInfo().Kind == domerr.InfrastructureError &&
+			strings.Contains(r4.ErrorInfo().Message, "dedup decorator misconfigured"))
+		tf.RunTest(fmt.Sprintf("Window %s - handler not called", window), h4.calls == 0)
+	}
+
+	tf.Summary(t)
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/middleware"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/normalize"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// stdoutWriter prints each message; its first `failures` writes fail.
+type stdoutWriter struct {
+	name     string
+	failures int
+}
+
+func (w *stdoutWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	if w.failures > 0 {
+		w.failures--
+		fmt.Printf("%s: unavailable\n", w.name)
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(w.name + " unavailable"))
+	}
+	fmt.Printf("%s: %s\n", w.name, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// wallClock is a ClockPort reading the system time.
+type wallClock struct{}
+
+func (wallClock) Now() time.Time { return time.Now() }
+
+// printReporter prints error reports.
+type printReporter struct{}
+
+func (printReporter) Report(_ context.Context, r model.ErrorReport) domerr.Result[model.Unit] {
+	fmt.Printf("reported %s: %s\n", r.Metadata["operation"], r.Error.Message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// Decorators nest: each wraps a handler and satisfies the same inbound
+// port, so the composed chain is still an inbound.GreetPort.
+func Example() {
+	writer := &stdoutWriter{name: "console", failures: 1}
+	greet := usecase.NewGreetUseCase(writer)
+
+	retried := middleware.NewRetry(greet, 3, time.Millisecond)
+	reported := middleware.NewReportErrors(retried, printReporter{}, "greet",
+		func(cmd command.GreetCommand) map[string]string { return map[string]string{"name": cmd.Name} }, wallClock{})
+
+	fmt.Println(reported.Execute(context.Background(), command.NewGreetCommand("Alice")).IsOk())
+	// Output:
+	// console: unavailable
+	// console: Hello, Alice!
+	// true
+}
+
+func ExampleNewRetry() {
+	writer := &stdoutWriter{name: "console", failures: 5}
+	retried := middleware.NewRetry(usecase.NewGreetUseCase(writer), 2, time.Millisecond)
+
+	result := retried.Execute(context.Background(), command.NewGreetCommand("Bob"))
+	fmt.Println(result.ErrorInfo())
+	// Output:
+	// console: unavailable
+	// console: unavailable
+	// InfrastructureError: console unavailable
+}
+
+func ExampleNewNormalize() {
+	greet := usecase.NewGreetUseCase(&stdoutWriter{name: "console"})
+	normalized := middleware.NewNormalize(greet, normalize.Default(),
+		func(cmd command.GreetCommand) string { return cmd.Name },
+		func(cmd command.GreetCommand, name string) command.GreetCommand { cmd.Name = name; return cmd },
+		nil)
+
+	normalized.Execute(context.Background(), command.NewGreetCommand("  Ada   Lovelace "))
+	// Output: console: Hello, Ada Lovelace!
+}
+
+func ExampleNewNormalizingWriter() {
+	sms := middleware.NewNormalizingWriter(&stdoutWriter{name: "sms"}, normalize.ASCII(), wallClock{})
+	sms.Write(context.Background(), "Hello, Zoë!")
+	// Output: sms: Hello, Zoe!
+}
+
+func ExampleNewFailoverWriter() {
+	ctx := context.Background()
+	primary := &stdoutWriter{name: "slack", failures: 1}
+	w := middleware.NewFailoverWriter(primary, &stdoutWriter{name: "spool"}, wallClock{})
+
+	w.Write(ctx, "Hello, Alice!") // slack down: spooled
+	fmt.Println("pending:", w.Pending())
+	w.Write(ctx, "Hello, Bob!") // slack back: backlog first
+	// Output:
+	// slack: unavailable
+	// spool: Hello, Alice!
+	// pending: 1
+	// slack: Hello, Alice!
+	// slack: Hello, Bob!
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Exclusive execution decorator backed by LockPort
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Exclusive ensures that at most one instance executes a given command key
+// at a time, across every process sharing the same LockPort backend.
+//
+// Workflow:
+//  1. Derive the lock key from the command
+//  2. Acquire the lock (non-blocking); if held, return the lock error
+//  3. Execute the wrapped handler
+//  4. Release the lock, even if ctx was cancelled meanwhile
+//
+// The ttl bounds how long a crashed holder can block others; it should
+// exceed the handler's worst-case execution time.
+//
+// Implements: the same inbound port as H
+type Exclusive[C any, T any, H Handler[C, T], L outbound.LockPort] struct {
+	next  H
+	locks L
+	key   func(C) string
+	ttl   time.Duration
+}
+
+// NewExclusive wraps next so that executions sharing a key never overlap.
+//
+// Parameters:
+//   - next: the handler (use case or inner decorator) to protect
+//   - locks: the LockPort adapter (in-memory for one process, Redis for many)
+//   - key: derives the lock key from a command
+//   - ttl: lock lifetime; must be > 0
+func NewExclusive[C any, T any, H Handler[C, T], L outbound.LockPort](
+	next H, locks L, key func(C) string, ttl time.Duration,
+) *Exclusive[C, T, H, L] {
+	return &Exclusive[C, T, H, L]{next: next, locks: locks, key: key, ttl: ttl}
+}
+
+// Execute runs the wrapped handler while holding the command's lock.
+//
+// Contract:
+//   - Returns the lock error (InfrastructureError) without calling next if
+//     the key is already held or the lock backend fails
+//   - Otherwise returns next's Result unchanged
+//   - A failed Release does not change the Result; the lease expires on its own
+func (e *Exclusive[C, T, H, L]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	leaseResult := e.locks.Acquire(ctx, e.key(cmd), e.ttl)
+	if leaseResult.IsError() {
+		return domerr.Err[T](leaseResult.ErrorInfo())
+	}
+	lease := leaseResult.Value()
+
+	// Release must not be skipped because the caller's ctx was cancelled,
+	// otherwise the key stays blocked until the ttl elapses.
+	defer e.locks.Release(context.WithoutCancel(ctx), lease)
+
+	return e.next.Execute(ctx, cmd)
+}
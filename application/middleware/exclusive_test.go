@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// countingHandler records executions and can observe the lock state.
+type countingHandler struct {
+	calls  int
+	during func()
+}
+
+func (h *countingHandler) Execute(_ context.Context, _ command.GreetCommand) domerr.Result[model.Unit] {
+	h.calls++
+	if h.during != nil {
+		h.during()
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// fakeLock grants a key once until released.
+type fakeLock struct {
+	held     map[string]bool
+	released []model.Lease
+	fail     bool
+}
+
+func (l *fakeLock) Acquire(_ context.Context, key string, _ time.Duration) domerr.Result[model.Lease] {
+	if l.fail {
+		return domerr.Err[model.Lease](domerr.NewInfrastructureError("backend down"))
+	}
+	if l.held[key] {
+		return domerr.Err[model.Lease](domerr.NewInfrastructureError("lock held"))
+	}
+	l.held[key] = true
+	return domerr.Ok(model.Lease{Key: key, Token: "t"})
+}
+
+func (l *fakeLock) Release(ctx context.Context, lease model.Lease) domerr.Result[model.Unit] {
+	l.released = append(l.released, lease)
+	if ctx.Err() == nil {
+		delete(l.held, lease.Key)
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationMiddlewareExclusive tests the Exclusive decorator.
+func TestApplicationMiddlewareExclusive(t *testing.T) {
+	tf := test.New("Application.Middleware.Exclusive")
+	ctx := context.Background()
+	keyFn := func(cmd command.GreetCommand) string { return "greet:" + cmd.Name }
+
+	// ========================================================================
+	// Test: Lock is held during execution and released afterwards
+	// ========================================================================
+
+	locks := &fakeLock{held: map[string]bool{}}
+	inner := &countingHandler{}
+	uc := NewExclusive(inner, locks, keyFn, time.Minute)
+	inner.during = func() {
+		tf.RunTest("Lock held during execution", locks.held["greet:Alice"])
+		nested := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+		tf.RunTest("Concurrent same key - rejected", nested.IsError())
+	}
+
+	r1 := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Execute - IsOk", r1.IsOk())
+	tf.RunTest("Execute - inner called once", inner.calls == 1)
+	tf.RunTest("Execute - lock released", !locks.held["greet:Alice"])
+
+	// ========================================================================
+	// Test: Lock backend failure short-circuits
+	// ========================================================================
+
+	failing := &fakeLock{held: map[string]bool{}, fail: true}
+	inner2 := &countingHandler{}
+	r2 := NewExclusive(inner2, failing, keyFn, time.Minute).Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("Backend failure - IsError", r2.IsError())
+	tf.RunTest("Backend failure - inner not called", inner2.calls == 0)
+
+	// ========================================================================
+	// Test: Release uses a non-cancelled context
+	// ========================================================================
+
+	locks3 := &fakeLock{held: map[string]bool{}}
+	cctx, cancel := context.WithCancel(ctx)
+	inner3 := &countingHandler{during: cancel}
+	NewExclusive(inner3, locks3, keyFn, time.Minute).Execute(cctx, command.NewGreetCommand("Eve"))
+	tf.RunTest("Cancelled during execution - lock still released", !locks3.held["greet:Eve"])
+
+	tf.Summary(t)
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Writer decorator that fails over to a secondary sink
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// failoverBacklogLimit bounds the messages held for replay.
+const failoverBacklogLimit = 10000
+
+// FailoverWriter wraps an output port (like ChangeDetectingWriter) and
+// writes to a secondary sink - typically a local file - while the primary
+// is failing, then replays those messages to the primary once it recovers.
+//
+// Workflow:
+//  1. Replay any messages held from earlier failovers to the primary
+//  2. If the backlog is empty, write to the primary
+//  3. If the primary fails with an InfrastructureError, or the backlog
+//     could not be replayed, write to the secondary instead and hold the
+//     message for replay
+//
+// Order: messages reach the primary in the order they were written; a new
+// message never overtakes the backlog. Writes are serialized to keep that
+// order, so one slow sink delays concurrent writers.
+//
+// Other failures (validation, cancellation) are returned as-is and never
+// fail over. The backlog is in memory: messages held when the process
+// exits remain in the secondary only, and at most 10000 are held - later
+// ones are still written to the secondary but counted as Dropped.
+//
+// Batches: it does not forward WriteAll, since each message fails over on
+// its own; a batch through it is written one message at a time.
+//
+// Implements: outbound.ReceiptWriterPort
+type FailoverWriter[P outbound.WriterPort, S outbound.WriterPort] struct {
+	primary   P
+	secondary S
+	clock     outbound.ClockPort
+
+	mu      sync.Mutex
+	backlog []string
+	dropped int
+}
+
+// NewFailoverWriter wraps primary so writes fall back to secondary while
+// it is unavailable. clock stamps receipts synthesized for a sink without
+// its own; if nil, writes return NilClockError.
+//
+// Usage:
+//
+//	w := middleware.NewFailoverWriter(slackWriter, spoolFile, clock)
+//	w.Write(ctx, "Hello, Alice!") // Slack down: spooled, replayed later
+func NewFailoverWriter[P outbound.WriterPort, S outbound.WriterPort](
+	primary P, secondary S, clock outbound.ClockPort,
+) *FailoverWriter[P, S] {
+	return &FailoverWriter[P, S]{primary: primary, secondary: secondary, clock: clock}
+}
+
+// Write writes message to the primary, or to the secondary while the
+// primary is unavailable.
+func (w *FailoverWriter[P, S]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return domerr.MapTo(w.WriteWithReceipt(ctx, message), func(model.WriteReceipt) model.Unit { return model.UnitValue })
+}
+
+// WriteWithReceipt writes message as Write does.
+//
+// Contract:
+//   - Returns Ok(the primary's receipt) when the primary took the message
+//   - Returns Ok(the secondary's receipt) after a failover; the message is
+//     held for replay
+//   - Returns Err(InfrastructureError) naming both failures when the
+//     secondary fails too; the message is not held
+//   - Returns the primary's Err unchanged for any other failure
+func (w *FailoverWriter[P, S]) WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt] {
+	if w.clock == nil {
+		return domerr.Err[model.WriteReceipt](outbound.NilClockError("failover writer"))
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var cause domerr.ErrorType
+	if replayed := w.replayLocked(ctx); replayed.IsError() {
+		cause = replayed.ErrorInfo()
+	} else {
+		written := outbound.WriteWithReceipt(ctx, w.primary, message, w.clock)
+		if written.IsOk() || !failsOver(ctx, written.ErrorInfo()) {
+			return written
+		}
+		cause = written.ErrorInfo()
+	}
+	if ctx.Err() != nil {
+		return domerr.Err[model.WriteReceipt](cause)
+	}
+
+	spooled := outbound.WriteWithReceipt(ctx, w.secondary, message, w.clock)
+	if spooled.IsError() {
+		return domerr.Err[model.WriteReceipt](domerr.NewInfrastructureError(fmt.Sprintf(
+			"failover write failed: primary: %s; secondary: %s", cause.Message, spooled.ErrorInfo().Message)))
+	}
+	if len(w.backlog) < failoverBacklogLimit {
+		w.backlog = append(w.backlog, message)
+	} else {
+		w.dropped++
+	}
+	return spooled
+}
+
+// Replay writes the held messages to the primary, oldest first, without
+// waiting for the next Write.
+//
+// Contract:
+//   - Returns Ok(number replayed) once the backlog is empty
+//   - Returns Err with the primary's failure; messages not yet replayed
+//     stay held
+//   - A held message the primary rejects for another reason than an
+//     InfrastructureError (e.g. validation) is dropped and counted as
+//     Dropped, so it cannot block the backlog
+func (w *FailoverWriter[P, S]) Replay(ctx context.Context) domerr.Result[int] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.replayLocked(ctx)
+}
+
+// Pending returns the number of messages held for replay.
+func (w *FailoverWriter[P, S]) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.backlog)
+}
+
+// Dropped returns the number of messages written to the secondary that
+// will not reach the primary: the backlog was full, or the primary
+// rejected them on replay.
+func (w *FailoverWriter[P, S]) Dropped() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.dropped
+}
+
+func (w *FailoverWriter[P, S]) replayLocked(ctx context.Context) domerr.Result[int] {
+	replayed := 0
+	for len(w.backlog) > 0 {
+		r := w.primary.Write(ctx, w.backlog[0])
+		if r.IsError() && (r.ErrorInfo().Kind == domerr.InfrastructureError || ctx.Err() != nil) {
+			return domerr.Err[int](r.ErrorInfo())
+		}
+		if r.IsOk() {
+			replayed++
+		} else {
+			w.dropped++ // rejected by the primary; retrying cannot help
+		}
+		w.backlog[0] = ""
+		w.backlog = w.backlog[1:]
+	}
+	w.backlog = nil
+	return domerr.Ok(replayed)
+}
+
+// failsOver reports whether a primary failure should go to the secondary.
+func failsOver(ctx context.Context, err domerr.ErrorType) bool {
+	return err.Kind == domerr.InfrastructureError && ctx.Err() == nil
+}
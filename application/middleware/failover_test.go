@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// rejectingWriter fails every write with a ValidationError.
+type rejectingWriter struct{}
+
+func (rejectingWriter) Write(context.Context, string) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](domerr.NewValidationError("message rejected"))
+}
+
+// TestApplicationMiddlewareFailoverWriter tests failover to a secondary sink.
+func TestApplicationMiddlewareFailoverWriter(t *testing.T) {
+	tf := test.New("Application.Middleware.FailoverWriter")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fixedClock{at}
+
+	// ========================================================================
+	// Test: Healthy primary
+	// ========================================================================
+
+	primary, spool := &plainWriter{}, &receiptWriter{}
+	w := NewFailoverWriter(primary, spool, clock)
+	tf.RunTest("Healthy - IsOk", w.Write(ctx, "one").IsOk())
+	tf.RunTest("Healthy - primary only", len(primary.lines) == 1 && len(spool.lines) == 0)
+	healthy := NewFailoverWriter(&plainWriter{}, spool, clock).WriteWithReceipt(ctx, "one")
+	tf.RunTest("Healthy - synthesized receipt from clock", healthy.IsOk() && healthy.Value().WrittenAt.Equal(at))
+
+	// ========================================================================
+	// Test: Failover while the primary is down
+	// ========================================================================
+
+	primary.fail = true
+	receipt := w.WriteWithReceipt(ctx, "two")
+	tf.RunTest("Primary down - IsOk", receipt.IsOk())
+	tf.RunTest("Primary down - secondary's receipt", receipt.IsOk() && receipt.Value().Destination == "report.txt")
+	w.Write(ctx, "three")
+	tf.RunTest("Primary down - spooled in order", slices.Equal(spool.lines, []string{"two", "three"}))
+	tf.RunTest("Primary down - held for replay", w.Pending() == 2)
+
+	replay := w.Replay(ctx)
+	tf.RunTest("Replay while down - IsError, still held", replay.IsError() && w.Pending() == 2)
+
+	// ========================================================================
+	// Test: Recovery replays before new writes
+	// ========================================================================
+
+	primary.fail = false
+	tf.RunTest("Recovered - IsOk", w.Write(ctx, "four").IsOk())
+	tf.RunTest("Recovered - backlog first, in order",
+		slices.Equal(primary.lines, []string{"one", "two", "three", "four"}))
+	tf.RunTest("Recovered - nothing pending", w.Pending() == 0)
+
+	primary.fail = true
+	w.Write(ctx, "five")
+	primary.fail = false
+	replayed := w.Replay(ctx)
+	tf.RunTest("Replay - count", replayed.IsOk() && replayed.Value() == 1)
+	tf.RunTest("Replay - delivered", primary.lines[len(primary.lines)-1] == "five")
+	tf.RunTest("Replay - empty backlog is Ok(0)", w.Replay(ctx).Value() == 0)
+
+	// ========================================================================
+	// Test: Both sinks down
+	// ========================================================================
+
+	both := NewFailoverWriter(&plainWriter{fail: true}, &plainWriter{fail: true}, clock)
+	failed := both.Write(ctx, "lost")
+	tf.RunTest("Both down - IsError", failed.IsError() && failed.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("Both down - names both failures", failed.IsError() &&
+		strings.Contains(failed.ErrorInfo().Message, "primary: disk full; secondary: disk full"))
+	tf.RunTest("Both down - nothing held", both.Pending() == 0)
+
+	// ========================================================================
+	// Test: Only infrastructure failures fail over
+	// ========================================================================
+
+	secondary := &plainWriter{}
+	rejecting := NewFailoverWriter(rejectingWriter{}, secondary, clock)
+	rejected := rejecting.Write(ctx, "bad")
+	tf.RunTest("Validation error - returned as-is",
+		rejected.IsError() && rejected.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Validation error - secondary untouched", len(secondary.lines) == 0)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	downSecondary := &plainWriter{}
+	down := NewFailoverWriter(&plainWriter{fail: true}, downSecondary, clock)
+	tf.RunTest("Cancelled ctx - no failover", down.Write(cancelled, "x").IsError() && len(downSecondary.lines) == 0)
+
+	// ========================================================================
+	// Test: Backlog limits
+	// ========================================================================
+
+	poisoned := NewFailoverWriter(rejectingWriter{}, &plainWriter{}, clock)
+	poisoned.backlog = []string{"held"}
+	tf.RunTest("Rejected on replay - dropped, not blocking",
+		poisoned.Replay(ctx).IsOk() && poisoned.Pending() == 0 && poisoned.Dropped() == 1)
+
+	full := NewFailoverWriter(&plainWriter{fail: true}, &plainWriter{}, clock)
+	full.backlog = make([]string, failoverBacklogLimit)
+	tf.RunTest("Full backlog - still spooled", full.Write(ctx, "x").IsOk())
+	tf.RunTest("Full backlog - counted as dropped",
+		full.Pending() == failoverBacklogLimit && full.Dropped() == 1)
+
+	// ========================================================================
+	// Test: Nil clock is misconfiguration
+	// ========================================================================
+
+	unclocked := &plainWriter{}
+	r9 := NewFailoverWriter(unclocked, &plainWriter{}, nil).Write(ctx, "x")
+	tf.RunTest("Nil clock - InfrastructureError", r9.IsError() &&
+		r9.ErrorInfo().Message == "failover writer misconfigured: clock is nil")
+	tf.RunTest("Nil clock - nothing written", len(unclocked.lines) == 0)
+
+	tf.Summary(t)
+}
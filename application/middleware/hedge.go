@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Hedged reads across a primary and a replica blob store
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Hedging tuning.
+const (
+	hedgeSamples    = 128 // primary latencies kept for the percentile
+	hedgeWarmup     = 20  // samples needed before hedging a slow primary
+	hedgeBurstLimit = 10  // budget tokens that can accumulate
+)
+
+// HedgedBlobStore wraps an output port (like ChangeDetectingWriter) and
+// sends reads to a replica as well when the primary is slow, returning
+// whichever answers successfully first. It cuts tail latency at the cost
+// of a bounded amount of extra load.
+//
+// Reads (Get, List):
+//  1. Ask the primary
+//  2. If it has not answered within the percentile of its recent
+//     latencies (e.g. p95), or it fails, ask the replica as well - if the
+//     budget allows
+//  3. Return the first Ok Result; if both fail, the primary's error
+//
+// Writes (Put, Delete) go to the primary only; the replica is expected to
+// follow it (storage replication), and may lag.
+//
+// Budget: each read earns `budget` hedge tokens (0.1 = at most 10% extra
+// requests over time, in bursts of up to 10); a hedge spends one. The
+// budget starts full. No hedge is sent for slowness until 20 primary
+// latencies have been observed; a failed primary is hedged from the first
+// read.
+//
+// A store that panics counts as failing with an InfrastructureError; the
+// panic does not escape the read.
+//
+// The losing request is not cancelled - cancelling a Get would abort a
+// body that may still win - and a body it returns is closed.
+//
+// Implements: outbound.BlobPort
+type HedgedBlobStore[B outbound.BlobPort] struct {
+	primary B
+	replica B
+	hedge   *hedger
+}
+
+// NewHedgedBlobStore hedges reads of primary to replica after the
+// percentile (0 < percentile < 1, e.g. 0.95) of primary latency, within
+// budget extra requests per read.
+func NewHedgedBlobStore[B outbound.BlobPort](primary, replica B, percentile, budget float64) *HedgedBlobStore[B] {
+	return &HedgedBlobStore[B]{primary: primary, replica: replica, hedge: newHedger(percentile, budget)}
+}
+
+// Put stores body on the primary.
+func (s *HedgedBlobStore[B]) Put(ctx context.Context, key string, body io.Reader) domerr.Result[model.Unit] {
+	return s.primary.Put(ctx, key, body)
+}
+
+// Get returns key's body from whichever store answers first.
+func (s *HedgedBlobStore[B]) Get(ctx context.Context, key string) domerr.Result[io.ReadCloser] {
+	return hedged(s.hedge,
+		func() domerr.Result[io.ReadCloser] { return s.primary.Get(ctx, key) },
+		func() domerr.Result[io.ReadCloser] { return s.replica.Get(ctx, key) },
+		func(body io.ReadCloser) { body.Close() })
+}
+
+// List returns the listing from whichever store answers first.
+func (s *HedgedBlobStore[B]) List(ctx context.Context, prefix string) domerr.Result[[]model.BlobInfo] {
+	return hedged(s.hedge,
+		func() domerr.Result[[]model.BlobInfo] { return s.primary.List(ctx, prefix) },
+		func() domerr.Result[[]model.BlobInfo] { return s.replica.List(ctx, prefix) },
+		func([]model.BlobInfo) {})
+}
+
+// Delete removes key from the primary.
+func (s *HedgedBlobStore[B]) Delete(ctx context.Context, key string) domerr.Result[model.Unit] {
+	return s.primary.Delete(ctx, key)
+}
+
+// hedger holds the latency window and the hedge budget.
+type hedger struct {
+	percentile float64
+	budget     float64
+	now        func() time.Time
+	after      func(d time.Duration) <-chan time.Time
+
+	mu        sync.Mutex
+	latencies []time.Duration // ring buffer of primary latencies
+	next      int
+	tokens    float64
+}
+
+func newHedger(percentile, budget float64) *hedger {
+	return &hedger{
+		percentile: min(max(percentile, 0), 1),
+		budget:     max(budget, 0),
+		now:        time.Now,
+		after:      time.After,
+		latencies:  make([]time.Duration, 0, hedgeSamples),
+		tokens:     hedgeBurstLimit,
+	}
+}
+
+// delay returns the current hedge delay; false while warming up.
+func (h *hedger) delay() (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = min(h.tokens+h.budget, hedgeBurstLimit)
+	if len(h.latencies) < hedgeWarmup {
+		return 0, false
+	}
+	sorted := slices.Clone(h.latencies)
+	slices.Sort(sorted)
+	return sorted[min(int(h.percentile*float64(len(sorted))), len(sorted)-1)], true
+}
+
+// record adds a primary latency sample.
+func (h *hedger) record(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.latencies) < hedgeSamples {
+		h.latencies = append(h.latencies, d)
+		return
+	}
+	h.latencies[h.next] = d
+	h.next = (h.next + 1) % hedgeSamples
+}
+
+// spend takes one hedge token if available.
+func (h *hedger) spend() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.tokens < 1 {
+		return false
+	}
+	h.tokens--
+	return true
+}
+
+// hedged runs primary, and replica once hedging is warranted, returning
+// the first Ok Result. discard releases an Ok Result that lost.
+func hedged[T any](h *hedger, primary, replica func() domerr.Result[T], discard func(T)) domerr.Result[T] {
+	outcomes := make(chan hedgeOutcome[T], 2)
+
+	delay, warm := h.delay()
+	go func() {
+		start := h.now()
+		r := recoverHedged("primary", primary)
+		if r.IsOk() {
+			h.record(h.now().Sub(start))
+		}
+		outcomes <- hedgeOutcome[T]{result: r, primary: true}
+	}()
+	// A slow primary is hedged once warm; a failed one (failed = true)
+	// even while warming up, as no latency estimate is needed.
+	pending, hedgeSent := 1, false
+	sendHedge := func(failed bool) {
+		if hedgeSent || !(warm || failed) || !h.spend() {
+			return
+		}
+		hedgeSent = true
+		pending++
+		go func() { outcomes <- hedgeOutcome[T]{result: recoverHedged("replica", replica)} }()
+	}
+
+	var timer <-chan time.Time
+	if warm {
+		timer = h.after(delay)
+	}
+	var primaryErr domerr.Result[T]
+	for pending > 0 {
+		select {
+		case <-timer:
+			timer = nil
+			sendHedge(false)
+		case o := <-outcomes:
+			pending--
+			if o.result.IsOk() {
+				go drainHedged(outcomes, pending, discard)
+				return o.result
+			}
+			if o.primary {
+				primaryErr = o.result
+				sendHedge(true)
+			}
+		}
+	}
+	return primaryErr
+}
+
+// recoverHedged calls read, converting a panic into an InfrastructureError.
+func recoverHedged[T any](store string, read func() domerr.Result[T]) (result domerr.Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[T](domerr.NewInfrastructureError(
+				fmt.Sprintf("hedged %s read panicked: %v", store, r)))
+		}
+	}()
+	return read()
+}
+
+// hedgeOutcome is one finished request of a hedged read.
+type hedgeOutcome[T any] struct {
+	result  domerr.Result[T]
+	primary bool
+}
+
+// drainHedged waits for the pending losing requests and discards any that
+// succeeded.
+func drainHedged[T any](outcomes <-chan hedgeOutcome[T], pending int, discard func(T)) {
+	for range pending {
+		if o := <-outcomes; o.result.IsOk() {
+			discard(o.result.Value())
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// gatedBlobs is a BlobPort whose reads wait for gate (if set) and answer
+// with the store's name, or fail.
+type gatedBlobs struct {
+	name   string
+	gate   chan struct{}
+	fail   bool
+	panics bool
+	reads  atomic.Int32
+	writes atomic.Int32
+	closed atomic.Int32
+}
+
+type trackedBody struct {
+	io.Reader
+	closed *atomic.Int32
+}
+
+func (b trackedBody) Close() error {
+	b.closed.Add(1)
+	return nil
+}
+
+func (b *gatedBlobs) read() domerr.ErrorType {
+	b.reads.Add(1)
+	if b.gate != nil {
+		<-b.gate
+	}
+	if b.panics {
+		panic(b.name + " crashed")
+	}
+	if b.fail {
+		return domerr.NewInfrastructureError(b.name + " unavailable")
+	}
+	return domerr.ErrorType{}
+}
+
+func (b *gatedBlobs) Put(context.Context, string, io.Reader) domerr.Result[model.Unit] {
+	b.writes.Add(1)
+	return domerr.Ok(model.UnitValue)
+}
+
+func (b *gatedBlobs) Get(context.Context, string) domerr.Result[io.ReadCloser] {
+	if err := b.read(); err.Message != "" {
+		return domerr.Err[io.ReadCloser](err)
+	}
+	return domerr.Ok[io.ReadCloser](trackedBody{Reader: strings.NewReader(b.name), closed: &b.closed})
+}
+
+func (b *gatedBlobs) List(context.Context, string) domerr.Result[[]model.BlobInfo] {
+	if err := b.read(); err.Message != "" {
+		return domerr.Err[[]model.BlobInfo](err)
+	}
+	return domerr.Ok([]model.BlobInfo{{Key: b.name}})
+}
+
+func (b *gatedBlobs) Delete(context.Context, string) domerr.Result[model.Unit] {
+	b.writes.Add(1)
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationMiddlewareHedgedBlobStore tests hedged blob reads.
+func TestApplicationMiddlewareHedgedBlobStore(t *testing.T) {
+	tf := test.New("Application.Middleware.HedgedBlobStore")
+	ctx := context.Background()
+
+	// newStore returns a warmed-up store whose hedge timer fires only when
+	// the returned channel is sent to.
+	newStore := func(primary, replica *gatedBlobs, budget float64, warm bool) (*HedgedBlobStore[*gatedBlobs], chan time.Time) {
+		s := NewHedgedBlobStore(primary, replica, 0.95, budget)
+		fire := make(chan time.Time, 1)
+		s.hedge.after = func(time.Duration) <-chan time.Time { return fire }
+		if warm {
+			for range hedgeWarmup {
+				s.hedge.record(time.Millisecond)
+			}
+		}
+		s.hedge.tokens = hedgeBurstLimit
+		return s, fire
+	}
+	body := func(r domerr.Result[io.ReadCloser]) string {
+		if r.IsError() {
+			return "ERR " + r.ErrorInfo().Message
+		}
+		defer r.Value().Close()
+		b, _ := io.ReadAll(r.Value())
+		return string(b)
+	}
+	eventually := func(cond func() bool) bool {
+		for range 200 {
+			if cond() {
+				return true
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		return false
+	}
+
+	// ========================================================================
+	// Test: Fast primary, no hedge
+	// ========================================================================
+
+	p1, r1 := &gatedBlobs{name: "primary"}, &gatedBlobs{name: "replica"}
+	s1, _ := newStore(p1, r1, 1, true)
+	tf.RunTest("Fast primary - primary answers", body(s1.Get(ctx, "k")) == "primary")
+	tf.RunTest("Fast primary - replica not asked", r1.reads.Load() == 0)
+
+	// ========================================================================
+	// Test: Slow primary is hedged; the loser's body is closed
+	// ========================================================================
+
+	p2, r2 := &gatedBlobs{name: "primary", gate: make(chan struct{})}, &gatedBlobs{name: "replica"}
+	s2, fire2 := newStore(p2, r2, 1, true)
+	fire2 <- time.Now()
+	tf.RunTest("Slow primary - replica wins", body(s2.Get(ctx, "k")) == "replica")
+	close(p2.gate)
+	tf.RunTest("Slow primary - late primary body closed", eventually(func() bool { return p2.closed.Load() == 1 }))
+
+	lists := s2.List(ctx, "")
+	tf.RunTest("List - hedged the same way", lists.IsOk() && len(lists.Value()) == 1)
+
+	// ========================================================================
+	// Test: Failing primary falls over to the replica
+	// ========================================================================
+
+	p3, r3 := &gatedBlobs{name: "primary", fail: true}, &gatedBlobs{name: "replica"}
+	s3, _ := newStore(p3, r3, 1, true)
+	tf.RunTest("Failed primary - replica answers", body(s3.Get(ctx, "k")) == "replica")
+
+	r3.fail = true
+	tf.RunTest("Both fail - primary's error",
+		strings.Contains(body(s3.Get(ctx, "k")), "primary unavailable"))
+
+	// ========================================================================
+	// Test: Budget and warmup limit hedging
+	// ========================================================================
+
+	p4, r4 := &gatedBlobs{name: "primary", fail: true}, &gatedBlobs{name: "replica"}
+	s4, _ := newStore(p4, r4, 0, true)
+	s4.hedge.tokens = 0
+	tf.RunTest("No budget - primary error returned", strings.HasPrefix(body(s4.Get(ctx, "k")), "ERR"))
+	tf.RunTest("No budget - replica not asked", r4.reads.Load() == 0)
+
+	p5, r5 := &gatedBlobs{name: "primary", gate: make(chan struct{})}, &gatedBlobs{name: "replica"}
+	s5, fire5 := newStore(p5, r5, 1, false)
+	fire5 <- time.Now()
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(p5.gate)
+	}()
+	tf.RunTest("Cold slow primary - not hedged", body(s5.Get(ctx, "k")) == "primary")
+	tf.RunTest("Cold slow primary - replica not asked", r5.reads.Load() == 0)
+
+	p5f, r5f := &gatedBlobs{name: "primary", fail: true}, &gatedBlobs{name: "replica"}
+	s5f, _ := newStore(p5f, r5f, 1, false)
+	tf.RunTest("Cold failed primary - replica answers", body(s5f.Get(ctx, "k")) == "replica")
+
+	fresh := NewHedgedBlobStore(&gatedBlobs{name: "primary", fail: true}, &gatedBlobs{name: "replica"}, 0.95, 0.1)
+	tf.RunTest("New store - failed first read hedged", body(fresh.Get(ctx, "k")) == "replica")
+
+	p5b, r5b := &gatedBlobs{name: "primary", fail: true}, &gatedBlobs{name: "replica"}
+	s5b, _ := newStore(p5b, r5b, 0, false)
+	s5b.hedge.tokens = 0
+	s5b.Get(ctx, "k")
+	tf.RunTest("Cold failed primary - still within budget", r5b.reads.Load() == 0)
+
+	budgeted, _ := newStore(&gatedBlobs{name: "p"}, &gatedBlobs{name: "r"}, 0.25, true)
+	budgeted.hedge.tokens = 0
+	budgeted.hedge.delay()
+	tf.RunTest("Budget - tokens accrue per read", budgeted.hedge.tokens == 0.25)
+
+	// ========================================================================
+	// Test: A panicking store is a failure
+	// ========================================================================
+
+	p8, r8 := &gatedBlobs{name: "primary", panics: true}, &gatedBlobs{name: "replica"}
+	s8, _ := newStore(p8, r8, 1, true)
+	tf.RunTest("Panicking primary - replica answers", body(s8.Get(ctx, "k")) == "replica")
+
+	r8.panics = true
+	both := s8.Get(ctx, "k")
+	tf.RunTest("Both panic - primary's error",
+		both.IsError() && both.ErrorInfo().Kind == domerr.InfrastructureError &&
+			both.ErrorInfo().Message == "hedged primary read panicked: primary crashed")
+
+	p9, r9 := &gatedBlobs{name: "primary", fail: true}, &gatedBlobs{name: "replica", panics: true}
+	s9, _ := newStore(p9, r9, 1, true)
+	tf.RunTest("Panicking replica - primary's error",
+		strings.Contains(body(s9.Get(ctx, "k")), "primary unavailable"))
+
+	// ========================================================================
+	// Test: Hedge delay is the latency percentile
+	// ========================================================================
+
+	h := newHedger(0.95, 1)
+	for i := 1; i <= 100; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+	d, warm := h.delay()
+	tf.RunTest("Percentile - p95 of 1..100ms", warm && d == 96*time.Millisecond)
+	for range hedgeSamples {
+		h.record(time.Second)
+	}
+	d, _ = h.delay()
+	tf.RunTest("Percentile - window keeps recent samples", d == time.Second)
+
+	// ========================================================================
+	// Test: Writes go to the primary only
+	// ========================================================================
+
+	p6, r6 := &gatedBlobs{name: "primary"}, &gatedBlobs{name: "replica"}
+	s6, _ := newStore(p6, r6, 1, true)
+	s6.Put(ctx, "k", strings.NewReader("x"))
+	s6.Delete(ctx, "k")
+	tf.RunTest("Writes - primary only", p6.writes.Load() == 2 && r6.writes.Load() == 0)
+
+	// ========================================================================
+	// Test: Concurrent reads are safe
+	// ========================================================================
+
+	p7, r7 := &gatedBlobs{name: "primary"}, &gatedBlobs{name: "replica"}
+	s7 := NewHedgedBlobStore(p7, r7, 0.5, 0.5)
+	var wg sync.WaitGroup
+	var ok atomic.Int32
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if s7.Get(ctx, "k").IsOk() {
+				ok.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	tf.RunTest("Concurrent - all reads Ok", ok.Load() == 50)
+
+	tf.Summary(t)
+}
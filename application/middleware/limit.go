@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Adaptive concurrency limit decorator (AIMD and gradient)
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Adaptive limit tuning. The gradient values follow Netflix's
+// concurrency-limits Gradient2Limit defaults.
+const (
+	limitBackoff      = 0.9 // multiplicative decrease on a drop
+	gradientTolerance = 1.5 // latency growth tolerated before shrinking
+	gradientSmoothing = 0.2 // weight of each new limit estimate
+	gradientQueue     = 4   // headroom added above the gradient estimate
+	gradientWarmup    = 10  // samples averaged before the long RTT is an EMA
+	gradientWindow    = 600 // long RTT EMA window, in samples
+)
+
+// AdaptiveLimit bounds in-flight executions of the wrapped handler like
+// Shed, but adjusts the bound from observed latency and failures instead
+// of a fixed number, so it tracks what the adapters behind the handler
+// can take without manual tuning.
+//
+// Algorithms:
+//   - NewAIMDLimit: additive increase (+1 per sample) while latency stays
+//     under a threshold, multiplicative decrease (x0.9) when it does not
+//   - NewGradientLimit: compares each latency sample with a long-term
+//     average; limit = limit * gradient + 4, gradient = 1.5 * long/sample
+//     clamped to [0.5, 1], smoothed by 0.2 (Netflix Gradient2). No
+//     threshold to tune: it reacts to latency rising relative to normal.
+//
+// Drops: an InfrastructureError or OverloadedError from the handler means
+// the dependency is struggling, and cuts the limit by x0.9 in both modes.
+//
+// App-limited: while fewer than half the allowed executions are in flight
+// the limit is not probing anything, so samples leave it unchanged
+// (drops still apply).
+//
+// Rejection: calls beyond the current limit fail immediately with an
+// OverloadedError, as with NewShed.
+//
+// Use one AdaptiveLimit per use case; the limit is not shared.
+//
+// Implements: the same inbound port as H
+type AdaptiveLimit[C any, T any, H Handler[C, T]] struct {
+	next     H
+	maxLimit float64
+	update   func(s *limitState, latency time.Duration)
+	now      func() time.Time
+
+	mu    sync.Mutex
+	state limitState
+}
+
+// limitState is the algorithm state, guarded by AdaptiveLimit.mu.
+type limitState struct {
+	estimate  float64
+	inFlight  int
+	threshold time.Duration
+	longRTT   float64 // seconds
+	samples   int
+}
+
+// NewAIMDLimit wraps next with an AIMD limit starting at initial and kept
+// within [1, maxLimit]; samples slower than threshold count as congestion.
+func NewAIMDLimit[C any, T any, H Handler[C, T]](next H, initial, maxLimit int, threshold time.Duration) *AdaptiveLimit[C, T, H] {
+	l := newAdaptiveLimit(next, initial, maxLimit, aimdUpdate)
+	l.state.threshold = threshold
+	return l
+}
+
+// NewGradientLimit wraps next with a gradient limit starting at initial
+// and kept within [1, maxLimit].
+func NewGradientLimit[C any, T any, H Handler[C, T]](next H, initial, maxLimit int) *AdaptiveLimit[C, T, H] {
+	return newAdaptiveLimit(next, initial, maxLimit, gradientUpdate)
+}
+
+func newAdaptiveLimit[C any, T any, H Handler[C, T]](next H, initial, maxLimit int,
+	update func(*limitState, time.Duration)) *AdaptiveLimit[C, T, H] {
+	maxLimit = max(maxLimit, 1)
+	return &AdaptiveLimit[C, T, H]{
+		next:     next,
+		maxLimit: float64(maxLimit),
+		update:   update,
+		now:      time.Now,
+		state:    limitState{estimate: float64(min(max(initial, 1), maxLimit))},
+	}
+}
+
+// Execute runs the wrapped handler if the current limit allows it.
+//
+// Contract:
+//   - Returns the handler's Result when admitted
+//   - Returns Err(OverloadedError) when rejected; the handler was not called
+func (l *AdaptiveLimit[C, T, H]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	l.mu.Lock()
+	limit := l.limitLocked()
+	if l.state.inFlight >= limit {
+		l.mu.Unlock()
+		return domerr.Err[T](domerr.NewOverloadedError(
+			fmt.Sprintf("overloaded: %d executions in flight (adaptive limit)", limit)))
+	}
+	l.state.inFlight++
+	l.mu.Unlock()
+
+	// Deferred so a panicking handler still releases its in-flight slot;
+	// the panic counts as a drop and keeps propagating.
+	start := l.now()
+	dropped := true
+	defer func() { l.observe(l.now().Sub(start), dropped) }()
+
+	result := l.next.Execute(ctx, cmd)
+	dropped = result.IsError() && result.ErrorInfo().Kind.IsTransient()
+	return result
+}
+
+// Limit returns the current concurrency limit.
+func (l *AdaptiveLimit[C, T, H]) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limitLocked()
+}
+
+// InFlight returns the number of executions currently running.
+func (l *AdaptiveLimit[C, T, H]) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state.inFlight
+}
+
+func (l *AdaptiveLimit[C, T, H]) limitLocked() int {
+	return int(l.state.estimate)
+}
+
+// observe records one finished execution and adjusts the limit.
+func (l *AdaptiveLimit[C, T, H]) observe(latency time.Duration, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// In flight as seen by this execution, itself included.
+	inFlight := l.state.inFlight
+	l.state.inFlight--
+
+	switch {
+	case dropped:
+		l.state.estimate *= limitBackoff
+	case float64(inFlight) >= l.state.estimate/2:
+		l.update(&l.state, latency)
+	default:
+		// App-limited: the sample says nothing about the limit.
+	}
+	l.state.estimate = math.Max(1, math.Min(l.maxLimit, l.state.estimate))
+}
+
+// aimdUpdate grows the limit by one per fast sample and backs off on slow ones.
+func aimdUpdate(s *limitState, latency time.Duration) {
+	if latency > s.threshold {
+		s.estimate *= limitBackoff
+		return
+	}
+	s.estimate++
+}
+
+// gradientUpdate moves the limit by the ratio of the long-term RTT to the
+// sample (Gradient2).
+func gradientUpdate(s *limitState, latency time.Duration) {
+	rtt := math.Max(latency.Seconds(), 1e-9)
+	s.samples++
+	if s.samples <= gradientWarmup {
+		s.longRTT += (rtt - s.longRTT) / float64(s.samples)
+	} else {
+		s.longRTT += (rtt - s.longRTT) * 2 / (gradientWindow + 1)
+	}
+	// When latency falls back the long average lags far above the samples;
+	// let it decay so the baseline follows.
+	if s.longRTT/rtt > 2 {
+		s.longRTT *= 0.95
+	}
+
+	gradient := math.Max(0.5, math.Min(1, gradientTolerance*s.longRTT/rtt))
+	target := s.estimate*gradient + gradientQueue
+	s.estimate = s.estimate*(1-gradientSmoothing) + target*gradientSmoothing
+}
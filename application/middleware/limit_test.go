@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// blockingHandler holds each execution until release is closed.
+type blockingHandler struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (h *blockingHandler) Execute(_ context.Context, _ command.GreetCommand) domerr.Result[model.Unit] {
+	h.started <- struct{}{}
+	<-h.release
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationMiddlewareAdaptiveLimit tests the AIMD and gradient limits.
+func TestApplicationMiddlewareAdaptiveLimit(t *testing.T) {
+	tf := test.New("Application.Middleware.AdaptiveLimit")
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+	ms := time.Millisecond
+
+	// observeAt feeds one sample as if inFlight executions were running.
+	observeAt := func(l *AdaptiveLimit[command.GreetCommand, model.Unit, *flakyHandler], inFlight int, latency time.Duration, dropped bool) {
+		l.state.inFlight = inFlight
+		l.observe(latency, dropped)
+	}
+
+	// ========================================================================
+	// Test: AIMD
+	// ========================================================================
+
+	aimd := NewAIMDLimit(&flakyHandler{}, 10, 100, 50*ms)
+	tf.RunTest("AIMD - initial limit", aimd.Limit() == 10)
+	observeAt(aimd, 10, 10*ms, false)
+	tf.RunTest("AIMD - fast sample adds one", aimd.Limit() == 11)
+	observeAt(aimd, 11, 80*ms, false)
+	tf.RunTest("AIMD - slow sample backs off", aimd.Limit() == 9)
+	observeAt(aimd, 9, 10*ms, true)
+	tf.RunTest("AIMD - drop backs off", aimd.Limit() == 8)
+	observeAt(aimd, 2, 10*ms, false)
+	tf.RunTest("AIMD - app-limited sample ignored", aimd.Limit() == 8)
+
+	small := NewAIMDLimit(&flakyHandler{}, 2, 3, 50*ms)
+	for range 5 {
+		observeAt(small, 3, ms, false)
+	}
+	tf.RunTest("AIMD - capped at max", small.Limit() == 3)
+	for range 50 {
+		observeAt(small, 1, ms, true)
+	}
+	tf.RunTest("AIMD - floor of one", small.Limit() == 1)
+
+	// ========================================================================
+	// Test: Gradient
+	// ========================================================================
+
+	grad := NewGradientLimit(&flakyHandler{}, 20, 200)
+	for range 20 {
+		observeAt(grad, grad.Limit(), 10*ms, false)
+	}
+	grown := grad.Limit()
+	tf.RunTest("Gradient - steady latency grows the limit", grown > 20)
+
+	for range 20 {
+		observeAt(grad, grad.Limit(), 40*ms, false)
+	}
+	shrunk := grad.Limit()
+	tf.RunTest("Gradient - rising latency shrinks the limit", shrunk < grown)
+
+	for range 200 {
+		observeAt(grad, grad.Limit(), 10*ms, false)
+	}
+	tf.RunTest("Gradient - recovers when latency falls back", grad.Limit() > shrunk)
+
+	idle := NewGradientLimit(&flakyHandler{}, 20, 200)
+	for range 20 {
+		observeAt(idle, 1, 10*ms, false)
+	}
+	tf.RunTest("Gradient - app-limited samples ignored", idle.Limit() == 20)
+
+	// ========================================================================
+	// Test: Execute admission and outcome classification
+	// ========================================================================
+
+	clock := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := &flakyHandler{results: []domerr.Result[model.Unit]{
+		domerr.Err[model.Unit](domerr.NewInfrastructureError("down")),
+		domerr.Err[model.Unit](domerr.NewValidationError("bad")),
+	}}
+	l := NewAIMDLimit(h, 2, 10, 50*ms)
+	l.now = func() time.Time { clock = clock.Add(10 * ms); return clock }
+	l.Execute(ctx, cmd)
+	tf.RunTest("Execute - infrastructure error is a drop", l.Limit() == 1)
+	l.Execute(ctx, cmd)
+	tf.RunTest("Execute - validation error is a sample", l.Limit() == 2)
+	tf.RunTest("Execute - in flight released", l.InFlight() == 0)
+
+	blocking := &blockingHandler{started: make(chan struct{}), release: make(chan struct{})}
+	gate := NewAIMDLimit(blocking, 1, 10, time.Minute)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		gate.Execute(ctx, cmd)
+	}()
+	<-blocking.started
+	rejected := gate.Execute(ctx, cmd)
+	tf.RunTest("Execute - over limit rejected",
+		rejected.IsError() && rejected.ErrorInfo().Kind == domerr.OverloadedError)
+	close(blocking.release)
+	wg.Wait()
+	tf.RunTest("Execute - admitted call grew the limit", gate.Limit() == 2)
+
+	// ========================================================================
+	// Test: A panicking handler releases its slot
+	// ========================================================================
+
+	panicky := NewAIMDLimit(scriptedHandler{panics: true}, 2, 10, time.Minute)
+	repanicked := func() (r any) {
+		defer func() { r = recover() }()
+		panicky.Execute(ctx, cmd)
+		return nil
+	}()
+	tf.RunTest("Panic - propagates", repanicked == "boom")
+	tf.RunTest("Panic - in flight released", panicky.InFlight() == 0)
+	tf.RunTest("Panic - counted as a drop", panicky.Limit() == 1)
+
+	tf.Summary(t)
+}
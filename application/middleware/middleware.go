@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Cross-cutting decorators for inbound ports
+
+// Package middleware provides decorators that wrap any inbound port
+// (use case) to add cross-cutting behavior without touching the use case.
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer
+//   - Depends only on application ports and domain types
+//   - Every decorator is generic over the wrapped handler type H, so the
+//     inner call is statically dispatched (same as use cases over ports)
+//   - A decorator satisfies the same inbound port as the handler it wraps,
+//     so decorators compose by nesting
+//   - ChangeDetectingWriter, NormalizingWriter, FailoverWriter and
+//     HedgedBlobStore are the exceptions: they wrap an output port
+//     (WriterPort, or BlobPort for HedgedBlobStore) rather than a use case
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/application/middleware"
+//
+//	uc := usecase.NewGreetUseCase[*adapter.ConsoleWriter](writer)
+//	exclusive := middleware.NewExclusive(uc, locks,
+//	    func(cmd command.GreetCommand) string { return "greet:" + cmd.Name },
+//	    30*time.Second)
+//
+//	// exclusive satisfies inbound.GreetPort
+//	result := exclusive.Execute(ctx, cmd)
+package middleware
+
+import (
+	"context"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Handler is the shape shared by all inbound ports: a single Execute method
+// taking a command DTO and returning a Result.
+//
+// inbound.GreetPort is Handler[command.GreetCommand, model.Unit].
+type Handler[C any, T any] interface {
+	Execute(ctx context.Context, cmd C) domerr.Result[T]
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Input normalization decorator applied before validation
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/normalize"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Normalize rewrites a command through a normalize.Normalizer before the
+// wrapped handler validates it, reporting what was changed.
+//
+// Workflow:
+//  1. Read the field to normalize from the command
+//  2. Run the Normalizer; report any warnings via warn
+//  3. Write the normalized value back and execute the wrapped handler
+//
+// Implements: the same inbound port as H
+type Normalize[C any, T any, H Handler[C, T]] struct {
+	next       H
+	normalizer normalize.Normalizer
+	get        func(C) string
+	set        func(C, string) C
+	warn       func(ctx context.Context, warnings []string)
+}
+
+// NewNormalize wraps next so the command field selected by get/set is
+// normalized first.
+//
+// Parameters:
+//   - next: the handler (use case or inner decorator)
+//   - normalizer: e.g. normalize.Default()
+//   - get/set: read and replace the field on the command (commands are values)
+//   - warn: receives warnings when normalization changed the input; may be nil
+func NewNormalize[C any, T any, H Handler[C, T]](
+	next H,
+	normalizer normalize.Normalizer,
+	get func(C) string,
+	set func(C, string) C,
+	warn func(ctx context.Context, warnings []string),
+) *Normalize[C, T, H] {
+	return &Normalize[C, T, H]{next: next, normalizer: normalizer, get: get, set: set, warn: warn}
+}
+
+// Execute normalizes the command and delegates to the wrapped handler.
+//
+// Contract:
+//   - Never fails on its own; returns next's Result unchanged
+//   - warn is called at most once, and only with a non-empty slice
+func (n *Normalize[C, T, H]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	out := n.normalizer(n.get(cmd))
+	if len(out.Warnings) > 0 && n.warn != nil {
+		n.warn(ctx, out.Warnings)
+	}
+	return n.next.Execute(ctx, n.set(cmd, out.Value))
+}
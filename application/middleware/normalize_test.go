@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/normalize"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// recordingHandler captures the last command it received.
+type recordingHandler struct {
+	last command.GreetCommand
+}
+
+func (h *recordingHandler) Execute(_ context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	h.last = cmd
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationMiddlewareNormalize tests the Normalize decorator.
+func TestApplicationMiddlewareNormalize(t *testing.T) {
+	tf := test.New("Application.Middleware.Normalize")
+	ctx := context.Background()
+
+	getName := func(cmd command.GreetCommand) string { return cmd.Name }
+	setName := func(cmd command.GreetCommand, name string) command.GreetCommand {
+		cmd.Name = name
+		return cmd
+	}
+
+	inner := &recordingHandler{}
+	var warned []string
+	calls := 0
+	uc := NewNormalize(inner, normalize.Default(), getName, setName,
+		func(_ context.Context, w []string) { calls++; warned = w })
+
+	r1 := uc.Execute(ctx, command.NewGreetCommand("  Alice  "))
+	tf.RunTest("Execute - IsOk", r1.IsOk())
+	tf.RunTest("Execute - inner sees normalized name", inner.last.Name == "Alice")
+	tf.RunTest("Execute - warning reported", calls == 1 && len(warned) == 2)
+
+	uc.Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("Clean input - no warning call", calls == 1)
+	tf.RunTest("Clean input - passed through", inner.last.Name == "Bob")
+
+	quiet := NewNormalize(inner, normalize.Default(), getName, setName, nil)
+	tf.RunTest("Nil warn - IsOk", quiet.Execute(ctx, command.NewGreetCommand(" Eve ")).IsOk())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Writer decorator that normalizes output for one sink
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/normalize"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// NormalizingWriter wraps an output port and rewrites each message through
+// a normalize.Normalizer before writing it. Like ChangeDetectingWriter it
+// decorates a WriterPort rather than a use case.
+//
+// Use it to adapt output to one sink without changing what the application
+// stores: wrap only the legacy sink with normalize.ASCII() and leave the
+// UTF-8 console unwrapped. Use cases build the greeting (and its history
+// record) before writing, so the record keeps the original name.
+//
+// Normalization warnings are dropped; the message is still written.
+//
+// Implements: outbound.ReceiptWriterPort, outbound.BatchWriterPort
+type NormalizingWriter[W outbound.WriterPort] struct {
+	next       W
+	normalizer normalize.Normalizer
+	clock      outbound.ClockPort
+}
+
+// NewNormalizingWriter wraps next so every message passes through
+// normalizer. clock stamps receipts synthesized for a next without its own;
+// if nil, writes return NilClockError.
+//
+// Usage:
+//
+//	sms := middleware.NewNormalizingWriter(smsWriter, normalize.ASCII(), clock)
+//	sms.Write(ctx, "Hello, Наталья!") // sends "Hello, Natalya!"
+func NewNormalizingWriter[W outbound.WriterPort](
+	next W, normalizer normalize.Normalizer, clock outbound.ClockPort,
+) *NormalizingWriter[W] {
+	return &NormalizingWriter[W]{next: next, normalizer: normalizer, clock: clock}
+}
+
+// Write normalizes message and writes it to the wrapped writer.
+func (w *NormalizingWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if w.clock == nil {
+		return domerr.Err[model.Unit](outbound.NilClockError("normalizing writer"))
+	}
+	return w.next.Write(ctx, w.normalizer(message).Value)
+}
+
+// WriteWithReceipt normalizes message and writes it, returning the wrapped
+// writer's receipt. For a writer without receipts one is synthesized from
+// the normalized message length and the clock.
+func (w *NormalizingWriter[W]) WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt] {
+	if w.clock == nil {
+		return domerr.Err[model.WriteReceipt](outbound.NilClockError("normalizing writer"))
+	}
+	return outbound.WriteWithReceipt(ctx, w.next, w.normalizer(message).Value, w.clock)
+}
+
+// WriteAll normalizes every message and writes them with the wrapped
+// writer's WriteAll when it has one, otherwise one by one with a
+// synthesized receipt.
+func (w *NormalizingWriter[W]) WriteAll(ctx context.Context, messages []string) domerr.Result[model.BatchReceipt] {
+	if w.clock == nil {
+		return domerr.Err[model.BatchReceipt](outbound.NilClockError("normalizing writer"))
+	}
+	normalized := make([]string, len(messages))
+	for i, message := range messages {
+		normalized[i] = w.normalizer(message).Value
+	}
+	return outbound.WriteAll(ctx, w.next, normalized, w.clock)
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/normalize"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// batchSink is a BatchWriterPort counting its WriteAll calls.
+type batchSink struct {
+	plainWriter
+	batches int
+}
+
+func (w *batchSink) WriteAll(ctx context.Context, messages []string) domerr.Result[model.BatchReceipt] {
+	w.batches++
+	for _, m := range messages {
+		w.Write(ctx, m)
+	}
+	return domerr.Ok(model.BatchReceipt{Messages: len(messages), Destination: "batch"})
+}
+
+// TestApplicationMiddlewareNormalizingWriter tests per-sink output normalization.
+func TestApplicationMiddlewareNormalizingWriter(t *testing.T) {
+	tf := test.New("Application.Middleware.NormalizingWriter")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fixedClock{at}
+
+	// ========================================================================
+	// Test: Only the wrapped sink sees normalized output
+	// ========================================================================
+
+	legacy := &plainWriter{}
+	console := &plainWriter{}
+	ascii := NewNormalizingWriter(legacy, normalize.ASCII(), clock)
+
+	message := "Hello, Наталья!"
+	tf.RunTest("Write - IsOk", ascii.Write(ctx, message).IsOk())
+	tf.RunTest("Write - legacy sink gets ASCII", legacy.lines[0] == "Hello, Natalya!")
+	tf.RunTest("Write - unwrapped sink unchanged", console.Write(ctx, message).IsOk() &&
+		console.lines[0] == message)
+
+	// ========================================================================
+	// Test: Receipts
+	// ========================================================================
+
+	r1 := ascii.WriteWithReceipt(ctx, "Zoë")
+	tf.RunTest("Receipt without receipt writer - normalized length", r1.IsOk() && r1.Value().Bytes == 3)
+	tf.RunTest("Receipt without receipt writer - time from clock", r1.IsOk() && r1.Value().WrittenAt.Equal(at))
+
+	withReceipts := NewNormalizingWriter(&receiptWriter{}, normalize.Transliterate(), clock)
+	r2 := withReceipts.WriteWithReceipt(ctx, "Zoë")
+	tf.RunTest("Receipt - from wrapped writer", r2.IsOk() && r2.Value().Destination == "report.txt")
+
+	// ========================================================================
+	// Test: Batches are forwarded
+	// ========================================================================
+
+	sink := &batchSink{}
+	b1 := NewNormalizingWriter(sink, normalize.ASCII(), clock).WriteAll(ctx, []string{"Zoë", "Наталья"})
+	tf.RunTest("WriteAll - one batch to a batch writer", b1.IsOk() && sink.batches == 1 &&
+		b1.Value().Destination == "batch")
+	tf.RunTest("WriteAll - every message normalized", len(sink.lines) == 2 &&
+		sink.lines[0] == "Zoe" && sink.lines[1] == "Natalya")
+
+	plain := &plainWriter{}
+	b2 := NewNormalizingWriter(plain, normalize.ASCII(), clock).WriteAll(ctx, []string{"Zoë", "Bob"})
+	tf.RunTest("WriteAll - plain writer, one by one", b2.IsOk() && len(plain.lines) == 2 &&
+		b2.Value().Messages == 2 && b2.Value().Bytes == 6 && b2.Value().WrittenAt.Equal(at))
+	tf.RunTest("WriteAll - plain writer failure", NewNormalizingWriter(&plainWriter{fail: true}, normalize.ASCII(), clock).
+		WriteAll(ctx, []string{"x"}).IsError())
+
+	// ========================================================================
+	// Test: Errors pass through
+	// ========================================================================
+
+	failing := NewNormalizingWriter(&plainWriter{fail: true}, normalize.ASCII(), clock)
+	tf.RunTest("Wrapped failure - IsError", failing.Write(ctx, "x").IsError())
+
+	// ========================================================================
+	// Test: Nil clock is misconfiguration
+	// ========================================================================
+
+	unclocked := NewNormalizingWriter(legacy, normalize.ASCII(), nil)
+	tf.RunTest("Nil clock - Write fails", unclocked.Write(ctx, "x").ErrorInfo().Message ==
+		"normalizing writer misconfigured: clock is nil")
+	tf.RunTest("Nil clock - WriteWithReceipt fails", unclocked.WriteWithReceipt(ctx, "x").IsError())
+	tf.RunTest("Nil clock - WriteAll fails", unclocked.WriteAll(ctx, []string{"x"}).IsError())
+
+	tf.Summary(t)
+}
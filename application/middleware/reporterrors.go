@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Panic recovery and error reporting decorator
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ReportErrors recovers panics from the wrapped handler and sends every
+// unexpected failure to an ErrorReporterPort.
+//
+// Workflow:
+//  1. Execute the wrapped handler
+//  2. If it panics, capture the stack, convert the panic to an
+//     InfrastructureError and report it
+//  3. If it returns an InfrastructureError, report it (with the error's own
+//     stack trace when it was created with NewInfrastructureErrorTrace)
+//  4. Return the (possibly converted) Result; ValidationErrors pass through
+//     unreported
+//
+// Reports are sent with a non-cancellable copy of ctx so a cancelled request
+// is still reported; a failed report never changes the Result.
+//
+// Implements: the same inbound port as H
+type ReportErrors[C any, T any, H Handler[C, T], R outbound.ErrorReporterPort] struct {
+	next      H
+	reporter  R
+	operation string
+	metadata  func(C) map[string]string
+	clock     outbound.ClockPort
+}
+
+// NewReportErrors wraps next with panic recovery and error reporting.
+//
+// Parameters:
+//   - next: the handler (use case or inner decorator)
+//   - reporter: the ErrorReporterPort adapter (e.g. Sentry)
+//   - operation: name recorded as the "operation" metadata entry
+//   - metadata: extracts request metadata from the command; may be nil
+//   - clock: stamps each report's OccurredAt; if nil, Execute returns
+//     NilClockError without running next
+func NewReportErrors[C any, T any, H Handler[C, T], R outbound.ErrorReporterPort](
+	next H, reporter R, operation string, metadata func(C) map[string]string, clock outbound.ClockPort,
+) *ReportErrors[C, T, H, R] {
+	return &ReportErrors[C, T, H, R]{
+		next: next, reporter: reporter, operation: operation, metadata: metadata, clock: clock,
+	}
+}
+
+// Execute runs the wrapped handler, reporting unexpected failures.
+//
+// Contract:
+//   - Returns next's Result unchanged unless next panics
+//   - A panic becomes Err(InfrastructureError "<operation> panicked: <value>")
+//   - Never panics
+func (m *ReportErrors[C, T, H, R]) Execute(ctx context.Context, cmd C) (result domerr.Result[T]) {
+	if m.clock == nil {
+		return domerr.Err[T](outbound.NilClockError("error reporting decorator"))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err := domerr.NewInfrastructureError(fmt.Sprintf("%s panicked: %v", m.operation, r))
+			m.report(ctx, cmd, err, true, string(debug.Stack()))
+			result = domerr.Err[T](err)
+		}
+	}()
+
+	result = m.next.Execute(ctx, cmd)
+	if result.IsError() && result.ErrorInfo().Kind == domerr.InfrastructureError {
+		// Errors built with NewInfrastructureErrorTrace carry their own stack.
+		err := result.ErrorInfo()
+		m.report(ctx, cmd, err, false, err.StackTrace().String())
+	}
+	return result
+}
+
+// report builds and sends one ErrorReport, ignoring reporter failures.
+func (m *ReportErrors[C, T, H, R]) report(ctx context.Context, cmd C, err domerr.ErrorType, panicked bool, stack string) {
+	meta := map[string]string{}
+	if m.metadata != nil {
+		for k, v := range m.metadata(cmd) {
+			meta[k] = v
+		}
+	}
+	meta["operation"] = m.operation
+
+	_ = m.reporter.Report(context.WithoutCancel(ctx), model.ErrorReport{
+		Error:      err,
+		Panicked:   panicked,
+		Stack:      stack,
+		Metadata:   meta,
+		OccurredAt: m.clock.Now(),
+	})
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// fakeReporter records reports and can fail.
+type fakeReporter struct {
+	reports []model.ErrorReport
+	fail    bool
+}
+
+func (r *fakeReporter) Report(_ context.Context, report model.ErrorReport) domerr.Result[model.Unit] {
+	r.reports = append(r.reports, report)
+	if r.fail {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("sink down"))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// scriptedHandler returns a fixed Result or panics.
+type scriptedHandler struct {
+	result domerr.Result[model.Unit]
+	panics bool
+}
+
+func (h scriptedHandler) Execute(_ context.Context, _ command.GreetCommand) domerr.Result[model.Unit] {
+	if h.panics {
+		panic("boom")
+	}
+	return h.result
+}
+
+// fixedClock implements outbound.ClockPort with a constant time.
+type fixedClock struct{ at time.Time }
+
+func (c fixedClock) Now() time.Time { return c.at }
+
+// TestApplicationMiddlewareReportErrors tests the ReportErrors decorator.
+func TestApplicationMiddlewareReportErrors(t *testing.T) {
+	tf := test.New("Application.Middleware.ReportErrors")
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+	meta := func(c command.GreetCommand) map[string]string { return map[string]string{"name": c.Name} }
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fixedClock{at}
+
+	// ========================================================================
+	// Test: Ok and ValidationError are not reported
+	// ========================================================================
+
+	rep := &fakeReporter{}
+	ok := NewReportErrors(scriptedHandler{result: domerr.Ok(model.UnitValue)}, rep, "greet", meta, clock)
+	tf.RunTest("Ok - IsOk", ok.Execute(ctx, cmd).IsOk())
+	invalid := NewReportErrors(scriptedHandler{
+		result: domerr.Err[model.Unit](domerr.NewValidationError("empty"))}, rep, "greet", meta, clock)
+	tf.RunTest("ValidationError - passed through", invalid.Execute(ctx, cmd).IsError())
+	tf.RunTest("Ok/ValidationError - nothing reported", len(rep.reports) == 0)
+
+	// ========================================================================
+	// Test: InfrastructureError is reported with metadata
+	// ========================================================================
+
+	infra := NewReportErrors(scriptedHandler{
+		result: domerr.Err[model.Unit](domerr.NewInfrastructureError("disk full"))}, rep, "greet", meta, clock)
+	r1 := infra.Execute(ctx, cmd)
+	tf.RunTest("InfrastructureError - Result unchanged", r1.IsError() && r1.ErrorInfo().Message == "disk full")
+	tf.RunTest("InfrastructureError - reported once", len(rep.reports) == 1)
+	tf.RunTest("InfrastructureError - metadata", len(rep.reports) == 1 &&
+		rep.reports[0].Metadata["name"] == "Alice" && rep.reports[0].Metadata["operation"] == "greet")
+	tf.RunTest("InfrastructureError - not a panic", len(rep.reports) == 1 && !rep.reports[0].Panicked)
+	tf.RunTest("InfrastructureError - report time from clock", len(rep.reports) == 1 && rep.reports[0].OccurredAt.Equal(at))
+
+	// ========================================================================
+	// Test: Panic is recovered, converted and reported with stack
+	// ========================================================================
+
+	rep2 := &fakeReporter{fail: true}
+	panicky := NewReportErrors(scriptedHandler{panics: true}, rep2, "greet", nil, clock)
+	r2 := panicky.Execute(ctx, cmd)
+	tf.RunTest("Panic - IsError", r2.IsError())
+	tf.RunTest("Panic - InfrastructureError message",
+		r2.IsError() && r2.ErrorInfo().Kind == domerr.InfrastructureError &&
+			r2.ErrorInfo().Message == "greet panicked: boom")
+	tf.RunTest("Panic - reported with stack", len(rep2.reports) == 1 &&
+		rep2.reports[0].Panicked && strings.Contains(rep2.reports[0].Stack, "scriptedHandler"))
+	tf.RunTest("Panic - reporter failure ignored", r2.ErrorInfo().Message == "greet panicked: boom")
+
+	// ========================================================================
+	// Test: Traced InfrastructureError reports its own stack
+	// ========================================================================
+
+	domerr.SetTraceDepth(16)
+	defer domerr.SetTraceDepth(0)
+	rep3 := &fakeReporter{}
+	traced := NewReportErrors(scriptedHandler{
+		result: domerr.Err[model.Unit](domerr.NewInfrastructureErrorTrace("disk full"))}, rep3, "greet", nil, clock)
+	traced.Execute(ctx, cmd)
+	tf.RunTest("Traced error - stack reported", len(rep3.reports) == 1 &&
+		strings.Contains(rep3.reports[0].Stack, "TestApplicationMiddlewareReportErrors"))
+
+	// ========================================================================
+	// Test: Nil clock is misconfiguration
+	// ========================================================================
+
+	rep4 := &fakeReporter{}
+	unclocked := NewReportErrors(scriptedHandler{panics: true}, rep4, "greet", nil, nil)
+	r4 := unclocked.Execute(ctx, cmd)
+	tf.RunTest("Nil clock - InfrastructureError, next not run", r4.IsError() &&
+		r4.ErrorInfo().Message == "error reporting decorator misconfigured: clock is nil")
+	tf.RunTest("Nil clock - nothing reported", len(rep4.reports) == 0)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Retry decorator honoring adapter RetryAfter hints
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// maxRetryBackoff caps the exponential backoff. A RetryAfter hint from the
+// failing adapter may still ask for a longer wait.
+const maxRetryBackoff = 30 * time.Second
+
+// Retry re-executes the wrapped handler when it fails with an
+// InfrastructureError or OverloadedError, waiting between attempts.
+//
+// Wait before attempt n+1:
+//   - backoff * 2^(n-1), capped at 30s
+//   - raised to the error's RetryAfter hint when that is longer
+//
+// Not retried:
+//   - Ok results and ValidationErrors (retrying cannot change them)
+//   - StaleVersionErrors (wrap with RetryOnConflict, which retries without
+//     backoff)
+//   - When ctx is done, or its deadline falls before the next attempt;
+//     the last Result is returned instead of waiting in vain
+//
+// Only wrap idempotent handlers: a failed attempt may have had effects.
+//
+// Implements: the same inbound port as H
+type Retry[C any, T any, H Handler[C, T]] struct {
+	next     H
+	attempts int
+	backoff  time.Duration
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetry wraps next with up to attempts total executions (attempts < 1 is
+// treated as 1) and an initial backoff between them.
+func NewRetry[C any, T any, H Handler[C, T]](next H, attempts int, backoff time.Duration) *Retry[C, T, H] {
+	return &Retry[C, T, H]{next: next, attempts: max(attempts, 1), backoff: backoff, sleep: sleepContext}
+}
+
+// Execute runs the wrapped handler, retrying transient failures.
+//
+// Contract:
+//   - Returns the first Ok or ValidationError Result unchanged
+//   - Otherwise returns the last attempt's Result
+func (r *Retry[C, T, H]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	backoff := r.backoff
+	for attempt := 1; ; attempt++ {
+		result := r.next.Execute(ctx, cmd)
+		if result.IsOk() || !result.ErrorInfo().Kind.IsTransient() || attempt >= r.attempts {
+			return result
+		}
+
+		wait := min(backoff, maxRetryBackoff)
+		if hint := result.ErrorInfo().RetryAfter; hint > wait {
+			wait = hint
+		}
+		if ctx.Err() != nil {
+			return result
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return result
+		}
+		if err := r.sleep(ctx, wait); err != nil {
+			return result
+		}
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// flakyHandler returns queued Results in order, then Ok.
+type flakyHandler struct {
+	results []domerr.Result[model.Unit]
+	calls   int
+}
+
+func (h *flakyHandler) Execute(_ context.Context, _ command.GreetCommand) domerr.Result[model.Unit] {
+	h.calls++
+	if len(h.results) == 0 {
+		return domerr.Ok(model.UnitValue)
+	}
+	r := h.results[0]
+	h.results = h.results[1:]
+	return r
+}
+
+// TestApplicationMiddlewareRetry tests the Retry decorator.
+func TestApplicationMiddlewareRetry(t *testing.T) {
+	tf := test.New("Application.Middleware.Retry")
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+	infraErr := domerr.Err[model.Unit](domerr.NewInfrastructureError("unavailable"))
+
+	newRetry := func(h *flakyHandler, attempts int) (*Retry[command.GreetCommand, model.Unit, *flakyHandler], *[]time.Duration) {
+		var waits []time.Duration
+		r := NewRetry(h, attempts, 100*time.Millisecond)
+		r.sleep = func(_ context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		}
+		return r, &waits
+	}
+
+	// ========================================================================
+	// Test: Transient failures are retried with exponential backoff
+	// ========================================================================
+
+	h1 := &flakyHandler{results: []domerr.Result[model.Unit]{infraErr, infraErr}}
+	r1, waits1 := newRetry(h1, 5)
+	tf.RunTest("Transient - eventually IsOk", r1.Execute(ctx, cmd).IsOk())
+	tf.RunTest("Transient - three attempts", h1.calls == 3)
+	tf.RunTest("Transient - backoff doubles", len(*waits1) == 2 &&
+		(*waits1)[0] == 100*time.Millisecond && (*waits1)[1] == 200*time.Millisecond)
+
+	storm := make([]domerr.Result[model.Unit], 80)
+	for i := range storm {
+		storm[i] = infraErr
+	}
+	h1c := &flakyHandler{results: storm}
+	r1c, waits1c := newRetry(h1c, len(storm))
+	r1c.Execute(ctx, cmd)
+	capped := len(*waits1c) == len(storm)-1
+	for _, w := range *waits1c {
+		capped = capped && w > 0 && w <= maxRetryBackoff
+	}
+	tf.RunTest("Many attempts - backoff capped, never overflows", capped &&
+		(*waits1c)[len(*waits1c)-1] == maxRetryBackoff)
+
+	shed := domerr.Err[model.Unit](domerr.NewOverloadedError("busy"))
+	h1b := &flakyHandler{results: []domerr.Result[model.Unit]{shed}}
+	r1b, _ := newRetry(h1b, 3)
+	tf.RunTest("Overloaded - retried", r1b.Execute(ctx, cmd).IsOk() && h1b.calls == 2)
+
+	// ========================================================================
+	// Test: RetryAfter hint extends the wait
+	// ========================================================================
+
+	hinted := domerr.Err[model.Unit](domerr.NewInfrastructureError("rate limited").WithRetryAfter(2 * time.Second))
+	h2 := &flakyHandler{results: []domerr.Result[model.Unit]{hinted}}
+	r2, waits2 := newRetry(h2, 3)
+	r2.Execute(ctx, cmd)
+	tf.RunTest("RetryAfter - honored", len(*waits2) == 1 && (*waits2)[0] == 2*time.Second)
+
+	// ========================================================================
+	// Test: Non-retryable outcomes
+	// ========================================================================
+
+	h3 := &flakyHandler{results: []domerr.Result[model.Unit]{
+		domerr.Err[model.Unit](domerr.NewValidationError("empty"))}}
+	r3, _ := newRetry(h3, 3)
+	tf.RunTest("ValidationError - returned", r3.Execute(ctx, cmd).IsError())
+	tf.RunTest("ValidationError - not retried", h3.calls == 1)
+
+	h3b := &flakyHandler{results: []domerr.Result[model.Unit]{
+		domerr.Err[model.Unit](domerr.NewStaleVersionError("stale"))}}
+	r3b, _ := newRetry(h3b, 3)
+	tf.RunTest("StaleVersionError - not retried", r3b.Execute(ctx, cmd).IsError() && h3b.calls == 1)
+
+	h4 := &flakyHandler{results: []domerr.Result[model.Unit]{infraErr, infraErr, infraErr}}
+	r4, _ := newRetry(h4, 2)
+	tf.RunTest("Attempts exhausted - last error", r4.Execute(ctx, cmd).IsError())
+	tf.RunTest("Attempts exhausted - two calls", h4.calls == 2)
+
+	h5 := &flakyHandler{results: []domerr.Result[model.Unit]{hinted}}
+	r5, waits5 := newRetry(h5, 3)
+	short, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	tf.RunTest("Deadline before RetryAfter - gives up", r5.Execute(short, cmd).IsError() &&
+		h5.calls == 1 && len(*waits5) == 0)
+
+	h6 := &flakyHandler{results: []domerr.Result[model.Unit]{infraErr, infraErr}}
+	r6, waits6 := newRetry(h6, 3)
+	done, cancel3 := context.WithCancel(ctx)
+	cancel3()
+	tf.RunTest("Cancelled ctx - no retry", r6.Execute(done, cmd).IsError() && h6.calls == 1 && len(*waits6) == 0)
+
+	// ========================================================================
+	// Test: Real sleep honors cancellation
+	// ========================================================================
+
+	cancelled, cancel2 := context.WithCancel(ctx)
+	cancel2()
+	tf.RunTest("sleepContext - cancelled returns error", sleepContext(cancelled, time.Hour) != nil)
+	tf.RunTest("sleepContext - elapses", sleepContext(ctx, time.Millisecond) == nil)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Load shedding decorator bounding in-flight executions
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Shed bounds how many executions of the wrapped handler run at once and
+// rejects the excess with an OverloadedError, so a traffic spike is turned
+// away at the use case instead of piling up on the adapters behind it.
+//
+// Modes:
+//   - NewShed: reject as soon as limit executions are in flight
+//   - NewAdaptiveShed: wait for a free slot, CoDel style. While the queue
+//     keeps draining, a caller waits up to interval; once the queue has
+//     not been empty for longer than interval (a standing queue, i.e.
+//     sustained overload) callers wait only up to target. Latency stays
+//     bounded under overload while short bursts are still absorbed.
+//
+// Use one Shed per use case; the limit is not shared between instances.
+//
+// Implements: the same inbound port as H
+type Shed[C any, T any, H Handler[C, T]] struct {
+	next     H
+	slots    chan struct{}
+	queued   bool
+	target   time.Duration
+	interval time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	waiting   int
+	lastEmpty time.Time
+}
+
+// NewShed wraps next so that at most limit executions run at once (limit
+// < 1 is treated as 1); further calls are rejected immediately.
+func NewShed[C any, T any, H Handler[C, T]](next H, limit int) *Shed[C, T, H] {
+	return &Shed[C, T, H]{next: next, slots: make(chan struct{}, max(limit, 1)), now: time.Now}
+}
+
+// NewAdaptiveShed wraps next like NewShed, but calls beyond limit queue
+// for a slot: up to interval normally, up to target under sustained
+// overload. Typical values are target 5ms and interval 100ms.
+func NewAdaptiveShed[C any, T any, H Handler[C, T]](next H, limit int, target, interval time.Duration) *Shed[C, T, H] {
+	s := NewShed(next, limit)
+	s.queued, s.target, s.interval = true, target, interval
+	s.lastEmpty = s.now()
+	return s
+}
+
+// Execute runs the wrapped handler if a slot is free (or frees up in time).
+//
+// Contract:
+//   - Returns the handler's Result when admitted
+//   - Returns Err(OverloadedError) when rejected; the handler was not called
+//   - Returns Err(InfrastructureError) if ctx is done while queued
+func (s *Shed[C, T, H]) Execute(ctx context.Context, cmd C) domerr.Result[T] {
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		if !s.queued {
+			return domerr.Err[T](s.overloaded())
+		}
+		if admitted := s.wait(ctx); admitted.IsError() {
+			return domerr.Err[T](admitted.ErrorInfo())
+		}
+	}
+	defer func() { <-s.slots }()
+	return s.next.Execute(ctx, cmd)
+}
+
+// InFlight returns the number of executions currently running.
+func (s *Shed[C, T, H]) InFlight() int {
+	return len(s.slots)
+}
+
+// wait queues for a slot. It returns Ok once a slot is held.
+func (s *Shed[C, T, H]) wait(ctx context.Context) domerr.Result[model.Unit] {
+	s.mu.Lock()
+	now := s.now()
+	if s.waiting == 0 {
+		s.lastEmpty = now
+	}
+	timeout := s.interval
+	if now.Sub(s.lastEmpty) > s.interval {
+		timeout = s.target
+	}
+	s.waiting++
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.waiting--
+		if s.waiting == 0 {
+			s.lastEmpty = s.now()
+		}
+		s.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case s.slots <- struct{}{}:
+		return domerr.Ok(model.UnitValue)
+	case <-timer.C:
+		return domerr.Err[model.Unit](s.overloaded())
+	case <-ctx.Done():
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(
+			fmt.Sprintf("cancelled while queued: %v", context.Cause(ctx))))
+	}
+}
+
+// overloaded builds the rejection error.
+func (s *Shed[C, T, H]) overloaded() domerr.ErrorType {
+	return domerr.NewOverloadedError(fmt.Sprintf("overloaded: %d executions in flight", cap(s.slots)))
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// gatedHandler blocks every execution until release is closed.
+type gatedHandler struct {
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (h *gatedHandler) Execute(_ context.Context, _ command.GreetCommand) domerr.Result[model.Unit] {
+	h.calls.Add(1)
+	<-h.release
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationMiddlewareShed tests the load shedding decorator.
+func TestApplicationMiddlewareShed(t *testing.T) {
+	tf := test.New("Application.Middleware.Shed")
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+
+	// fill starts n executions and waits until they hold their slots.
+	fill := func(s *Shed[command.GreetCommand, model.Unit, *gatedHandler], n int) *sync.WaitGroup {
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() { defer wg.Done(); s.Execute(ctx, cmd) }()
+		}
+		for s.InFlight() < n {
+			time.Sleep(time.Millisecond)
+		}
+		return &wg
+	}
+
+	// ========================================================================
+	// Test: Immediate rejection beyond the limit
+	// ========================================================================
+
+	h1 := &gatedHandler{release: make(chan struct{})}
+	s1 := NewShed(h1, 2)
+	wg := fill(s1, 2)
+	r1 := s1.Execute(ctx, cmd)
+	tf.RunTest("Over limit - OverloadedError", r1.IsError() && r1.ErrorInfo().Kind == domerr.OverloadedError)
+	tf.RunTest("Over limit - handler not called", h1.calls.Load() == 2)
+	close(h1.release)
+	wg.Wait()
+	tf.RunTest("Slots released - admitted", s1.Execute(ctx, cmd).IsOk() && s1.InFlight() == 0)
+
+	// ========================================================================
+	// Test: Adaptive queueing
+	// ========================================================================
+
+	h2 := &gatedHandler{release: make(chan struct{})}
+	s2 := NewAdaptiveShed(h2, 1, time.Millisecond, 2*time.Second)
+	wg = fill(s2, 1)
+	go func() { time.Sleep(20 * time.Millisecond); close(h2.release) }()
+	tf.RunTest("Queued - admitted when a slot frees", s2.Execute(ctx, cmd).IsOk())
+	wg.Wait()
+
+	// A standing queue shortens the wait to target.
+	var mu sync.Mutex
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { mu.Lock(); defer mu.Unlock(); return now }
+	h3 := &gatedHandler{release: make(chan struct{})}
+	s3 := NewAdaptiveShed(h3, 1, time.Millisecond, 2*time.Second)
+	s3.now = clock
+	s3.lastEmpty = clock()
+	wg = fill(s3, 1)
+	first := make(chan domerr.Result[model.Unit], 1)
+	go func() { first <- s3.Execute(ctx, cmd) }()
+	for {
+		s3.mu.Lock()
+		queued := s3.waiting
+		s3.mu.Unlock()
+		if queued == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	mu.Lock()
+	now = now.Add(3 * time.Second)
+	mu.Unlock()
+	start := time.Now()
+	r3 := s3.Execute(ctx, cmd)
+	tf.RunTest("Standing queue - rejected after target", r3.IsError() &&
+		r3.ErrorInfo().Kind == domerr.OverloadedError && time.Since(start) < time.Second)
+	close(h3.release)
+	tf.RunTest("Standing queue - earlier waiter still admitted", (<-first).IsOk())
+	wg.Wait()
+
+	h4 := &gatedHandler{release: make(chan struct{})}
+	s4 := NewAdaptiveShed(h4, 1, time.Millisecond, 2*time.Second)
+	wg = fill(s4, 1)
+	short, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	r4 := s4.Execute(short, cmd)
+	tf.RunTest("Cancelled while queued - InfrastructureError", r4.IsError() &&
+		r4.ErrorInfo().Kind == domerr.InfrastructureError)
+	close(h4.release)
+	wg.Wait()
+
+	tf.Summary(t)
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: middleware
+// Description: Spool-and-forward decorator for offline operation
+
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Spooling keeps commands that could not be delivered in a local spool, so
+// a desktop or CLI application keeps accepting work while the remote sink
+// or API is unreachable. The sync use case (usecase.SyncUseCase) replays
+// the spool once connectivity returns.
+//
+// Workflow:
+//  1. Give the command an idempotency key (command.WithIdempotencyKey),
+//     unless ctx already carries one
+//  2. Execute the wrapped handler
+//  3. On a transient failure (InfrastructureError or OverloadedError, as
+//     SyncUseCase treats them), append the command (as JSON) to the spool
+//     under that key and return Ok: the command is accepted and will be
+//     delivered later
+//
+// The key is assigned before the first attempt because an attempt that
+// timed out may still have been applied remotely; replays carry the same
+// key so the receiver can recognize them.
+//
+// Other failures (validation, cancellation) are returned as-is. Commands
+// executed while others are spooled are not held back, so delivery order
+// is not preserved across an outage. C must encode to JSON.
+//
+// Implements: the same inbound port as H
+type Spooling[C any, H Handler[C, model.Unit], S outbound.SpoolPort, I outbound.IDGeneratorPort] struct {
+	next  H
+	spool S
+	ids   I
+	clock outbound.ClockPort
+}
+
+// NewSpooling wraps next so commands failing transiently are spooled
+// instead of lost; clock stamps each entry's SpooledAt. A nil
+// clock makes Execute return NilClockError without running next.
+//
+// Usage:
+//
+//	offline := middleware.NewSpooling[command.GreetCommand](slackGreeter, spool, ids, clock)
+//	offline.Execute(ctx, command.NewGreetCommand("Alice")) // Ok even offline
+func NewSpooling[C any, H Handler[C, model.Unit], S outbound.SpoolPort, I outbound.IDGeneratorPort](
+	next H, spool S, ids I, clock outbound.ClockPort,
+) *Spooling[C, H, S, I] {
+	return &Spooling[C, H, S, I]{next: next, spool: spool, ids: ids, clock: clock}
+}
+
+// Execute runs the wrapped handler, spooling cmd if it cannot be delivered.
+//
+// Contract:
+//   - Returns next's Result when it is Ok or not transient
+//     (ErrorKind.IsTransient: InfrastructureError or OverloadedError)
+//   - Returns Ok(Unit) once an undeliverable cmd is spooled
+//   - Returns Err(InfrastructureError) naming both failures if spooling
+//     fails too
+//   - If no idempotency key can be generated, cmd runs without one and is
+//     not spooled
+func (s *Spooling[C, H, S, I]) Execute(ctx context.Context, cmd C) domerr.Result[model.Unit] {
+	if s.clock == nil {
+		return domerr.Err[model.Unit](outbound.NilClockError("spooling decorator"))
+	}
+	key, ok := command.IdempotencyKey(ctx)
+	if !ok {
+		id := s.ids.NewID()
+		if id.IsError() {
+			return s.next.Execute(ctx, cmd)
+		}
+		key = id.Value()
+		ctx = command.WithIdempotencyKey(ctx, key)
+	}
+
+	result := s.next.Execute(ctx, cmd)
+	if result.IsOk() || !result.ErrorInfo().Kind.IsTransient() || ctx.Err() != nil {
+		return result
+	}
+
+	encoded, err := json.Marshal(cmd)
+	if err != nil {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(fmt.Sprintf(
+			"%s; command not spooled: %v", result.ErrorInfo().Message, err)))
+	}
+	spooled := s.spool.Append(ctx, model.SpoolEntry{Key: key, Command: encoded, SpooledAt: s.clock.Now()})
+	if spooled.IsError() {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(fmt.Sprintf(
+			"%s; command not spooled: %s", result.ErrorInfo().Message, spooled.ErrorInfo().Message)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
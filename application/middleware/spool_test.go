@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// sliceSpool is an in-memory SpoolPort.
+type sliceSpool struct {
+	entries []model.SpoolEntry
+	fail    bool
+}
+
+func (s *sliceSpool) Append(_ context.Context, e model.SpoolEntry) domerr.Result[model.Unit] {
+	if s.fail {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("disk full"))
+	}
+	s.entries = append(s.entries, e)
+	return domerr.Ok(model.UnitValue)
+}
+
+func (s *sliceSpool) Scan(_ context.Context, visit func(model.SpoolEntry) bool) domerr.Result[model.Unit] {
+	for _, e := range s.entries {
+		if !visit(e) {
+			break
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+func (s *sliceSpool) Remove(context.Context, string) domerr.Result[model.Unit] {
+	return domerr.Ok(model.UnitValue)
+}
+
+// counterIDs issues "id-1", "id-2", ...
+type counterIDs struct {
+	n    int
+	fail bool
+}
+
+func (g *counterIDs) NewID() domerr.Result[string] {
+	if g.fail {
+		return domerr.Err[string](domerr.NewInfrastructureError("no entropy"))
+	}
+	g.n++
+	return domerr.Ok(fmt.Sprintf("id-%d", g.n))
+}
+
+// keyHandler records the idempotency key of each execution and returns
+// queued Results like flakyHandler.
+type keyHandler struct {
+	flakyHandler
+	keys []string
+}
+
+func (h *keyHandler) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	key, _ := command.IdempotencyKey(ctx)
+	h.keys = append(h.keys, key)
+	return h.flakyHandler.Execute(ctx, cmd)
+}
+
+// TestApplicationMiddlewareSpooling tests spooling undeliverable commands.
+func TestApplicationMiddlewareSpooling(t *testing.T) {
+	tf := test.New("Application.Middleware.Spooling")
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+	infraErr := domerr.Err[model.Unit](domerr.NewInfrastructureError("slack unreachable"))
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fixedClock{at}
+
+	// ========================================================================
+	// Test: Delivered commands are not spooled
+	// ========================================================================
+
+	h := &keyHandler{}
+	spool := &sliceSpool{}
+	s := NewSpooling[command.GreetCommand](h, spool, &counterIDs{}, clock)
+	tf.RunTest("Online - IsOk", s.Execute(ctx, cmd).IsOk())
+	tf.RunTest("Online - idempotency key assigned", h.keys[0] == "id-1")
+	tf.RunTest("Online - nothing spooled", len(spool.entries) == 0)
+
+	// ========================================================================
+	// Test: Undeliverable commands are spooled under their key
+	// ========================================================================
+
+	h.results = []domerr.Result[model.Unit]{infraErr}
+	tf.RunTest("Offline - accepted", s.Execute(ctx, cmd).IsOk())
+	tf.RunTest("Offline - spooled", len(spool.entries) == 1)
+	tf.RunTest("Offline - same key as the attempt", spool.entries[0].Key == "id-2" && h.keys[1] == "id-2")
+	tf.RunTest("Offline - command as JSON", string(spool.entries[0].Command) == `{"Name":"Alice"}`)
+	tf.RunTest("Offline - spool time from clock", spool.entries[0].SpooledAt.Equal(at))
+
+	keyed := command.WithIdempotencyKey(ctx, "replay-7")
+	h.results = []domerr.Result[model.Unit]{infraErr}
+	s.Execute(keyed, cmd)
+	tf.RunTest("Key in ctx - reused", h.keys[2] == "replay-7" && spool.entries[1].Key == "replay-7")
+
+	h.results = []domerr.Result[model.Unit]{domerr.Err[model.Unit](domerr.NewOverloadedError("shedding load"))}
+	tf.RunTest("Overloaded - accepted and spooled", s.Execute(ctx, cmd).IsOk() && len(spool.entries) == 3)
+
+	// ========================================================================
+	// Test: Other failures are returned
+	// ========================================================================
+
+	h.results = []domerr.Result[model.Unit]{domerr.Err[model.Unit](domerr.NewValidationError("bad name"))}
+	invalid := s.Execute(ctx, cmd)
+	tf.RunTest("Validation error - returned", invalid.IsError() && invalid.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Validation error - not spooled", len(spool.entries) == 3)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	h.results = []domerr.Result[model.Unit]{infraErr}
+	tf.RunTest("Cancelled ctx - not spooled", s.Execute(cancelled, cmd).IsError() && len(spool.entries) == 3)
+
+	// ========================================================================
+	// Test: Spool and ID failures
+	// ========================================================================
+
+	broken := NewSpooling[command.GreetCommand](&flakyHandler{results: []domerr.Result[model.Unit]{infraErr}},
+		&sliceSpool{fail: true}, &counterIDs{}, clock)
+	lost := broken.Execute(ctx, cmd)
+	tf.RunTest("Spool fails - IsError", lost.IsError() && lost.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("Spool fails - names both failures", lost.IsError() &&
+		strings.Contains(lost.ErrorInfo().Message, "slack unreachable; command not spooled: disk full"))
+
+	noIDs := &sliceSpool{}
+	unkeyed := NewSpooling[command.GreetCommand](&flakyHandler{results: []domerr.Result[model.Unit]{infraErr}},
+		noIDs, &counterIDs{fail: true}, clock)
+	tf.RunTest("No ID - handler error returned", unkeyed.Execute(ctx, cmd).IsError())
+	tf.RunTest("No ID - not spooled", len(noIDs.entries) == 0)
+
+	// ========================================================================
+	// Test: Nil clock is misconfiguration
+	// ========================================================================
+
+	unclockedHandler := &keyHandler{}
+	unclocked := NewSpooling[command.GreetCommand](unclockedHandler, &sliceSpool{}, &counterIDs{}, nil)
+	r9 := unclocked.Execute(ctx, cmd)
+	tf.RunTest("Nil clock - InfrastructureError", r9.IsError() &&
+		r9.ErrorInfo().Message == "spooling decorator misconfigured: clock is nil")
+	tf.RunTest("Nil clock - next not run", len(unclockedHandler.keys) == 0)
+
+	tf.Summary(t)
+}
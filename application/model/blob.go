@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: BlobInfo listing entry for blob storage
+
+package model
+
+import "time"
+
+// BlobInfo describes one stored blob, as returned by BlobPort.List.
+//
+// Design Notes:
+//   - Plain data (DTO); the blob adapter owns all behavior
+//   - ModifiedAt is the storage backend's clock, in UTC
+type BlobInfo struct {
+	Key        string    `json:"key"`
+	Size       int64     `json:"size"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
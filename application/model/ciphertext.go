@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Ciphertext produced by CryptoPort
+
+package model
+
+// Ciphertext is data encrypted through CryptoPort, tagged with the ID of
+// the key that encrypted it.
+//
+// Design Notes:
+//   - Plain data (DTO) with JSON tags so it can be stored next to records
+//   - KeyID lets decryption pick the right key after a rotation
+//   - Data is opaque to callers (the adapter's nonce, ciphertext and tag)
+type Ciphertext struct {
+	KeyID string `json:"kid"`
+	Data  []byte `json:"data"`
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: ErasureReport returned by data subject erasure
+
+package model
+
+// ErasureReport describes a completed erasure of one data subject.
+//
+// Design Notes:
+//   - Plain data (DTO), suitable for keeping as evidence of the request
+//   - Stores lists every store that was searched, in processing order,
+//     including stores where nothing was found (Erased 0)
+type ErasureReport struct {
+	Subject string         `json:"subject"`
+	Stores  []StoreErasure `json:"stores"`
+}
+
+// StoreErasure is the outcome for one store.
+type StoreErasure struct {
+	Store  string `json:"store"`
+	Erased int    `json:"erased"`
+}
+
+// Total returns the number of records erased across all stores.
+func (r ErasureReport) Total() int {
+	total := 0
+	for _, s := range r.Stores {
+		total += s.Erased
+	}
+	return total
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Error report handed to ErrorReporterPort
+
+package model
+
+import (
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ErrorReport describes an unexpected failure for an error tracking sink.
+//
+// Design Notes:
+//   - Plain data (DTO) - the reporter adapter owns formatting and transport
+//   - Panicked is true when Error was produced from a recovered panic
+//   - Stack is the goroutine stack in runtime/debug.Stack format; empty
+//     when no stack was captured (ordinary Err results)
+//   - Metadata carries request context (operation name, command fields)
+//     and must not contain secrets
+type ErrorReport struct {
+	Error      domerr.ErrorType
+	Panicked   bool
+	Stack      string
+	Metadata   map[string]string
+	OccurredAt time.Time
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: ExportSummary returned by history exports
+
+package model
+
+// ExportSummary describes a completed history export.
+//
+// Design Notes:
+//   - Plain data (DTO)
+//   - Bytes counts the encoded output, headers and delimiters included
+//   - Destination is "blob:<key>" for BlobPort exports, "" for io.Writers
+//   - SHA256 is the lowercase hex SHA-256 of the encoded output; blob
+//     exports also store it in the sidecar "<key>.sha256"
+type ExportSummary struct {
+	Format      string `json:"format"`
+	Records     int    `json:"records"`
+	Bytes       int64  `json:"bytes"`
+	Destination string `json:"destination"`
+	SHA256      string `json:"sha256"`
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: GreetingRecord read model and its validating builder
+
+package model
+
+import (
+	"regexp"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// DefaultLocale is the locale recorded when none is set on the builder.
+const DefaultLocale = "en"
+
+// localePattern accepts BCP 47 shaped tags such as "en", "en-US", "zh-Hant-TW".
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// GreetingRecord is the read-model DTO describing one greeting that was
+// delivered: who was greeted, with what text, when, and under which request.
+//
+// Design Notes:
+//   - Plain data with JSON tags so it can be stored and returned as-is
+//   - Build values with GreetingRecordBuilder, which enforces the invariants;
+//     a zero GreetingRecord is not a valid record
+//   - Timestamp is always UTC
+type GreetingRecord struct {
+	Name          string    `json:"name"`
+	Text          string    `json:"text"`
+	Timestamp     time.Time `json:"timestamp"`
+	CorrelationID string    `json:"correlation_id"`
+	Locale        string    `json:"locale"`
+}
+
+// GreetingRecordBuilder assembles a GreetingRecord and validates it in Build.
+//
+// Invariants enforced by Build:
+//   - Name satisfies the domain Person rules (non-empty, length limit)
+//   - Text is non-empty
+//   - Timestamp is set (non-zero); stored as UTC
+//   - CorrelationID is non-empty
+//   - Locale is a BCP 47 shaped tag; DefaultLocale when not set
+//
+// Usage:
+//
+//	record := model.NewGreetingRecordBuilder().
+//	    Name("Alice").
+//	    Text("Hello, Alice!").
+//	    Timestamp(time.Now()).
+//	    CorrelationID(id).
+//	    Build() // Result[GreetingRecord]
+type GreetingRecordBuilder struct {
+	record GreetingRecord
+}
+
+// NewGreetingRecordBuilder starts an empty builder.
+func NewGreetingRecordBuilder() *GreetingRecordBuilder {
+	return &GreetingRecordBuilder{}
+}
+
+// Name sets the greeted name.
+func (b *GreetingRecordBuilder) Name(name string) *GreetingRecordBuilder {
+	b.record.Name = name
+	return b
+}
+
+// Text sets the delivered greeting text.
+func (b *GreetingRecordBuilder) Text(text string) *GreetingRecordBuilder {
+	b.record.Text = text
+	return b
+}
+
+// Timestamp sets when the greeting was delivered.
+func (b *GreetingRecordBuilder) Timestamp(ts time.Time) *GreetingRecordBuilder {
+	b.record.Timestamp = ts
+	return b
+}
+
+// CorrelationID sets the ID of the request that produced the greeting.
+func (b *GreetingRecordBuilder) CorrelationID(id string) *GreetingRecordBuilder {
+	b.record.CorrelationID = id
+	return b
+}
+
+// Locale sets the locale the greeting was rendered in.
+func (b *GreetingRecordBuilder) Locale(locale string) *GreetingRecordBuilder {
+	b.record.Locale = locale
+	return b
+}
+
+// Build validates the collected fields.
+//
+// Contract:
+//   - Returns Ok(record) if every invariant holds
+//   - Returns Err(ValidationError) describing the first violated invariant
+//   - The builder can be reused; Build does not modify it
+func (b *GreetingRecordBuilder) Build() domerr.Result[GreetingRecord] {
+	record := b.record
+
+	if person := valueobject.CreatePerson(record.Name); person.IsError() {
+		return domerr.Err[GreetingRecord](person.ErrorInfo())
+	}
+	if record.Text == "" {
+		return domerr.Err[GreetingRecord](domerr.NewValidationError(
+			"greeting record text cannot be empty"))
+	}
+	if record.Timestamp.IsZero() {
+		return domerr.Err[GreetingRecord](domerr.NewValidationError(
+			"greeting record timestamp must be set"))
+	}
+	if record.CorrelationID == "" {
+		return domerr.Err[GreetingRecord](domerr.NewValidationError(
+			"greeting record correlation ID cannot be empty"))
+	}
+	if record.Locale == "" {
+		record.Locale = DefaultLocale
+	}
+	if !localePattern.MatchString(record.Locale) {
+		return domerr.Err[GreetingRecord](domerr.NewValidationError(
+			"greeting record locale must be a BCP 47 tag"))
+	}
+
+	record.Timestamp = record.Timestamp.UTC()
+	return domerr.Ok(record)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationModelGreetingRecord tests the GreetingRecord builder.
+func TestApplicationModelGreetingRecord(t *testing.T) {
+	tf := test.New("Application.Model.GreetingRecord")
+	at := time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	valid := func() *model.GreetingRecordBuilder {
+		return model.NewGreetingRecordBuilder().
+			Name("Alice").
+			Text("Hello, Alice!").
+			Timestamp(at).
+			CorrelationID("req-1")
+	}
+
+	// ========================================================================
+	// Test: Valid record
+	// ========================================================================
+
+	r1 := valid().Build()
+	tf.RunTest("Valid - IsOk", r1.IsOk())
+	tf.RunTest("Valid - default locale", r1.IsOk() && r1.Value().Locale == model.DefaultLocale)
+	tf.RunTest("Valid - timestamp UTC", r1.IsOk() &&
+		r1.Value().Timestamp.Location() == time.UTC && r1.Value().Timestamp.Equal(at))
+
+	data, err := json.Marshal(r1.Value())
+	tf.RunTest("JSON - snake_case tags", err == nil && string(data) ==
+		`{"name":"Alice","text":"Hello, Alice!","timestamp":"2025-01-02T11:00:00Z","correlation_id":"req-1","locale":"en"}`)
+
+	r2 := valid().Locale("pt-BR").Build()
+	tf.RunTest("Explicit locale - kept", r2.IsOk() && r2.Value().Locale == "pt-BR")
+
+	// ========================================================================
+	// Test: Invariant violations are ValidationErrors
+	// ========================================================================
+
+	isValidationErr := func(r domerr.Result[model.GreetingRecord]) bool {
+		return r.IsError() && r.ErrorInfo().Kind == domerr.ValidationError
+	}
+	tf.RunTest("Empty name - rejected", isValidationErr(valid().Name("").Build()))
+	tf.RunTest("Empty text - rejected", isValidationErr(valid().Text("").Build()))
+	tf.RunTest("Zero timestamp - rejected", isValidationErr(valid().Timestamp(time.Time{}).Build()))
+	tf.RunTest("Empty correlation ID - rejected", isValidationErr(valid().CorrelationID("").Build()))
+	tf.RunTest("Malformed locale - rejected", isValidationErr(valid().Locale("english!").Build()))
+
+	tf.Summary(t)
+}
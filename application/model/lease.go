@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Lease returned by distributed lock acquisition
+
+package model
+
+import "time"
+
+// Lease is proof of ownership of an exclusive lock.
+//
+// A Lease is returned by LockPort.Acquire and must be handed back to
+// LockPort.Release. The Token identifies this particular acquisition so a
+// holder whose lease already expired cannot release a lock that has since
+// been re-acquired by someone else.
+//
+// Design Notes:
+//   - Plain data (DTO) - the lock adapter owns all behavior
+//   - ExpiresAt is advisory (computed by the acquirer's clock)
+type Lease struct {
+	Key       string
+	Token     string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Content policy decision returned by ContentPolicyPort
+
+package model
+
+// PolicyAction is what a content policy wants done with a piece of text.
+type PolicyAction int
+
+const (
+	// PolicyAllow accepts the text unchanged.
+	PolicyAllow PolicyAction = iota
+	// PolicyMask accepts the text with disallowed parts replaced (see Text).
+	PolicyMask
+	// PolicyReject refuses the text; callers return a ValidationError.
+	PolicyReject
+)
+
+// String returns the action name ("allow", "mask", "reject").
+func (a PolicyAction) String() string {
+	switch a {
+	case PolicyAllow:
+		return "allow"
+	case PolicyMask:
+		return "mask"
+	case PolicyReject:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
+
+// PolicyDecision is the verdict of a ContentPolicyPort check.
+//
+// Design Notes:
+//   - Plain data (DTO) - the policy adapter owns all behavior
+//   - Text is the masked text for PolicyMask and the input otherwise
+//   - Reason is a short, user-safe explanation; empty for PolicyAllow
+type PolicyDecision struct {
+	Action PolicyAction
+	Text   string
+	Reason string
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: WriteReceipt delivery evidence returned by writers
+
+package model
+
+import "time"
+
+// WriteReceipt is evidence that a message was handed to its destination.
+//
+// Design Notes:
+//   - Plain data (DTO) for audit trails
+//   - Bytes counts what the adapter wrote, including framing it adds
+//     (newline, syslog header, timestamp prefix)
+//   - Destination is a human-readable description ("stdout",
+//     "syslog+udp://host:514"); empty when the adapter cannot tell
+//   - WrittenAt is taken by the adapter after the write succeeded
+//   - Skipped is set by decorators that deliberately did not write (the
+//     content was unchanged); Bytes is then 0 and WrittenAt zero
+type WriteReceipt struct {
+	Bytes       int       `json:"bytes"`
+	Destination string    `json:"destination"`
+	WrittenAt   time.Time `json:"written_at"`
+	Skipped     bool      `json:"skipped,omitempty"`
+}
+
+// BatchReceipt is evidence that a batch of messages was handed to its
+// destination in one operation (see outbound.BatchWriterPort).
+//
+// Design Notes:
+//   - Messages is the number of messages written
+//   - Bytes counts everything written for the batch, including framing
+//   - Destination and WrittenAt as in WriteReceipt
+type BatchReceipt struct {
+	Messages    int       `json:"messages"`
+	Bytes       int       `json:"bytes"`
+	Destination string    `json:"destination"`
+	WrittenAt   time.Time `json:"written_at"`
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Secret value with zeroization on release
+
+package model
+
+// redacted is what a Secret prints as, so secrets never leak into logs.
+const redacted = "[REDACTED]"
+
+// Secret holds sensitive bytes (passwords, API tokens) fetched through
+// SecretPort.
+//
+// Design Notes:
+//   - The value is kept as []byte (not string) so it can be zeroized
+//   - String/GoString/Format-based printing is redacted
+//   - Copies of a Secret share the same backing array: Release on any copy
+//     wipes the value for all of them
+//   - Callers should `defer secret.Release()` once the value is consumed
+//
+// Usage:
+//
+//	result := secrets.Get(ctx, "smtp/password")
+//	if result.IsOk() {
+//	    secret := result.Value()
+//	    defer secret.Release()
+//	    auth := smtp.PlainAuth("", user, string(secret.Bytes()), host)
+//	}
+type Secret struct {
+	value []byte
+}
+
+// NewSecret wraps value as a Secret. The Secret takes ownership of value;
+// the caller must not keep using the slice.
+func NewSecret(value []byte) Secret {
+	return Secret{value: value}
+}
+
+// Bytes returns the secret bytes. The slice is owned by the Secret and is
+// zeroized by Release; copy it if it must outlive the Secret.
+func (s Secret) Bytes() []byte {
+	return s.value
+}
+
+// IsEmpty returns true if the secret has no value (or was released).
+func (s Secret) IsEmpty() bool {
+	for _, b := range s.value {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Release overwrites the secret bytes with zeros.
+func (s Secret) Release() {
+	for i := range s.value {
+		s.value[i] = 0
+	}
+}
+
+// String implements fmt.Stringer with a redacted value.
+func (s Secret) String() string {
+	return redacted
+}
+
+// GoString implements fmt.GoStringer with a redacted value (for %#v).
+func (s Secret) GoString() string {
+	return redacted
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Spooled commands and the sync report for offline mode
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SpoolEntry is a command held locally until it can be delivered.
+//
+// Design Notes:
+//   - Key is the command's idempotency key: assigned before the first
+//     attempt and sent again with every replay, so a receiver that saw an
+//     attempt which only looked failed can recognize the repeat
+//   - Command is the command DTO encoded as JSON
+//   - SpooledAt is when the command was spooled
+type SpoolEntry struct {
+	Key       string          `json:"key"`
+	Command   json.RawMessage `json:"command"`
+	SpooledAt time.Time       `json:"spooled_at"`
+}
+
+// SyncReport describes one pass over the spool.
+//
+// Design Notes:
+//   - Replayed entries were delivered and removed from the spool
+//   - Rejected entries were refused for a reason other than an
+//     InfrastructureError (e.g. validation) and removed: replaying them
+//     again cannot succeed
+//   - Remaining entries are still spooled, because delivery failed again
+//     or the pass stopped at its limit
+type SyncReport struct {
+	Replayed  int `json:"replayed"`
+	Rejected  int `json:"rejected"`
+	Remaining int `json:"remaining"`
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: normalize
+// Description: Composable input normalization applied before validation
+
+// Package normalize provides composable string normalizers that clean up raw
+// input (names, keys) before it reaches domain validation.
+//
+// Each Normalizer returns the transformed value together with a warning for
+// every step that changed it, so callers can tell users what was adjusted
+// ("trimmed surrounding whitespace") instead of silently rewriting input.
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer
+//   - Pure functions; no I/O, no dependencies beyond the standard library
+//   - Validation stays in the domain; normalization only prepares input
+//   - Steps needing external tables (Unicode NFC, transliteration) are
+//     plugged in from outer layers with Step, e.g.
+//     normalize.Step("normalized to NFC", norm.NFC.String)
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/application/normalize"
+//
+//	names := normalize.Chain(normalize.TrimSpace(), normalize.CollapseSpace())
+//	out := names("  Alice   Smith ")
+//	// out.Value == "Alice Smith"
+//	// out.Warnings == ["trimmed surrounding whitespace", "collapsed repeated whitespace"]
+package normalize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalized is the output of a Normalizer: the transformed value and one
+// warning per step that changed the input, in the order applied.
+type Normalized struct {
+	Value    string
+	Warnings []string
+}
+
+// Normalizer transforms a raw input string.
+//
+// Contract:
+//   - Never fails; input that cannot be improved is returned unchanged
+//   - Adds a warning only when the value actually changed
+type Normalizer func(input string) Normalized
+
+// Step builds a Normalizer from a plain transformation. warning is reported
+// when f changes the input.
+//
+// Use Step to plug in transformations from outer layers (e.g. NFC from
+// golang.org/x/text) without adding dependencies to the application layer.
+func Step(warning string, f func(string) string) Normalizer {
+	return func(input string) Normalized {
+		out := f(input)
+		if out == input {
+			return Normalized{Value: input}
+		}
+		return Normalized{Value: out, Warnings: []string{warning}}
+	}
+}
+
+// Chain composes normalizers left to right, accumulating warnings.
+// An empty chain is the identity.
+func Chain(steps ...Normalizer) Normalizer {
+	return func(input string) Normalized {
+		result := Normalized{Value: input}
+		for _, step := range steps {
+			next := step(result.Value)
+			result.Value = next.Value
+			result.Warnings = append(result.Warnings, next.Warnings...)
+		}
+		return result
+	}
+}
+
+// TrimSpace removes leading and trailing Unicode whitespace.
+func TrimSpace() Normalizer {
+	return Step("trimmed surrounding whitespace", strings.TrimSpace)
+}
+
+// CollapseSpace replaces every run of Unicode whitespace with one ASCII space.
+// Leading/trailing runs are collapsed, not removed; combine with TrimSpace.
+func CollapseSpace() Normalizer {
+	return Step("collapsed repeated whitespace", collapseSpace)
+}
+
+// StripControl removes control and format characters (e.g. NUL, zero-width
+// joiners, bidi overrides) that are invisible but defeat comparisons.
+// Whitespace controls (tab, newline) are kept for CollapseSpace to handle.
+func StripControl() Normalizer {
+	return Step("removed control characters", func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return r
+			}
+			if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+				return -1
+			}
+			return r
+		}, s)
+	})
+}
+
+// FoldCase maps the input to lower case using Unicode case mapping, so
+// values differing only in case compare equal. Apply to lookup keys, not to
+// display text: "Alice" becomes "alice".
+func FoldCase() Normalizer {
+	return Step("folded case", strings.ToLower)
+}
+
+// Default is the pipeline applied to names: StripControl, CollapseSpace,
+// then TrimSpace. It never changes letters or case.
+func Default() Normalizer {
+	return Chain(StripControl(), CollapseSpace(), TrimSpace())
+}
+
+// collapseSpace replaces whitespace runs with a single space.
+func collapseSpace(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	inSpace := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !inSpace {
+				b.WriteByte(' ')
+			}
+			inSpace = true
+			continue
+		}
+		inSpace = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
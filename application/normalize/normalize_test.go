@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package normalize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/normalize"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationNormalize tests the normalization pipeline.
+func TestApplicationNormalize(t *testing.T) {
+	tf := test.New("Application.Normalize")
+
+	// ========================================================================
+	// Test: Individual steps
+	// ========================================================================
+
+	r1 := normalize.TrimSpace()("  Alice\t")
+	tf.RunTest("TrimSpace - value", r1.Value == "Alice")
+	tf.RunTest("TrimSpace - one warning", len(r1.Warnings) == 1)
+
+	r2 := normalize.CollapseSpace()("Alice \t\n Smith")
+	tf.RunTest("CollapseSpace - value", r2.Value == "Alice Smith")
+
+	r3 := normalize.StripControl()("Al\u200bice\x00")
+	tf.RunTest("StripControl - removes zero-width and NUL", r3.Value == "Alice")
+
+	r4 := normalize.FoldCase()("ÅSA")
+	tf.RunTest("FoldCase - Unicode lower case", r4.Value == "åsa")
+
+	// ========================================================================
+	// Test: Unchanged input produces no warnings
+	// ========================================================================
+
+	r5 := normalize.Default()("Alice Smith")
+	tf.RunTest("Default clean input - unchanged", r5.Value == "Alice Smith")
+	tf.RunTest("Default clean input - no warnings", len(r5.Warnings) == 0)
+
+	// ========================================================================
+	// Test: Chain accumulates warnings in order
+	// ========================================================================
+
+	r6 := normalize.Default()("  Alice\u200b   Smith ")
+	tf.RunTest("Default - value", r6.Value == "Alice Smith")
+	tf.RunTest("Default - warnings in order", strings.Join(r6.Warnings, ",") ==
+		"removed control characters,collapsed repeated whitespace,trimmed surrounding whitespace")
+
+	// ========================================================================
+	// Test: Custom Step plugs into Chain
+	// ========================================================================
+
+	upper := normalize.Step("upper-cased", strings.ToUpper)
+	r7 := normalize.Chain(normalize.TrimSpace(), upper)(" bob ")
+	tf.RunTest("Custom step - value", r7.Value == "BOB")
+	tf.RunTest("Custom step - warning reported", len(r7.Warnings) == 2 && r7.Warnings[1] == "upper-cased")
+	tf.RunTest("Empty chain - identity", normalize.Chain()("x y").Value == "x y")
+	// ========================================================================
+	// Test: Transliteration and ASCII
+	// ========================================================================
+
+	r10 := normalize.Transliterate()("Zoë Ångström")
+	tf.RunTest("Transliterate Latin - diacritics removed", r10.Value == "Zoe Angstrom")
+	tf.RunTest("Transliterate Latin - warning", len(r10.Warnings) == 1)
+	tf.RunTest("Transliterate Greek - Alexis", normalize.Transliterate()("Αλέξης").Value == "Alexis")
+	tf.RunTest("Transliterate Cyrillic - Natalya", normalize.Transliterate()("Наталья").Value == "Natalya")
+	tf.RunTest("Transliterate upper digraph - Zhanna", normalize.Transliterate()("Жанна").Value == "Zhanna")
+	tf.RunTest("Transliterate CJK - unchanged", normalize.Transliterate()("山田").Value == "山田")
+	tf.RunTest("Transliterate ASCII - no warning", len(normalize.Transliterate()("Alice").Warnings) == 0)
+
+	r11 := normalize.ASCII()("Ёлка 山")
+	tf.RunTest("ASCII - romanized then replaced", r11.Value == "Elka ?")
+	tf.RunTest("ASCII - both warnings", len(r11.Warnings) == 2)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: normalize
+// Description: Transliteration of Latin, Greek and Cyrillic text to ASCII
+
+package normalize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Transliterate romanizes text for sinks that cannot display it:
+//   - Latin letters with diacritics lose them ("Zoë Ångström" -> "Zoe Angstrom")
+//   - Greek is romanized after ELOT 743 ("Αλέξης" -> "Alexis")
+//   - Cyrillic is romanized after the BGN/PCGN scheme, including the
+//     Ukrainian letters ("Наталья" -> "Natalya")
+//
+// Other scripts (Han, kana, Hangul, Arabic, ...) are left unchanged: their
+// romanization needs dictionaries, which belong in an outer layer plugged
+// in with Step. Combine with ASCII for sinks that reject anything else.
+//
+// Apply it per output, not to input: the original name stays what is
+// validated and recorded (see middleware.NormalizingWriter).
+func Transliterate() Normalizer {
+	return Step("transliterated to Latin script", transliterate)
+}
+
+// ASCII transliterates like Transliterate and then replaces every remaining
+// non-ASCII character with '?', for legacy sinks that accept only ASCII.
+func ASCII() Normalizer {
+	return Chain(Transliterate(), Step("replaced non-ASCII characters", func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if r > unicode.MaxASCII {
+				return '?'
+			}
+			return r
+		}, s)
+	}))
+}
+
+// transliterate applies romanTable, keeping the case of the source letter.
+func transliterate(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if r <= unicode.MaxASCII {
+			b.WriteRune(r)
+			continue
+		}
+		latin, ok := romanTable[unicode.ToLower(r)]
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+		if unicode.IsUpper(r) && latin != "" {
+			latin = strings.ToUpper(latin[:1]) + latin[1:]
+		}
+		b.WriteString(latin)
+	}
+	return b.String()
+}
+
+// romanTable maps lower-case letters to their romanization.
+var romanTable = buildRomanTable()
+
+// latinFolds groups Latin-1 and Latin Extended-A letters by the ASCII they
+// fold to.
+var latinFolds = map[string]string{
+	"a": "àáâãäåāăą", "ae": "æ", "c": "çćĉċč", "d": "ðďđ", "e": "èéêëēĕėęě",
+	"g": "ĝğġģ", "h": "ĥħ", "i": "ìíîïĩīĭįı", "j": "ĵ", "k": "ķ", "l": "ĺļľŀł",
+	"n": "ñńņň", "o": "òóôõöøōŏő", "oe": "œ", "r": "ŕŗř", "s": "śŝşš", "ss": "ß",
+	"t": "ţťŧ", "th": "þ", "u": "ùúûüũūŭůűų", "w": "ŵ", "y": "ýÿŷ", "z": "źżž",
+}
+
+// scriptLetters romanizes Greek (ELOT 743, one form per letter) and
+// Cyrillic (BGN/PCGN).
+var scriptLetters = map[rune]string{
+	'α': "a", 'ά': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'έ': "e",
+	'ζ': "z", 'η': "i", 'ή': "i", 'θ': "th", 'ι': "i", 'ί': "i", 'ϊ': "i",
+	'ΐ': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o",
+	'ό': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+	'ύ': "y", 'ϋ': "y", 'ΰ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	'ώ': "o",
+
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ґ': "g", 'д': "d", 'е': "e",
+	'ё': "e", 'є': "ye", 'ж': "zh", 'з': "z", 'и': "i", 'і': "i", 'ї': "yi",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p",
+	'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e",
+	'ю': "yu", 'я': "ya",
+}
+
+func buildRomanTable() map[rune]string {
+	table := make(map[rune]string, len(scriptLetters)+64)
+	for latin, letters := range latinFolds {
+		for _, r := range letters {
+			table[r] = latin
+		}
+	}
+	for r, latin := range scriptLetters {
+		table[r] = latin
+	}
+	return table
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for erase subject (right to erasure) use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// EraseSubjectPort is the input port for erasing every stored record of a
+// data subject (GDPR Art. 17 "right to erasure").
+//
+// Contract:
+//   - Returns Ok(ErasureReport) once no store holds a record of cmd.Subject
+//   - Returns Err(ValidationError) for an invalid subject
+//   - Returns Err(InfrastructureError) on read, removal or cancellation
+//     failure; records may be partly erased, and re-running is safe
+type EraseSubjectPort interface {
+	Execute(ctx context.Context, cmd command.EraseSubjectCommand) domerr.Result[model.ErasureReport]
+}
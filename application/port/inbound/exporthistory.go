@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for export history use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ExportHistoryPort is the input port for exporting greeting history.
+//
+// Contract:
+//   - Returns Ok(ExportSummary) once every record is stored under cmd.Key
+//     and its SHA-256 under cmd.Key + ".sha256"
+//   - Returns Err(ValidationError) for an unknown format
+//   - Returns Err(InfrastructureError) on read, storage or cancellation
+//     failure; no partial export is left behind
+type ExportHistoryPort interface {
+	Execute(ctx context.Context, cmd command.ExportHistoryCommand) domerr.Result[model.ExportSummary]
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for history query use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/query"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// QueryHistoryPort is the input port for paging through greeting history.
+//
+// Contract:
+//   - Returns Ok(Page) with matching records, oldest first
+//   - Returns Err(ValidationError) for an invalid page, filter or cursor,
+//     or if the query asks for sorting
+//   - Returns Err(InfrastructureError) on read failure or cancellation
+type QueryHistoryPort interface {
+	Execute(ctx context.Context, q query.Query) domerr.Result[query.Page[model.GreetingRecord]]
+}
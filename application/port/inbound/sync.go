@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for the spool sync use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// SyncPort is the input port for replaying spooled commands once
+// connectivity returns.
+//
+// Contract:
+//   - Returns Ok(SyncReport) after a pass; entries still undeliverable
+//     are counted as Remaining, not returned as an error
+//   - Returns Err(InfrastructureError) if the spool cannot be read or an
+//     entry cannot be removed after delivery (its replay is then repeated
+//     under the same idempotency key)
+type SyncPort interface {
+	Execute(ctx context.Context, cmd command.SyncCommand) domerr.Result[model.SyncReport]
+}
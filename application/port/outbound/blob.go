@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for blob (object) storage
+
+package outbound
+
+import (
+	"context"
+	"io"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// BlobPort is an output port contract for storing large, opaque objects
+// (exported greeting reports, imported files) under string keys.
+//
+// Bodies are streamed: Put consumes an io.Reader and Get returns an
+// io.ReadCloser, so adapters never need to hold a whole object in memory.
+//
+// Keys:
+//   - '/'-separated, e.g. "reports/2025/06/greetings.csv"
+//   - Non-empty, no leading '/', no empty, "." or ".." segments
+//
+// Contract:
+//   - Put stores body under key, replacing any existing object; readers
+//     never observe a partially written object
+//   - Get returns the object's body; the caller must Close it. A missing
+//     key is Err(InfrastructureError) "blob ... not found"
+//   - List returns the objects whose key starts with prefix, sorted by key
+//   - Delete of a missing key succeeds (idempotent)
+//   - Returns Err(InfrastructureError) on invalid keys, storage failure or
+//     context cancellation
+//   - Must not panic (convert panics to Err if needed)
+type BlobPort interface {
+	Put(ctx context.Context, key string, body io.Reader) domerr.Result[model.Unit]
+	Get(ctx context.Context, key string) domerr.Result[io.ReadCloser]
+	List(ctx context.Context, prefix string) domerr.Result[[]model.BlobInfo]
+	Delete(ctx context.Context, key string) domerr.Result[model.Unit]
+}
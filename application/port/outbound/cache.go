@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for key/value caching
+
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// CachePort is an output port contract for key/value caching.
+//
+// The application layer depends on this abstraction only; concrete caches
+// (in-memory, Redis, ...) live in the infrastructure layer and are selected
+// by the composition root. Decorators that add caching to other ports should
+// be written against CachePort, never against a concrete client.
+//
+// Values are opaque byte slices so the port does not dictate a serialization
+// format. Encoding/decoding is the caller's responsibility.
+//
+// Static Dispatch:
+//   - Use as a generic constraint: Decorator[C CachePort]
+//   - The concrete cache type is known at compile time
+//
+// Contract:
+//   - Get returns Ok(Some(value)) on hit, Ok(None) on miss or expiry
+//   - A miss is NOT an error; Err is reserved for infrastructure failures
+//   - Set with ttl <= 0 stores the value without expiry
+//   - Delete of a missing key succeeds (idempotent)
+//   - Returned and stored slices must not alias caller-owned memory
+//   - Returns Err(InfrastructureError) on I/O failure or context cancellation
+//   - Must not panic (convert panics to Err if needed)
+type CachePort interface {
+	Get(ctx context.Context, key string) domerr.Result[valueobject.Option[[]byte]]
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) domerr.Result[model.Unit]
+	Delete(ctx context.Context, key string) domerr.Result[model.Unit]
+}
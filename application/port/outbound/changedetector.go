@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for detecting unchanged output
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ChangeDetectorPort remembers the digest of the last output written under
+// each key, so unchanged output can be skipped.
+//
+// Contract:
+//   - Changed returns Ok(true) if no digest is recorded for key or the
+//     recorded digest differs from digest, Ok(false) if they are equal
+//   - Record stores digest for key, replacing any previous one
+//   - Returns Err(InfrastructureError) if the store fails or ctx is cancelled
+//   - Must not panic (convert panics to Err if needed)
+type ChangeDetectorPort interface {
+	Changed(ctx context.Context, key, digest string) domerr.Result[bool]
+	Record(ctx context.Context, key, digest string) domerr.Result[model.Unit]
+}
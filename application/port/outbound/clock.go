@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for reading the current time
+
+package outbound
+
+import (
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ClockPort is an output port contract for the current time, so timestamps
+// in output (receipts, records, log lines, error reports) can be frozen in
+// tests and golden files.
+//
+// Contract:
+//   - Now returns the current time; it never fails
+//   - Safe for concurrent use
+//
+// Scope: ClockPort stamps output. Code measuring durations (latency
+// limits, hedging) or expiring entries (cache and lock TTLs) keeps the
+// monotonic wall clock: a frozen clock would stop them working.
+type ClockPort interface {
+	Now() time.Time
+}
+
+// NilClockError is the error a component wired with a nil ClockPort
+// returns from its operations, instead of a nil-interface panic on first
+// use: InfrastructureError "<component> misconfigured: clock is nil".
+func NilClockError(component string) domerr.ErrorType {
+	return domerr.NewInfrastructureError(component + " misconfigured: clock is nil")
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for content moderation of user-supplied text
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ContentPolicyPort is an output port contract for deciding whether
+// user-supplied text (e.g. a name) may be used as-is, masked, or rejected.
+//
+// Adapters range from a static deny-list to calls to an external moderation
+// service; the application only sees the PolicyDecision.
+//
+// Contract:
+//   - Returns Ok(decision) for every input the policy could evaluate
+//   - A disallowed input is Ok(PolicyReject), not Err
+//   - Returns Err(InfrastructureError) if the policy backend is unavailable
+//     or ctx is cancelled; callers decide whether to fail open or closed
+//   - Must not panic (convert panics to Err if needed)
+type ContentPolicyPort interface {
+	Check(ctx context.Context, text string) domerr.Result[model.PolicyDecision]
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for encryption at rest
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// CryptoPort is an output port contract for authenticated encryption with
+// managed keys (a local keyring, a cloud KMS, Vault transit, ...).
+//
+// It is meant for small values - names, data keys for envelope encryption -
+// not for bulk data; see adapter.EncryptedBlobStore for objects.
+//
+// Associated data is authenticated but not encrypted: binding a value to
+// its context (e.g. the record or blob key) stops a ciphertext from being
+// moved to another record. Decrypt must be given the same associated data.
+//
+// Contract:
+//   - Encrypt returns Ok(Ciphertext) under the current key, tagged with its ID
+//   - Decrypt returns Ok(plaintext) for any key the adapter still holds, so
+//     data written before a key rotation stays readable
+//   - Returns Err(InfrastructureError) for an unknown key ID, a ciphertext
+//     that fails authentication (tampered, wrong associated data), a backend
+//     failure or context cancellation
+//   - Must not panic (convert panics to Err if needed)
+type CryptoPort interface {
+	Encrypt(ctx context.Context, plaintext, associatedData []byte) domerr.Result[model.Ciphertext]
+	Decrypt(ctx context.Context, ciphertext model.Ciphertext, associatedData []byte) domerr.Result[[]byte]
+}
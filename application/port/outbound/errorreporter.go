@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for reporting unexpected errors and panics
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ErrorReporterPort is an output port contract for sending unexpected
+// failures (InfrastructureErrors, recovered panics) to an error tracking
+// service such as Sentry.
+//
+// Expected failures (ValidationError) are part of normal operation and are
+// not reported.
+//
+// Contract:
+//   - Returns Ok(Unit) once the report was accepted by the sink
+//   - Returns Err(InfrastructureError) if the sink is unreachable, rejects
+//     the report, or ctx is cancelled
+//   - Callers must not let a reporting failure change their own Result
+//   - Must not panic (convert panics to Err if needed)
+type ErrorReporterPort interface {
+	Report(ctx context.Context, report model.ErrorReport) domerr.Result[model.Unit]
+}
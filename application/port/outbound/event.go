@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for publishing domain events
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// EventPublisherPort is an output port contract for announcing that a
+// greeting was delivered (message bus, webhook, in-process subscribers).
+//
+// Contract:
+//   - Returns Ok(Unit) once the event is accepted by the transport
+//   - Delivery is at-most-once from the caller's point of view; consumers
+//     should dedupe on CorrelationID
+//   - Returns Err(InfrastructureError) on transport failure or context cancellation
+//   - Must not panic (convert panics to Err if needed)
+type EventPublisherPort interface {
+	Publish(ctx context.Context, record model.GreetingRecord) domerr.Result[model.Unit]
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for persisting greeting history
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// HistoryPort is an output port contract for persisting delivered greetings.
+//
+// Records are keyed by CorrelationID. Remove exists so a use case can
+// compensate an Append when a later step of the same unit of work fails.
+//
+// Contract:
+//   - Append stores record; Err if a record with the same CorrelationID exists
+//   - Remove deletes the record; removing a missing record succeeds (idempotent)
+//   - Returns Err(InfrastructureError) on storage failure or context cancellation
+//   - Must not panic (convert panics to Err if needed)
+type HistoryPort interface {
+	Append(ctx context.Context, record model.GreetingRecord) domerr.Result[model.Unit]
+	Remove(ctx context.Context, correlationID string) domerr.Result[model.Unit]
+}
+
+// HistoryReaderPort is an output port contract for reading greeting history
+// as a stream, so exports never load the whole history into memory.
+//
+// Contract:
+//   - Scan calls visit for each record, oldest first, until visit returns
+//     false or the records run out; either way the Result is Ok
+//   - Records appended during a Scan may or may not be visited
+//   - Returns Err(InfrastructureError) on storage failure or context
+//     cancellation (checked between records)
+//   - Must not panic (convert panics to Err if needed)
+type HistoryReaderPort interface {
+	Scan(ctx context.Context, visit func(model.GreetingRecord) bool) domerr.Result[model.Unit]
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for unique identifier generation
+
+package outbound
+
+import domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+
+// IDGeneratorPort is an output port contract for generating unique IDs
+// (correlation IDs, event IDs, record IDs).
+//
+// Adapters must never call uuid/rand helpers directly for IDs; they receive
+// an IDGeneratorPort so tests can inject a deterministic generator and
+// assert on exact output.
+//
+// Contract:
+//   - Returns Ok(id) with a non-empty string, unique for this generator
+//   - IDs from time-ordered generators (UUIDv7, ULID) sort by creation time
+//   - Returns Err(InfrastructureError) if the entropy source fails
+//   - Safe for concurrent use
+//   - Must not panic (convert panics to Err if needed)
+type IDGeneratorPort interface {
+	NewID() domerr.Result[string]
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for distributed locking
+
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// LockPort is an output port contract for exclusive, time-bounded locks.
+//
+// Used when several replicas (schedulers, queue consumers, ...) may try to
+// run the same command concurrently and only one of them must win. The
+// in-memory adapter covers single-process deployments; the Redis adapter
+// covers multi-replica deployments.
+//
+// Contract:
+//   - Acquire is non-blocking: it either grants the lock or fails at once
+//   - Acquire returns Ok(Lease) when the key was free (or its lease expired)
+//   - Acquire returns Err(InfrastructureError) when the key is held
+//     or the lock backend is unavailable
+//   - ttl must be > 0; the lock is released automatically after ttl
+//   - Release only removes the lock if the lease token still matches
+//   - Release of an expired or foreign lease succeeds without effect
+//   - Must not panic (convert panics to Err if needed)
+type LockPort interface {
+	Acquire(ctx context.Context, key string, ttl time.Duration) domerr.Result[model.Lease]
+	Release(ctx context.Context, lease model.Lease) domerr.Result[model.Unit]
+}
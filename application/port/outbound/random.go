@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for pseudo-random choices
+
+package outbound
+
+// RandomPort is an output port contract for pseudo-random choices (which
+// salutation to use, jitter, sampling).
+//
+// Application code and adapters must not call math/rand directly; they
+// receive a RandomPort so tests can inject a seeded source and assert on
+// exact output. Not for secrets or IDs: use IDGeneratorPort or crypto/rand.
+//
+// Contract:
+//   - IntN returns a value in [0, n); callers guarantee n > 0
+//   - A source created with the same seed returns the same sequence
+//   - Safe for concurrent use
+type RandomPort interface {
+	IntN(n int) int
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for secret retrieval
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// SecretPort is an output port contract for fetching credentials.
+//
+// Adapters that need credentials (SMTP passwords, API tokens, ...) receive a
+// SecretPort from the composition root instead of reading environment
+// variables or files themselves, so the secret source can be swapped
+// (environment, Vault, ...) without touching them.
+//
+// Key Format:
+//   - Keys are adapter-defined, slash-separated paths, e.g. "smtp/password"
+//
+// Contract:
+//   - Returns Ok(Secret) if the key exists
+//   - Returns Err(InfrastructureError) if the key is missing or the backend fails
+//   - Each call returns a fresh Secret the caller owns and must Release
+//   - Must not panic (convert panics to Err if needed)
+type SecretPort interface {
+	Get(ctx context.Context, key string) domerr.Result[model.Secret]
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for the local command spool
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// SpoolPort is an output port contract for a durable local queue of
+// commands that could not be delivered (offline mode).
+//
+// Contract:
+//   - Append stores entry durably before returning Ok; an entry whose Key
+//     is already spooled is not stored twice
+//   - Scan calls visit for each entry, oldest first, until visit returns
+//     false or the entries run out; either way the Result is Ok
+//   - Remove deletes the entry with key; removing an unknown key is Ok
+//   - Returns Err(InfrastructureError) on storage failure or context
+//     cancellation
+//   - Must not panic (convert panics to Err if needed)
+type SpoolPort interface {
+	Append(ctx context.Context, entry model.SpoolEntry) domerr.Result[model.Unit]
+	Scan(ctx context.Context, visit func(model.SpoolEntry) bool) domerr.Result[model.Unit]
+	Remove(ctx context.Context, key string) domerr.Result[model.Unit]
+}
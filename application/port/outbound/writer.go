@@ -74,3 +74,85 @@ import (
 type WriterPort interface {
 	Write(ctx context.Context, message string) domerr.Result[model.Unit]
 }
+
+// ReceiptWriterPort is an optional extension of WriterPort for adapters
+// that can report delivery evidence.
+//
+// Use cases accept a plain WriterPort and check for this interface, so
+// adapters without receipts keep working unchanged.
+//
+// Contract:
+//   - Same success and failure conditions as Write
+//   - Returns Ok(WriteReceipt) describing the completed write
+type ReceiptWriterPort interface {
+	WriterPort
+	WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt]
+}
+
+// WriteWithReceipt writes message to w and returns w's own receipt if w is
+// a ReceiptWriterPort. Otherwise it calls Write and synthesizes a receipt
+// from the message length, an empty Destination and clock; a nil clock is
+// reported as NilClockError before anything is written.
+//
+// It is the one place use cases and writer decorators turn a plain
+// WriterPort into delivery evidence.
+func WriteWithReceipt[W WriterPort](
+	ctx context.Context, w W, message string, clock ClockPort,
+) domerr.Result[model.WriteReceipt] {
+	if rw, ok := any(w).(ReceiptWriterPort); ok {
+		return rw.WriteWithReceipt(ctx, message)
+	}
+	if clock == nil {
+		return domerr.Err[model.WriteReceipt](NilClockError("receipt writer"))
+	}
+	return domerr.MapTo(w.Write(ctx, message), func(model.Unit) model.WriteReceipt {
+		return model.WriteReceipt{Bytes: len(message), WrittenAt: clock.Now()}
+	})
+}
+
+// BatchWriterPort is an optional extension of WriterPort for adapters that
+// can write many messages in one operation (one syscall, one produce
+// request), which is far cheaper than one Write per message.
+//
+// Use cases accept a plain WriterPort and check for this interface (see
+// WriteAll), falling back to one Write per message. A decorator hides it
+// unless it forwards WriteAll itself: NormalizingWriter does;
+// ChangeDetectingWriter and FailoverWriter do not, since they decide per
+// message, so batches through them are written one message at a time.
+//
+// Contract:
+//   - Writes messages in order, each as Write would
+//   - Returns Ok(BatchReceipt) once every message was written
+//   - Returns Err(InfrastructureError) on failure or cancellation; a prefix
+//     of the batch may have been written
+//   - An empty batch writes nothing and returns Ok with Messages 0
+type BatchWriterPort interface {
+	WriterPort
+	WriteAll(ctx context.Context, messages []string) domerr.Result[model.BatchReceipt]
+}
+
+// WriteAll writes messages to w with a single WriteAll if w is a
+// BatchWriterPort. Otherwise it calls Write for each message in order,
+// stopping at the first failure, and synthesizes a receipt from the
+// message lengths, an empty Destination and clock; a nil clock is reported
+// as NilClockError before anything is written.
+func WriteAll[W WriterPort](
+	ctx context.Context, w W, messages []string, clock ClockPort,
+) domerr.Result[model.BatchReceipt] {
+	if bw, ok := any(w).(BatchWriterPort); ok {
+		return bw.WriteAll(ctx, messages)
+	}
+	if clock == nil {
+		return domerr.Err[model.BatchReceipt](NilClockError("batch writer"))
+	}
+	receipt := model.BatchReceipt{}
+	for _, message := range messages {
+		if written := w.Write(ctx, message); written.IsError() {
+			return domerr.Err[model.BatchReceipt](written.ErrorInfo())
+		}
+		receipt.Messages++
+		receipt.Bytes += len(message)
+	}
+	receipt.WrittenAt = clock.Now()
+	return domerr.Ok(receipt)
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance suite for BlobPort adapters
+
+package porttest
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// BlobFactory returns a fresh, empty blob store.
+type BlobFactory func(t *testing.T) outbound.BlobPort
+
+// TestBlobPortContract verifies that the BlobPort adapter built by factory
+// honors the BlobPort contract:
+//
+//   - Put then Get round-trips the body; Put overwrites
+//   - Get of a missing key is Err(InfrastructureError)
+//   - List filters by prefix and sorts by key, with sizes
+//   - Delete is idempotent
+//   - Invalid keys and a cancelled context yield Err(InfrastructureError)
+func TestBlobPortContract(t *testing.T, factory BlobFactory) {
+	t.Helper()
+	tf := test.New("Contract.BlobPort")
+	ctx := context.Background()
+	blobs := factory(t)
+
+	read := func(key string) (string, bool) {
+		r := blobs.Get(ctx, key)
+		if r.IsError() {
+			return "", false
+		}
+		defer r.Value().Close()
+		b, err := io.ReadAll(r.Value())
+		return string(b), err == nil
+	}
+
+	// ========================================================================
+	// Contract: round-trip, overwrite, missing
+	// ========================================================================
+
+	tf.RunTest("Put - IsOk", blobs.Put(ctx, "reports/b.txt", strings.NewReader("hello")).IsOk())
+	body, ok := read("reports/b.txt")
+	tf.RunTest("Get after Put - round-trips", ok && body == "hello")
+
+	blobs.Put(ctx, "reports/b.txt", strings.NewReader("hello, again"))
+	body, ok = read("reports/b.txt")
+	tf.RunTest("Put again - overwrites", ok && body == "hello, again")
+
+	missing := blobs.Get(ctx, "reports/missing.txt")
+	tf.RunTest("Get missing - InfrastructureError", isInfrastructureErr(missing))
+
+	// ========================================================================
+	// Contract: List
+	// ========================================================================
+
+	blobs.Put(ctx, "reports/a.txt", strings.NewReader("a"))
+	blobs.Put(ctx, "imports/c.txt", strings.NewReader("cc"))
+	listed := blobs.List(ctx, "reports/")
+	tf.RunTest("List - IsOk", listed.IsOk())
+	if listed.IsOk() {
+		infos := listed.Value()
+		tf.RunTest("List - filtered and sorted", len(infos) == 2 &&
+			infos[0].Key == "reports/a.txt" && infos[1].Key == "reports/b.txt")
+		tf.RunTest("List - sizes", len(infos) == 2 && infos[0].Size == 1 && infos[1].Size == 12)
+	}
+	all := blobs.List(ctx, "")
+	tf.RunTest("List empty prefix - everything", all.IsOk() && len(all.Value()) == 3)
+
+	// ========================================================================
+	// Contract: Delete is idempotent
+	// ========================================================================
+
+	tf.RunTest("Delete existing - IsOk", blobs.Delete(ctx, "reports/a.txt").IsOk())
+	tf.RunTest("Get after Delete - IsError", blobs.Get(ctx, "reports/a.txt").IsError())
+	tf.RunTest("Delete missing - IsOk", blobs.Delete(ctx, "reports/a.txt").IsOk())
+
+	// ========================================================================
+	// Contract: invalid keys and cancellation
+	// ========================================================================
+
+	for _, key := range []string{"", "/abs", "a/../b", "a//b", "trailing/"} {
+		tf.RunTest("Invalid key "+key+" - InfrastructureError",
+			isInfrastructureErr(blobs.Put(ctx, key, strings.NewReader("x"))))
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Put cancelled - InfrastructureError",
+		isInfrastructureErr(blobs.Put(cancelled, "reports/x.txt", strings.NewReader("x"))))
+	tf.RunTest("Get cancelled - InfrastructureError", isInfrastructureErr(blobs.Get(cancelled, "reports/b.txt")))
+	tf.RunTest("List cancelled - InfrastructureError", isInfrastructureErr(blobs.List(cancelled, "")))
+	tf.RunTest("Delete cancelled - InfrastructureError", isInfrastructureErr(blobs.Delete(cancelled, "reports/b.txt")))
+
+	tf.Summary(t)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance suite for CachePort adapters
+
+package porttest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// CacheFactory returns a fresh, empty cache adapter.
+type CacheFactory func(t *testing.T) outbound.CachePort
+
+// TestCachePortContract verifies that the CachePort adapter built by
+// factory honors the CachePort contract:
+//
+//   - Get of a missing key is Ok(None), not Err
+//   - Set then Get round-trips the bytes; Set overwrites
+//   - Returned bytes do not alias the cache's copy
+//   - Delete is idempotent (deleting a missing key is Ok)
+//   - Entries with ttl > 0 expire; ttl <= 0 never expires
+//   - A cancelled context yields Err(InfrastructureError)
+func TestCachePortContract(t *testing.T, factory CacheFactory) {
+	t.Helper()
+	tf := test.New("Contract.CachePort")
+	ctx := context.Background()
+	cache := factory(t)
+
+	// ========================================================================
+	// Contract: miss, round-trip, overwrite
+	// ========================================================================
+
+	r1 := cache.Get(ctx, "contract:missing")
+	tf.RunTest("Get missing - Ok(None)", r1.IsOk() && r1.Value().IsNone())
+
+	tf.RunTest("Set - IsOk", cache.Set(ctx, "contract:k", []byte("v1"), 0).IsOk())
+	r2 := cache.Get(ctx, "contract:k")
+	tf.RunTest("Get after Set - round-trips", r2.IsOk() && r2.Value().IsSome() &&
+		string(r2.Value().Value()) == "v1")
+
+	cache.Set(ctx, "contract:k", []byte("v2"), 0)
+	r3 := cache.Get(ctx, "contract:k")
+	tf.RunTest("Set again - overwrites", r3.IsOk() && string(r3.Value().UnwrapOr(nil)) == "v2")
+
+	if r3.IsOk() && r3.Value().IsSome() && len(r3.Value().Value()) > 0 {
+		r3.Value().Value()[0] = 'X'
+	}
+	r4 := cache.Get(ctx, "contract:k")
+	tf.RunTest("Get result - not aliased", r4.IsOk() && string(r4.Value().UnwrapOr(nil)) == "v2")
+
+	// ========================================================================
+	// Contract: Delete is idempotent
+	// ========================================================================
+
+	tf.RunTest("Delete existing - IsOk", cache.Delete(ctx, "contract:k").IsOk())
+	r5 := cache.Get(ctx, "contract:k")
+	tf.RunTest("Get after Delete - Ok(None)", r5.IsOk() && r5.Value().IsNone())
+	tf.RunTest("Delete missing - IsOk", cache.Delete(ctx, "contract:k").IsOk())
+
+	// ========================================================================
+	// Contract: expiry
+	// ========================================================================
+
+	cache.Set(ctx, "contract:ttl", []byte("short"), 20*time.Millisecond)
+	cache.Set(ctx, "contract:forever", []byte("long"), 0)
+	time.Sleep(60 * time.Millisecond)
+	r6 := cache.Get(ctx, "contract:ttl")
+	tf.RunTest("Expired entry - Ok(None)", r6.IsOk() && r6.Value().IsNone())
+	r7 := cache.Get(ctx, "contract:forever")
+	tf.RunTest("No-ttl entry - still present", r7.IsOk() && r7.Value().IsSome())
+
+	// ========================================================================
+	// Contract: cancellation
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Get cancelled - InfrastructureError", isInfrastructureErr(cache.Get(cancelled, "contract:forever")))
+	tf.RunTest("Set cancelled - InfrastructureError", isInfrastructureErr(cache.Set(cancelled, "contract:x", nil, 0)))
+	tf.RunTest("Delete cancelled - InfrastructureError", isInfrastructureErr(cache.Delete(cancelled, "contract:x")))
+
+	tf.Summary(t)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance suite for ChangeDetectorPort adapters
+
+package porttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// ChangeDetectorFactory returns a fresh detector with nothing recorded.
+type ChangeDetectorFactory func(t *testing.T) outbound.ChangeDetectorPort
+
+// TestChangeDetectorPortContract verifies that the ChangeDetectorPort
+// adapter built by factory honors the ChangeDetectorPort contract:
+//
+//   - An unknown key is Changed
+//   - After Record, the same digest is unchanged and a different one changed
+//   - Record replaces the previous digest; keys are independent
+//   - A cancelled context yields Err(InfrastructureError)
+func TestChangeDetectorPortContract(t *testing.T, factory ChangeDetectorFactory) {
+	t.Helper()
+	tf := test.New("Contract.ChangeDetectorPort")
+	ctx := context.Background()
+	d := factory(t)
+
+	is := func(r domerr.Result[bool], want bool) bool { return r.IsOk() && r.Value() == want }
+
+	// ========================================================================
+	// Contract: digests per key
+	// ========================================================================
+
+	tf.RunTest("Unknown key - changed", is(d.Changed(ctx, "contract:a", "d1"), true))
+	tf.RunTest("Record - IsOk", d.Record(ctx, "contract:a", "d1").IsOk())
+	tf.RunTest("Same digest - unchanged", is(d.Changed(ctx, "contract:a", "d1"), false))
+	tf.RunTest("Other digest - changed", is(d.Changed(ctx, "contract:a", "d2"), true))
+
+	d.Record(ctx, "contract:a", "d2")
+	tf.RunTest("Record again - replaces", is(d.Changed(ctx, "contract:a", "d2"), false) &&
+		is(d.Changed(ctx, "contract:a", "d1"), true))
+	tf.RunTest("Keys independent", is(d.Changed(ctx, "contract:b", "d2"), true))
+
+	// ========================================================================
+	// Contract: cancellation
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	r1 := d.Changed(cancelled, "contract:a", "d2")
+	tf.RunTest("Changed cancelled - InfrastructureError", r1.IsError() &&
+		r1.ErrorInfo().Kind == domerr.InfrastructureError)
+	r2 := d.Record(cancelled, "contract:a", "d3")
+	tf.RunTest("Record cancelled - InfrastructureError", r2.IsError() &&
+		r2.ErrorInfo().Kind == domerr.InfrastructureError)
+
+	tf.Summary(t)
+}
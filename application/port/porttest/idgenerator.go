@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance suite for IDGeneratorPort adapters
+
+package porttest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// idSampleSize is how many IDs each uniqueness check draws.
+const idSampleSize = 2000
+
+// IDGeneratorFactory returns a fresh ID generator.
+type IDGeneratorFactory func(t *testing.T) outbound.IDGeneratorPort
+
+// TestIDGeneratorPortContract verifies that the IDGeneratorPort adapter
+// built by factory honors the IDGeneratorPort contract:
+//
+//   - NewID returns Ok with a non-empty string
+//   - IDs are unique, sequentially and across concurrent callers
+//
+// Time-ordering is not checked here: it only applies to time-based
+// generators and is covered by their own tests.
+func TestIDGeneratorPortContract(t *testing.T, factory IDGeneratorFactory) {
+	t.Helper()
+	tf := test.New("Contract.IDGeneratorPort")
+	gen := factory(t)
+
+	seen := make(map[string]bool, idSampleSize*2)
+	okAll, uniqueAll := true, true
+	for i := 0; i < idSampleSize; i++ {
+		r := gen.NewID()
+		if r.IsError() || r.Value() == "" {
+			okAll = false
+			continue
+		}
+		if seen[r.Value()] {
+			uniqueAll = false
+		}
+		seen[r.Value()] = true
+	}
+	tf.RunTest("Sequential - all Ok and non-empty", okAll)
+	tf.RunTest("Sequential - unique", uniqueAll)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	okAll, uniqueAll = true, true
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < idSampleSize/8; i++ {
+				r := gen.NewID()
+				mu.Lock()
+				if r.IsError() || r.Value() == "" {
+					okAll = false
+				} else if seen[r.Value()] {
+					uniqueAll = false
+				} else {
+					seen[r.Value()] = true
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	tf.RunTest("Concurrent - all Ok and non-empty", okAll)
+	tf.RunTest("Concurrent - unique", uniqueAll)
+
+	tf.Summary(t)
+}
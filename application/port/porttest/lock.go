@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance suite for LockPort adapters
+
+package porttest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// LockFactory returns a fresh lock adapter with no keys held.
+type LockFactory func(t *testing.T) outbound.LockPort
+
+// TestLockPortContract verifies that the LockPort adapter built by factory
+// honors the LockPort contract:
+//
+//   - Acquire of a free key is Ok(Lease) for that key with a non-empty token
+//   - Acquire of a held key is Err(InfrastructureError)
+//   - Release with a foreign token is Ok and keeps the lock held
+//   - Release by the holder frees the key
+//   - A lease expires after its ttl
+//   - A cancelled context yields Err(InfrastructureError) on Acquire
+func TestLockPortContract(t *testing.T, factory LockFactory) {
+	t.Helper()
+	tf := test.New("Contract.LockPort")
+	ctx := context.Background()
+	locks := factory(t)
+
+	// ========================================================================
+	// Contract: mutual exclusion
+	// ========================================================================
+
+	r1 := locks.Acquire(ctx, "contract:job", time.Minute)
+	tf.RunTest("Acquire free - IsOk", r1.IsOk())
+	tf.RunTest("Acquire free - lease for key", r1.IsOk() &&
+		r1.Value().Key == "contract:job" && r1.Value().Token != "")
+	tf.RunTest("Acquire held - InfrastructureError",
+		isInfrastructureErr(locks.Acquire(ctx, "contract:job", time.Minute)))
+	tf.RunTest("Acquire other key - IsOk", locks.Acquire(ctx, "contract:other", time.Minute).IsOk())
+
+	// ========================================================================
+	// Contract: only the holder releases
+	// ========================================================================
+
+	if r1.IsOk() {
+		foreign := r1.Value()
+		foreign.Token = "not-the-holder"
+		tf.RunTest("Release foreign token - IsOk", locks.Release(ctx, foreign).IsOk())
+		tf.RunTest("Release foreign token - still held",
+			locks.Acquire(ctx, "contract:job", time.Minute).IsError())
+		tf.RunTest("Release holder - IsOk", locks.Release(ctx, r1.Value()).IsOk())
+	}
+	r2 := locks.Acquire(ctx, "contract:job", time.Minute)
+	tf.RunTest("Acquire after Release - IsOk", r2.IsOk())
+
+	// ========================================================================
+	// Contract: expiry
+	// ========================================================================
+
+	locks.Acquire(ctx, "contract:ttl", 20*time.Millisecond)
+	time.Sleep(60 * time.Millisecond)
+	tf.RunTest("Acquire after expiry - IsOk", locks.Acquire(ctx, "contract:ttl", time.Minute).IsOk())
+
+	// ========================================================================
+	// Contract: cancellation
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Acquire cancelled - InfrastructureError",
+		isInfrastructureErr(locks.Acquire(cancelled, "contract:cancel", time.Minute)))
+
+	tf.Summary(t)
+}
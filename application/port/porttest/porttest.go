@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance test suites for output port adapters
+
+// Package porttest provides conformance test suites for output port
+// adapters. An adapter author calls the suite from an ordinary test with a
+// factory for their adapter; the suite checks the semantics the application
+// layer relies on beyond the method signatures.
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer; ports own their contracts
+//   - Imported only from _test.go files
+//   - One suite per port: TestWriterPortContract, TestCachePortContract,
+//     TestLockPortContract, TestSecretPortContract, TestIDGeneratorPortContract
+//   - Run under -race so the concurrency checks are meaningful
+//
+// Usage:
+//
+//	func TestMyWriterContract(t *testing.T) {
+//	    porttest.TestWriterPortContract(t, func(t *testing.T) outbound.WriterPort {
+//	        return mywriter.New(...)
+//	    })
+//	}
+package porttest
+
+import domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+
+// isInfrastructureErr reports whether r is Err(InfrastructureError).
+func isInfrastructureErr[T any](r domerr.Result[T]) bool {
+	return r.IsError() && r.ErrorInfo().Kind == domerr.InfrastructureError
+}
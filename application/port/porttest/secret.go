@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance suite for SecretPort adapters
+
+package porttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// SecretFactory returns a secret adapter whose backend holds exactly the
+// given key/value pairs. Keys have the form "<path>/<field>".
+type SecretFactory func(t *testing.T, secrets map[string]string) outbound.SecretPort
+
+// TestSecretPortContract verifies that the SecretPort adapter built by
+// factory honors the SecretPort contract:
+//
+//   - Get of a stored key returns Ok(Secret) with the stored bytes
+//   - Get of a missing key is Err(InfrastructureError)
+//   - The Secret prints redacted
+//   - A cancelled context yields Err(InfrastructureError)
+func TestSecretPortContract(t *testing.T, factory SecretFactory) {
+	t.Helper()
+	tf := test.New("Contract.SecretPort")
+	ctx := context.Background()
+	secrets := factory(t, map[string]string{"contract/api/token": "t0ken"})
+
+	r1 := secrets.Get(ctx, "contract/api/token")
+	tf.RunTest("Get stored - IsOk", r1.IsOk())
+	tf.RunTest("Get stored - value", r1.IsOk() && string(r1.Value().Bytes()) == "t0ken")
+	tf.RunTest("Get stored - redacted when printed", r1.IsOk() && r1.Value().String() == "[REDACTED]")
+
+	r2 := secrets.Get(ctx, "contract/api/absent")
+	tf.RunTest("Get missing - InfrastructureError", isInfrastructureErr(r2))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	r3 := secrets.Get(cancelled, "contract/api/token")
+	tf.RunTest("Get cancelled - InfrastructureError", isInfrastructureErr(r3))
+
+	tf.Summary(t)
+}
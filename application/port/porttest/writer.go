@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: porttest
+// Description: Conformance suite for WriterPort adapters
+
+package porttest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// concurrentWriters is the number of goroutines in the concurrency check.
+const concurrentWriters = 32
+
+// WriterFactory returns a fresh, ready-to-use adapter. Use t.Cleanup to
+// release resources (listeners, files) the adapter needs.
+type WriterFactory func(t *testing.T) outbound.WriterPort
+
+// TestWriterPortContract verifies that the WriterPort adapter built by
+// factory honors the WriterPort contract:
+//
+//   - Write with a live context returns Ok(Unit)
+//   - Write with a cancelled context returns Err(InfrastructureError)
+//   - Write with an expired deadline returns Err(InfrastructureError)
+//   - Concurrent Writes all succeed (and are race-free under -race)
+//   - Write never panics
+func TestWriterPortContract(t *testing.T, factory WriterFactory) {
+	t.Helper()
+	tf := test.New("Contract.WriterPort")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Contract: live context succeeds
+	// ========================================================================
+
+	r1, panicked := safeWrite(factory(t), ctx, "contract: hello")
+	tf.RunTest("Live context - no panic", !panicked)
+	tf.RunTest("Live context - IsOk", !panicked && r1.IsOk())
+
+	// ========================================================================
+	// Contract: cancellation is honored
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	r2, panicked := safeWrite(factory(t), cancelled, "contract: cancelled")
+	tf.RunTest("Cancelled context - no panic", !panicked)
+	tf.RunTest("Cancelled context - InfrastructureError", !panicked && isInfrastructureErr(r2))
+
+	// ========================================================================
+	// Contract: deadline expiry is honored
+	// ========================================================================
+
+	expired, cancelExpired := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancelExpired()
+	r3, panicked := safeWrite(factory(t), expired, "contract: expired")
+	tf.RunTest("Expired deadline - no panic", !panicked)
+	tf.RunTest("Expired deadline - InfrastructureError", !panicked && isInfrastructureErr(r3))
+
+	// ========================================================================
+	// Contract: concurrent writes on one adapter
+	// ========================================================================
+
+	shared := factory(t)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failures := 0
+	for i := 0; i < concurrentWriters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r, panicked := safeWrite(shared, ctx, fmt.Sprintf("contract: concurrent %d", i))
+			if panicked || r.IsError() {
+				mu.Lock()
+				failures++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	tf.RunTest("Concurrent writes - all IsOk", failures == 0)
+
+	tf.Summary(t)
+}
+
+// safeWrite calls Write, reporting a panic instead of propagating it.
+func safeWrite(w outbound.WriterPort, ctx context.Context, message string) (result domerr.Result[model.Unit], panicked bool) {
+	defer func() {
+		if recover() != nil {
+			panicked = true
+		}
+	}()
+	return w.Write(ctx, message), false
+}
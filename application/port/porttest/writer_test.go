@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package porttest_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/porttest"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// memoryWriter is a minimal conforming WriterPort used to self-test the suite.
+type memoryWriter struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (w *memoryWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(err.Error()))
+	}
+	w.mu.Lock()
+	w.messages = append(w.messages, message)
+	w.mu.Unlock()
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestWriterPortContractSelfTest runs the suite against a conforming writer.
+func TestWriterPortContractSelfTest(t *testing.T) {
+	porttest.TestWriterPortContract(t, func(*testing.T) outbound.WriterPort {
+		return &memoryWriter{}
+	})
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: query
+// Description: Field filter types
+
+package query
+
+import (
+	"fmt"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Op is a filter comparison operator. It encodes to JSON as its string value.
+type Op string
+
+// Supported filter operators.
+const (
+	OpEq       Op = "eq"
+	OpNe       Op = "ne"
+	OpLt       Op = "lt"
+	OpLte      Op = "lte"
+	OpGt       Op = "gt"
+	OpGte      Op = "gte"
+	OpPrefix   Op = "prefix"
+	OpContains Op = "contains"
+)
+
+// IsValid returns true if op is one of the supported operators.
+func (op Op) IsValid() bool {
+	switch op {
+	case OpEq, OpNe, OpLt, OpLte, OpGt, OpGte, OpPrefix, OpContains:
+		return true
+	default:
+		return false
+	}
+}
+
+// Filter restricts results to items whose Field compares to Value via Op.
+//
+// Value is kept as a string; each repository converts it to the field's
+// native type and reports a ValidationError if that fails.
+type Filter struct {
+	Field string `json:"field"`
+	Op    Op     `json:"op"`
+	Value string `json:"value"`
+}
+
+// Validate checks the field against the allow-list and the operator.
+//
+// Contract:
+//   - Returns Err(ValidationError) for an unknown field or operator
+func (f Filter) Validate(allowedFields ...string) domerr.Result[Filter] {
+	if r := checkField("filter", f.Field, allowedFields); r.IsError() {
+		return domerr.Err[Filter](r.ErrorInfo())
+	}
+	if !f.Op.IsValid() {
+		return domerr.Err[Filter](domerr.NewValidationError(
+			fmt.Sprintf("filter operator %q is not supported", f.Op)))
+	}
+	return domerr.Ok(f)
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: query
+// Description: Cursor-based pagination types
+
+package query
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+const (
+	// DefaultPageLimit is used when a PageRequest has no Limit.
+	DefaultPageLimit = 50
+
+	// MaxPageLimit is the largest Limit a PageRequest may ask for.
+	MaxPageLimit = 1000
+)
+
+// Cursor is an opaque continuation token.
+//
+// Clients must treat it as opaque and only echo back what a previous Page
+// returned. Repositories encode their own position (an ID, an offset, a
+// timestamp...) with NewCursor and decode it with Position.
+//
+// The zero value means "start from the beginning".
+type Cursor string
+
+// NewCursor encodes a repository-specific position as an opaque Cursor.
+func NewCursor(position string) Cursor {
+	if position == "" {
+		return ""
+	}
+	return Cursor(base64.RawURLEncoding.EncodeToString([]byte(position)))
+}
+
+// IsZero returns true if the cursor points at the beginning.
+func (c Cursor) IsZero() bool {
+	return c == ""
+}
+
+// Position decodes the repository-specific position.
+//
+// Contract:
+//   - Returns Ok("") for the zero cursor
+//   - Returns Err(ValidationError) if the cursor was not produced by NewCursor
+func (c Cursor) Position() domerr.Result[string] {
+	if c.IsZero() {
+		return domerr.Ok("")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err != nil {
+		return domerr.Err[string](domerr.NewValidationError("cursor is malformed"))
+	}
+	return domerr.Ok(string(raw))
+}
+
+// PageRequest asks for at most Limit items after the After cursor.
+type PageRequest struct {
+	Limit int    `json:"limit,omitempty"`
+	After Cursor `json:"after,omitempty"`
+}
+
+// Validate checks the request and applies defaults.
+//
+// Contract:
+//   - Limit 0 becomes DefaultPageLimit
+//   - Returns Err(ValidationError) if Limit < 0 or Limit > MaxPageLimit
+//   - Returns Err(ValidationError) if After is malformed
+func (p PageRequest) Validate() domerr.Result[PageRequest] {
+	if p.Limit == 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit < 0 || p.Limit > MaxPageLimit {
+		return domerr.Err[PageRequest](domerr.NewValidationError(
+			fmt.Sprintf("page limit must be between 1 and %d", MaxPageLimit)))
+	}
+	if r := p.After.Position(); r.IsError() {
+		return domerr.Err[PageRequest](r.ErrorInfo())
+	}
+	return domerr.Ok(p)
+}
+
+// Page is one page of query results.
+//
+// Contract:
+//   - Next is the zero Cursor when there are no more items
+//   - len(Items) <= the requested Limit
+type Page[T any] struct {
+	Items []T    `json:"items"`
+	Next  Cursor `json:"next,omitempty"`
+}
+
+// NewPage creates a page of items with the cursor for the following page.
+func NewPage[T any](items []T, next Cursor) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return Page[T]{Items: items, Next: next}
+}
+
+// HasMore returns true if another page can be requested with Next.
+func (p Page[T]) HasMore() bool {
+	return !p.Next.IsZero()
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: query
+// Description: Pagination, sorting and filtering DTOs for read use cases
+
+// Package query provides the shared request/response types for read-side
+// use cases: cursor pagination, sorting and filtering.
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer (DTOs crossing the inbound boundary)
+//   - Plain data with JSON tags; validation returns Result (no panics)
+//   - Field names are validated against an allow-list supplied by each
+//     query port, so adapters never see unknown fields
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/application/query"
+//
+//	q := query.Query{
+//	    Page:    query.PageRequest{Limit: 20},
+//	    Sort:    []query.Sort{{Field: "created_at", Direction: query.Descending}},
+//	    Filters: []query.Filter{{Field: "name", Op: query.OpPrefix, Value: "Al"}},
+//	}
+//	result := q.Validate("name", "created_at")
+package query
+
+import (
+	"fmt"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Query bundles pagination, sorting and filtering for one read request.
+type Query struct {
+	Page    PageRequest `json:"page"`
+	Sort    []Sort      `json:"sort,omitempty"`
+	Filters []Filter    `json:"filters,omitempty"`
+}
+
+// Validate checks every part of the query against the allowed field names
+// and returns the query with defaults applied (see PageRequest.Validate).
+//
+// Contract:
+//   - Returns Ok(Query) if page, every sort and every filter are valid
+//   - Returns Err(ValidationError) for the first invalid part
+func (q Query) Validate(allowedFields ...string) domerr.Result[Query] {
+	pageResult := q.Page.Validate()
+	if pageResult.IsError() {
+		return domerr.Err[Query](pageResult.ErrorInfo())
+	}
+	q.Page = pageResult.Value()
+
+	// Copy so applying defaults never mutates the caller's slice.
+	sorts := make([]Sort, len(q.Sort))
+	for i, s := range q.Sort {
+		r := s.Validate(allowedFields...)
+		if r.IsError() {
+			return domerr.Err[Query](r.ErrorInfo())
+		}
+		sorts[i] = r.Value()
+	}
+	if q.Sort != nil {
+		q.Sort = sorts
+	}
+
+	for _, f := range q.Filters {
+		if r := f.Validate(allowedFields...); r.IsError() {
+			return domerr.Err[Query](r.ErrorInfo())
+		}
+	}
+
+	return domerr.Ok(q)
+}
+
+// checkField returns Ok(field) if it is in allowed, Err(ValidationError)
+// otherwise. An empty allow-list rejects every field.
+func checkField(kind, field string, allowed []string) domerr.Result[string] {
+	for _, a := range allowed {
+		if a == field {
+			return domerr.Ok(field)
+		}
+	}
+	return domerr.Err[string](domerr.NewValidationError(
+		fmt.Sprintf("%s field %q is not supported", kind, field)))
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package query_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/query"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationQuery tests pagination, sort and filter DTOs.
+func TestApplicationQuery(t *testing.T) {
+	tf := test.New("Application.Query")
+	fields := []string{"name", "created_at"}
+
+	// ========================================================================
+	// Test: Cursor round trip
+	// ========================================================================
+
+	c := query.NewCursor("id:42")
+	tf.RunTest("Cursor - not zero", !c.IsZero())
+	tf.RunTest("Cursor - position round trip", c.Position().UnwrapOr("") == "id:42")
+	tf.RunTest("Cursor - zero position is empty", query.Cursor("").Position().UnwrapOr("x") == "")
+	tf.RunTest("Cursor - malformed IsError", query.Cursor("%%%").Position().IsError())
+
+	// ========================================================================
+	// Test: PageRequest validation
+	// ========================================================================
+
+	p1 := query.PageRequest{}.Validate()
+	tf.RunTest("Page - zero limit defaults", p1.IsOk() && p1.Value().Limit == query.DefaultPageLimit)
+	tf.RunTest("Page - negative limit IsError", query.PageRequest{Limit: -1}.Validate().IsError())
+	tf.RunTest("Page - limit above max IsError",
+		query.PageRequest{Limit: query.MaxPageLimit + 1}.Validate().IsError())
+	tf.RunTest("Page - malformed cursor IsError",
+		query.PageRequest{Limit: 1, After: "%%%"}.Validate().IsError())
+
+	page := query.NewPage([]string{"a"}, query.NewCursor("1"))
+	tf.RunTest("Page - HasMore with next cursor", page.HasMore())
+	tf.RunTest("Page - nil items become empty slice", query.NewPage[int](nil, "").Items != nil)
+
+	// ========================================================================
+	// Test: Sort and Filter validation
+	// ========================================================================
+
+	s1 := query.Sort{Field: "name"}.Validate(fields...)
+	tf.RunTest("Sort - default direction ascending", s1.IsOk() && s1.Value().Direction == query.Ascending)
+	tf.RunTest("Sort - unknown field IsError", query.Sort{Field: "age"}.Validate(fields...).IsError())
+	tf.RunTest("Sort - bad direction IsError",
+		query.Sort{Field: "name", Direction: "up"}.Validate(fields...).IsError())
+
+	tf.RunTest("Filter - valid IsOk",
+		query.Filter{Field: "name", Op: query.OpPrefix, Value: "Al"}.Validate(fields...).IsOk())
+	tf.RunTest("Filter - unknown op IsError",
+		query.Filter{Field: "name", Op: "like"}.Validate(fields...).IsError())
+	r := query.Filter{Field: "age", Op: query.OpEq}.Validate(fields...)
+	tf.RunTest("Filter - unknown field is ValidationError",
+		r.IsError() && r.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: Query validation and JSON encoding
+	// ========================================================================
+
+	q := query.Query{
+		Sort:    []query.Sort{{Field: "created_at", Direction: query.Descending}},
+		Filters: []query.Filter{{Field: "name", Op: query.OpEq, Value: "Alice"}},
+	}
+	qr := q.Validate(fields...)
+	tf.RunTest("Query - valid IsOk", qr.IsOk())
+	tf.RunTest("Query - defaults applied", qr.IsOk() && qr.Value().Page.Limit == query.DefaultPageLimit)
+
+	data, err := json.Marshal(qr.Value())
+	tf.RunTest("Query - JSON encoding",
+		err == nil && string(data) ==
+			`{"page":{"limit":50},"sort":[{"field":"created_at","direction":"desc"}],"filters":[{"field":"name","op":"eq","value":"Alice"}]}`)
+
+	var decoded query.Query
+	err = json.Unmarshal(data, &decoded)
+	tf.RunTest("Query - JSON decoding", err == nil && decoded.Sort[0].Direction == query.Descending)
+
+	bad := query.Query{Filters: []query.Filter{{Field: "age", Op: query.OpEq}}}
+	tf.RunTest("Query - invalid filter IsError", bad.Validate(fields...).IsError())
+
+	tf.Summary(t)
+}
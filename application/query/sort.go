@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: query
+// Description: Sort order types
+
+package query
+
+import (
+	"fmt"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Direction is a sort direction. It encodes to JSON as "asc" or "desc".
+type Direction string
+
+const (
+	// Ascending sorts from smallest to largest.
+	Ascending Direction = "asc"
+
+	// Descending sorts from largest to smallest.
+	Descending Direction = "desc"
+)
+
+// Sort orders results by one field.
+type Sort struct {
+	Field     string    `json:"field"`
+	Direction Direction `json:"direction,omitempty"`
+}
+
+// Validate checks the field against the allow-list and the direction.
+//
+// Contract:
+//   - An empty Direction becomes Ascending
+//   - Returns Err(ValidationError) for an unknown field or direction
+func (s Sort) Validate(allowedFields ...string) domerr.Result[Sort] {
+	if r := checkField("sort", s.Field, allowedFields); r.IsError() {
+		return domerr.Err[Sort](r.ErrorInfo())
+	}
+	switch s.Direction {
+	case "":
+		s.Direction = Ascending
+	case Ascending, Descending:
+	default:
+		return domerr.Err[Sort](domerr.NewValidationError(
+			fmt.Sprintf("sort direction %q must be %q or %q", s.Direction, Ascending, Descending)))
+	}
+	return domerr.Ok(s)
+}
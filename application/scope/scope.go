@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: scope
+// Description: Structured concurrency for Result-returning goroutines
+
+// Package scope runs a group of goroutines that each return a Result,
+// cancelling the rest as soon as one fails and collecting every failure.
+//
+// It plays the role errgroup plays for plain errors, but keeps use cases in
+// the Result model: no converting ErrorType to error and back, no lost
+// ErrorKind or RetryAfter hint. Code already built on errgroup can run
+// Result-returning functions on it with GroupGo.
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer
+//   - Depends only on domain types and the standard library
+//   - For use cases that fan out to several outbound ports concurrently
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_lib_go/application/scope"
+//
+//	s := scope.New[model.Unit](ctx)
+//	s.Go(func(ctx context.Context) domerr.Result[model.Unit] { return cache.Set(ctx, k, v, ttl) })
+//	s.Go(func(ctx context.Context) domerr.Result[model.Unit] { return events.Publish(ctx, rec) })
+//	result := s.Wait() // Ok([]Unit) or Err(first failure, all messages)
+//	if result.IsError() {
+//	    for _, err := range s.Errors() { ... }
+//	}
+package scope
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MultiError is every failure of a Scope, in the order they occurred. The
+// first entry is the failure that cancelled the scope; later entries are
+// often its consequences (siblings reporting cancellation).
+type MultiError []domerr.ErrorType
+
+// Error joins all messages with "; ".
+func (m MultiError) Error() string {
+	messages := make([]string, len(m))
+	for i, err := range m {
+		messages[i] = err.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ErrorType collapses m into one ErrorType carrying the Kind and
+// RetryAfter hint of the first failure and the joined message.
+//
+// Contract:
+//   - m must be non-empty
+//   - A single failure is returned unchanged
+func (m MultiError) ErrorType() domerr.ErrorType {
+	if len(m) == 1 {
+		return m[0]
+	}
+	first := m[0]
+	combined := domerr.ErrorType{Kind: first.Kind, Message: fmt.Sprintf("%d failures: %s", len(m), m.Error())}
+	return combined.WithRetryAfter(first.RetryAfter)
+}
+
+// Scope is a group of goroutines sharing a context that is cancelled on
+// the first Err.
+//
+// Contract:
+//   - Go may be called from any goroutine until Wait is called
+//   - Wait is called exactly once; the scope is not reusable
+//   - A panicking goroutine counts as an InfrastructureError; the panic does
+//     not escape the scope
+type Scope[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	values []T
+	errs   MultiError
+}
+
+// New creates a scope whose goroutines run under a child of ctx.
+// Cancelling ctx cancels them all.
+func New[T any](ctx context.Context) *Scope[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Scope[T]{ctx: ctx, cancel: cancel}
+}
+
+// Go runs fn in a new goroutine. fn should return promptly once its ctx is
+// done.
+func (s *Scope[T]) Go(fn func(ctx context.Context) domerr.Result[T]) {
+	s.mu.Lock()
+	index := len(s.values)
+	var zero T
+	s.values = append(s.values, zero)
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		result := run(s.ctx, fn)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if result.IsError() {
+			s.errs = append(s.errs, result.ErrorInfo())
+			s.cancel()
+			return
+		}
+		s.values[index] = result.Value()
+	}()
+}
+
+// Wait blocks until every goroutine has returned.
+//
+// Contract:
+//   - Returns Ok(values) in the order Go was called if all succeeded
+//   - Returns Err(Errors().ErrorType()) otherwise
+func (s *Scope[T]) Wait() domerr.Result[[]T] {
+	s.wg.Wait()
+	s.cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.errs) > 0 {
+		return domerr.Err[[]T](s.errs.ErrorType())
+	}
+	return domerr.Ok(s.values)
+}
+
+// Errors returns every failure collected so far, in the order they
+// occurred; nil if none. Complete once Wait has returned.
+func (s *Scope[T]) Errors() MultiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append(MultiError(nil), s.errs...)
+}
+
+// run calls fn, converting a panic into an InfrastructureError.
+func run[T any](ctx context.Context, fn func(ctx context.Context) domerr.Result[T]) (result domerr.Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[T](domerr.NewInfrastructureError(
+				fmt.Sprintf("scope goroutine panicked: %v", r)))
+		}
+	}()
+	return fn(ctx)
+}
+
+// Group is the method set shared by errgroup.Group and similar groups
+// from the wider ecosystem. Declaring it here keeps this module free of
+// the dependency.
+type Group interface {
+	Go(f func() error)
+}
+
+// GroupGo runs fn on g and delivers its Result on the returned channel
+// (buffered, so it never blocks the goroutine). An Err is also returned to
+// g as the ErrorType itself, so g's own cancellation and Wait work as
+// usual, and domerr.FromError on g.Wait()'s error recovers it unchanged.
+//
+// Usage:
+//
+//	g, gctx := errgroup.WithContext(ctx)
+//	secret := scope.GroupGo(g, func() domerr.Result[model.Secret] { return secrets.Get(gctx, key) })
+//	if err := g.Wait(); err != nil { ... }
+//	result := <-secret
+func GroupGo[T any](g Group, fn func() domerr.Result[T]) <-chan domerr.Result[T] {
+	out := make(chan domerr.Result[T], 1)
+	g.Go(func() error {
+		result := run(context.Background(), func(context.Context) domerr.Result[T] { return fn() })
+		out <- result
+		_, err := result.Unpack()
+		return err
+	})
+	return out
+}
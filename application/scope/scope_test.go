@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package scope
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// firstErrGroup mimics errgroup.Group: Wait returns the first error.
+type firstErrGroup struct {
+	wg   sync.WaitGroup
+	once sync.Once
+	err  error
+}
+
+func (g *firstErrGroup) Go(f func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := f(); err != nil {
+			g.once.Do(func() { g.err = err })
+		}
+	}()
+}
+
+func (g *firstErrGroup) Wait() error {
+	g.wg.Wait()
+	return g.err
+}
+
+// TestApplicationScope tests Result-based structured concurrency.
+func TestApplicationScope(t *testing.T) {
+	tf := test.New("Application.Scope")
+	ctx := context.Background()
+
+	value := func(v int, delay time.Duration) func(context.Context) domerr.Result[int] {
+		return func(context.Context) domerr.Result[int] {
+			time.Sleep(delay)
+			return domerr.Ok(v)
+		}
+	}
+	// waitCancelled blocks until its ctx is done and reports that as a failure.
+	waitCancelled := func(ctx context.Context) domerr.Result[int] {
+		select {
+		case <-ctx.Done():
+			return domerr.Err[int](domerr.NewInfrastructureError("sibling cancelled"))
+		case <-time.After(5 * time.Second):
+			return domerr.Ok(-1)
+		}
+	}
+
+	// ========================================================================
+	// Test: All succeed
+	// ========================================================================
+
+	s := New[int](ctx)
+	s.Go(value(1, 20*time.Millisecond))
+	s.Go(value(2, 0))
+	s.Go(value(3, 10*time.Millisecond))
+	r := s.Wait()
+	tf.RunTest("All Ok - values in Go order", r.IsOk() && len(r.Value()) == 3 &&
+		r.Value()[0] == 1 && r.Value()[1] == 2 && r.Value()[2] == 3)
+	tf.RunTest("All Ok - no errors", s.Errors() == nil)
+
+	empty := New[int](ctx).Wait()
+	tf.RunTest("Empty scope - Ok", empty.IsOk() && len(empty.Value()) == 0)
+
+	// ========================================================================
+	// Test: First failure cancels siblings and is reported first
+	// ========================================================================
+
+	start := time.Now()
+	s = New[int](ctx)
+	s.Go(waitCancelled)
+	s.Go(func(context.Context) domerr.Result[int] {
+		return domerr.Err[int](domerr.NewValidationError("name empty").WithRetryAfter(time.Second))
+	})
+	s.Go(waitCancelled)
+	r = s.Wait()
+	tf.RunTest("Failure - siblings cancelled promptly", time.Since(start) < 2*time.Second)
+	tf.RunTest("Failure - all errors collected", len(s.Errors()) == 3 && s.Errors()[0].Message == "name empty")
+	tf.RunTest("Failure - Kind of first failure", r.IsError() && r.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Failure - RetryAfter of first failure", r.IsError() && r.ErrorInfo().RetryAfter == time.Second)
+	tf.RunTest("Failure - joined message", r.IsError() &&
+		r.ErrorInfo().Message == "3 failures: name empty; sibling cancelled; sibling cancelled")
+
+	s = New[int](ctx)
+	s.Go(value(1, 0))
+	s.Go(func(context.Context) domerr.Result[int] {
+		return domerr.Err[int](domerr.NewInfrastructureError("disk full"))
+	})
+	r = s.Wait()
+	tf.RunTest("Single failure - unchanged", r.IsError() && r.ErrorInfo().Message == "disk full")
+
+	// ========================================================================
+	// Test: Panics and parent cancellation
+	// ========================================================================
+
+	s = New[int](ctx)
+	s.Go(func(context.Context) domerr.Result[int] { panic("boom") })
+	r = s.Wait()
+	tf.RunTest("Panic - InfrastructureError", r.IsError() && r.ErrorInfo().Kind == domerr.InfrastructureError &&
+		strings.Contains(r.ErrorInfo().Message, "panicked: boom"))
+
+	parent, cancel := context.WithCancel(ctx)
+	s = New[int](parent)
+	s.Go(waitCancelled)
+	cancel()
+	tf.RunTest("Parent cancelled - propagates", s.Wait().IsError())
+
+	// ========================================================================
+	// Test: GroupGo bridge
+	// ========================================================================
+
+	g := &firstErrGroup{}
+	okCh := GroupGo(g, func() domerr.Result[int] { return domerr.Ok(7) })
+	hinted := domerr.NewInfrastructureError("throttled").WithRetryAfter(time.Second)
+	errCh := GroupGo(g, func() domerr.Result[int] { return domerr.Err[int](hinted) })
+	panicCh := GroupGo(g, func() domerr.Result[int] { panic("boom") })
+	groupErr := g.Wait()
+	ok, failed, panicked := <-okCh, <-errCh, <-panicCh
+	tf.RunTest("GroupGo - Ok delivered", ok.IsOk() && ok.Value() == 7)
+	tf.RunTest("GroupGo - Err delivered unchanged", failed.IsError() && failed.ErrorInfo() == hinted)
+	tf.RunTest("GroupGo - panic becomes Err", panicked.IsError() &&
+		strings.Contains(panicked.ErrorInfo().Message, "panicked: boom"))
+	tf.RunTest("GroupGo - group sees ErrorType", groupErr != nil && domerr.FromError(0, groupErr).IsError())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Erase subject use case (right to erasure)
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// ErasureStoreHistory names the greeting history in an ErasureReport.
+const ErasureStoreHistory = "history"
+
+// ErasableHistory is a history store the subject can be found in and
+// removed from.
+type ErasableHistory interface {
+	outbound.HistoryPort
+	outbound.HistoryReaderPort
+}
+
+// EraseSubjectUseCase removes every stored record of a data subject.
+//
+// Matching: a record belongs to the subject when its Name equals the
+// subject ignoring case (Unicode case folding), so "alice" also erases
+// "Alice". Records are removed rather than anonymized: a greeting record
+// without its name has no remaining purpose.
+//
+// Stores: the greeting history. Data that has left the library's stores
+// is out of reach and must be handled by its owner:
+//   - "greeted" events already published (GreetAndRecordUseCase)
+//   - history exports already written to a BlobPort; re-export after
+//     erasure to replace them
+//
+// Idempotence: re-running after a partial failure erases what remains;
+// running it for an unknown subject reports 0 records.
+//
+// Implements: inbound.EraseSubjectPort
+type EraseSubjectUseCase[H ErasableHistory] struct {
+	history H
+}
+
+// NewEraseSubjectUseCase creates the use case with an injected history.
+func NewEraseSubjectUseCase[H ErasableHistory](history H) *EraseSubjectUseCase[H] {
+	return &EraseSubjectUseCase[H]{history: history}
+}
+
+// Execute erases cmd.Subject from every store.
+func (uc *EraseSubjectUseCase[H]) Execute(ctx context.Context, cmd command.EraseSubjectCommand) domerr.Result[model.ErasureReport] {
+	subject := strings.TrimSpace(cmd.GetSubject())
+	if person := valueobject.CreatePerson(subject); person.IsError() {
+		return domerr.Err[model.ErasureReport](person.ErrorInfo())
+	}
+
+	erased := uc.eraseHistory(ctx, subject)
+	if erased.IsError() {
+		return domerr.Err[model.ErasureReport](erased.ErrorInfo())
+	}
+	return domerr.Ok(model.ErasureReport{
+		Subject: subject,
+		Stores:  []model.StoreErasure{{Store: ErasureStoreHistory, Erased: erased.Value()}},
+	})
+}
+
+// eraseHistory removes the subject's history records and returns how many.
+// IDs are collected first: stores need not support removal during a Scan.
+func (uc *EraseSubjectUseCase[H]) eraseHistory(ctx context.Context, subject string) domerr.Result[int] {
+	var ids []string
+	scanned := uc.history.Scan(ctx, func(r model.GreetingRecord) bool {
+		if strings.EqualFold(r.Name, subject) {
+			ids = append(ids, r.CorrelationID)
+		}
+		return true
+	})
+	if scanned.IsError() {
+		return domerr.Err[int](scanned.ErrorInfo())
+	}
+
+	for i, id := range ids {
+		if removed := uc.history.Remove(ctx, id); removed.IsError() {
+			return domerr.Err[int](domerr.NewInfrastructureError(fmt.Sprintf(
+				"erasure incomplete: %d of %d history records removed: %s",
+				i, len(ids), removed.ErrorInfo().Message)))
+		}
+	}
+	return domerr.Ok(len(ids))
+}
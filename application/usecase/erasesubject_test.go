@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// removableHistory is a sliceHistory that also supports Remove; removals
+// fail once removeBudget reaches zero (negative = unlimited).
+type removableHistory struct {
+	sliceHistory
+	removeBudget int
+}
+
+func (h *removableHistory) Append(_ context.Context, r model.GreetingRecord) domerr.Result[model.Unit] {
+	h.records = append(h.records, r)
+	return domerr.Ok(model.UnitValue)
+}
+
+func (h *removableHistory) Remove(_ context.Context, id string) domerr.Result[model.Unit] {
+	if h.removeBudget == 0 {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("store read-only"))
+	}
+	h.removeBudget--
+	for i, r := range h.records {
+		if r.CorrelationID == id {
+			h.records = append(h.records[:i], h.records[i+1:]...)
+			break
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+func (h *removableHistory) names() string {
+	names := make([]string, 0, len(h.records))
+	for _, r := range h.records {
+		names = append(names, r.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+// TestApplicationUseCaseEraseSubject tests the erase subject use case.
+func TestApplicationUseCaseEraseSubject(t *testing.T) {
+	tf := test.New("Application.UseCase.EraseSubject")
+	ctx := context.Background()
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newHistory := func(names ...string) *removableHistory {
+		h := &removableHistory{removeBudget: -1}
+		for i, name := range names {
+			h.records = append(h.records, model.GreetingRecord{
+				Name: name, Text: "Hello, " + name + "!", Timestamp: ts,
+				CorrelationID: string(rune('a' + i)), Locale: "en",
+			})
+		}
+		return h
+	}
+
+	// ========================================================================
+	// Test: Every record of the subject is removed
+	// ========================================================================
+
+	h1 := newHistory("Alice", "Bob", "alice", "Carol", "ALICE")
+	r1 := NewEraseSubjectUseCase(h1).Execute(ctx, command.NewEraseSubjectCommand(" Alice "))
+	tf.RunTest("Erase - IsOk", r1.IsOk())
+	tf.RunTest("Erase - case-insensitive match", h1.names() == "Bob,Carol")
+	tf.RunTest("Erase - report per store", r1.IsOk() &&
+		r1.Value().Subject == "Alice" && len(r1.Value().Stores) == 1 &&
+		r1.Value().Stores[0].Store == ErasureStoreHistory && r1.Value().Stores[0].Erased == 3)
+	tf.RunTest("Erase - report total", r1.IsOk() && r1.Value().Total() == 3)
+
+	r2 := NewEraseSubjectUseCase(h1).Execute(ctx, command.NewEraseSubjectCommand("Alice"))
+	tf.RunTest("Erase again - nothing left, IsOk", r2.IsOk() && r2.Value().Total() == 0)
+	tf.RunTest("Erase again - other subjects kept", h1.names() == "Bob,Carol")
+
+	// ========================================================================
+	// Test: Invalid subject
+	// ========================================================================
+
+	r3 := NewEraseSubjectUseCase(newHistory("Alice")).Execute(ctx, command.NewEraseSubjectCommand("   "))
+	tf.RunTest("Empty subject - ValidationError",
+		r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: Store failures
+	// ========================================================================
+
+	h4 := newHistory("Alice", "Alice", "Alice")
+	h4.removeBudget = 1
+	r4 := NewEraseSubjectUseCase(h4).Execute(ctx, command.NewEraseSubjectCommand("Alice"))
+	tf.RunTest("Remove fails - InfrastructureError",
+		r4.IsError() && r4.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("Remove fails - progress in message",
+		r4.IsError() && strings.Contains(r4.ErrorInfo().Message, "1 of 3 history records removed"))
+
+	h4.removeBudget = -1
+	r4b := NewEraseSubjectUseCase(h4).Execute(ctx, command.NewEraseSubjectCommand("Alice"))
+	tf.RunTest("Re-run after failure - rest erased", r4b.IsOk() && r4b.Value().Total() == 2 && h4.names() == "")
+
+	h5 := newHistory("Alice")
+	h5.fail = true
+	r5 := NewEraseSubjectUseCase(h5).Execute(ctx, command.NewEraseSubjectCommand("Alice"))
+	tf.RunTest("Scan fails - InfrastructureError",
+		r5.IsError() && r5.ErrorInfo().Kind == domerr.InfrastructureError)
+
+	tf.Summary(t)
+}
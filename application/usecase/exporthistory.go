@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Export history use case (streaming CSV/JSON report)
+
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Export formats accepted by ExportHistoryCommand.
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatJSON = "json"
+)
+
+// ChecksumSuffix is appended to an export's key to name its checksum sidecar.
+const ChecksumSuffix = ".sha256"
+
+// exportProgressEvery is how many records pass between progress callbacks.
+const exportProgressEvery = 100
+
+// ExportHistoryUseCase streams greeting history into a CSV or JSON report.
+//
+// Streaming: records flow HistoryReaderPort.Scan -> encoder -> destination
+// one at a time; neither the history nor the report is held in memory.
+// Blob exports connect the encoder to BlobPort.Put through an io.Pipe.
+//
+// Formats:
+//   - csv:  header row, then name,text,timestamp,correlation_id,locale
+//   - json: a JSON array of GreetingRecord objects, one per line
+//
+// Progress: the callback set with OnProgress receives the running record
+// count every 100 records and once at the end.
+//
+// Integrity: the SHA-256 of the report is computed while streaming and
+// returned in the summary. Blob exports also store it in a sidecar object
+// "<key>.sha256" in sha256sum format ("<hex>  <name>"), which
+// VerifyExportUseCase (or `sha256sum -c`) checks.
+//
+// Cancellation: ctx is checked between records; a cancelled blob export
+// fails its Put, so no partial object is stored.
+//
+// Implements: inbound.ExportHistoryPort
+type ExportHistoryUseCase[H outbound.HistoryReaderPort, B outbound.BlobPort] struct {
+	history  H
+	blobs    B
+	progress func(records int)
+}
+
+// NewExportHistoryUseCase creates the use case with injected ports.
+func NewExportHistoryUseCase[H outbound.HistoryReaderPort, B outbound.BlobPort](history H, blobs B) *ExportHistoryUseCase[H, B] {
+	return &ExportHistoryUseCase[H, B]{history: history, blobs: blobs}
+}
+
+// OnProgress sets the progress callback and returns uc for chaining.
+// The callback runs on the exporting goroutine; keep it fast.
+func (uc *ExportHistoryUseCase[H, B]) OnProgress(fn func(records int)) *ExportHistoryUseCase[H, B] {
+	uc.progress = fn
+	return uc
+}
+
+// Execute exports the whole history to the blob cmd.Key.
+func (uc *ExportHistoryUseCase[H, B]) Execute(ctx context.Context, cmd command.ExportHistoryCommand) domerr.Result[model.ExportSummary] {
+	if r := validateExportFormat(cmd.GetFormat()); r.IsError() {
+		return r
+	}
+
+	pr, pw := io.Pipe()
+	encoded := make(chan domerr.Result[model.ExportSummary], 1)
+	go func() {
+		r := uc.ExportTo(ctx, pw, cmd.GetFormat())
+		if r.IsError() {
+			pw.CloseWithError(errors.New(r.ErrorInfo().Message))
+		} else {
+			pw.Close()
+		}
+		encoded <- r
+	}()
+
+	put := uc.blobs.Put(ctx, cmd.GetKey(), pr)
+	pr.Close() // unblocks the encoder if Put stopped reading early
+	summary := <-encoded
+
+	// Put's error comes first: it already carries an encoder failure (read
+	// from the pipe), while an encoder error may only be the closed pipe.
+	if put.IsError() {
+		return domerr.Err[model.ExportSummary](put.ErrorInfo())
+	}
+	if summary.IsError() {
+		return summary
+	}
+
+	sidecar := strings.NewReader(formatChecksum(summary.Value().SHA256, path.Base(cmd.GetKey())))
+	if stored := uc.blobs.Put(ctx, cmd.GetKey()+ChecksumSuffix, sidecar); stored.IsError() {
+		// An export without its checksum is not a complete export.
+		uc.blobs.Delete(context.WithoutCancel(ctx), cmd.GetKey())
+		return domerr.Err[model.ExportSummary](stored.ErrorInfo())
+	}
+	return summary.Map(func(s model.ExportSummary) model.ExportSummary {
+		s.Destination = "blob:" + cmd.GetKey()
+		return s
+	})
+}
+
+// ExportTo streams the whole history to w in format.
+//
+// Contract:
+//   - Returns Ok(ExportSummary) with Destination ""
+//   - Returns Err(ValidationError) for an unknown format
+//   - Returns Err(InfrastructureError) on read/write failure or cancellation;
+//     w may then hold a partial report
+func (uc *ExportHistoryUseCase[H, B]) ExportTo(ctx context.Context, w io.Writer, format string) domerr.Result[model.ExportSummary] {
+	if r := validateExportFormat(format); r.IsError() {
+		return r
+	}
+
+	digest := sha256.New()
+	counter := &countingWriter{w: io.MultiWriter(w, digest)}
+	enc := newExportEncoder(format, counter)
+	records := 0
+	var failure error
+
+	if err := enc.begin(); err != nil {
+		return exportFailed(err)
+	}
+	scanned := uc.history.Scan(ctx, func(record model.GreetingRecord) bool {
+		if err := ctx.Err(); err != nil {
+			failure = fmt.Errorf("cancelled: %w", context.Cause(ctx))
+			return false
+		}
+		if err := enc.encode(record); err != nil {
+			failure = err
+			return false
+		}
+		records++
+		if uc.progress != nil && records%exportProgressEvery == 0 {
+			uc.progress(records)
+		}
+		return true
+	})
+	if scanned.IsError() {
+		return domerr.Err[model.ExportSummary](scanned.ErrorInfo())
+	}
+	if failure == nil {
+		failure = enc.end()
+	}
+	if failure != nil {
+		return exportFailed(failure)
+	}
+	if uc.progress != nil {
+		uc.progress(records)
+	}
+	return domerr.Ok(model.ExportSummary{
+		Format: format, Records: records, Bytes: counter.n, SHA256: hex.EncodeToString(digest.Sum(nil)),
+	})
+}
+
+func validateExportFormat(format string) domerr.Result[model.ExportSummary] {
+	if format != ExportFormatCSV && format != ExportFormatJSON {
+		return domerr.Err[model.ExportSummary](domerr.NewValidationError(
+			fmt.Sprintf("export format %q is not supported (want csv or json)", format)))
+	}
+	return domerr.Ok(model.ExportSummary{})
+}
+
+func exportFailed(err error) domerr.Result[model.ExportSummary] {
+	return domerr.Err[model.ExportSummary](domerr.NewInfrastructureError(
+		fmt.Sprintf("export failed: %v", err)))
+}
+
+// ============================================================================
+// Encoders
+// ============================================================================
+
+// exportEncoder writes one report: begin, encode per record, end.
+type exportEncoder interface {
+	begin() error
+	encode(record model.GreetingRecord) error
+	end() error
+}
+
+func newExportEncoder(format string, w io.Writer) exportEncoder {
+	if format == ExportFormatCSV {
+		return &csvExportEncoder{w: csv.NewWriter(w)}
+	}
+	return &jsonExportEncoder{w: w}
+}
+
+type csvExportEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvExportEncoder) begin() error {
+	return e.w.Write([]string{"name", "text", "timestamp", "correlation_id", "locale"})
+}
+
+func (e *csvExportEncoder) encode(r model.GreetingRecord) error {
+	return e.w.Write([]string{r.Name, r.Text, r.Timestamp.Format(time.RFC3339Nano), r.CorrelationID, r.Locale})
+}
+
+func (e *csvExportEncoder) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonExportEncoder struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonExportEncoder) begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonExportEncoder) encode(r model.GreetingRecord) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if e.count == 0 {
+		sep = "\n"
+	}
+	e.count++
+	_, err = io.WriteString(e.w, sep+string(b))
+	return err
+}
+
+func (e *jsonExportEncoder) end() error {
+	_, err := io.WriteString(e.w, "\n]\n")
+	return err
+}
+
+// countingWriter counts bytes passed through to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
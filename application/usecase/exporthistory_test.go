@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// sliceHistory is a HistoryReaderPort over a fixed slice; onVisit runs
+// before each record is handed out.
+type sliceHistory struct {
+	records []model.GreetingRecord
+	onVisit func(i int)
+	fail    bool
+}
+
+func (h *sliceHistory) Scan(_ context.Context, visit func(model.GreetingRecord) bool) domerr.Result[model.Unit] {
+	if h.fail {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("history unavailable"))
+	}
+	for i, r := range h.records {
+		if h.onVisit != nil {
+			h.onVisit(i)
+		}
+		if !visit(r) {
+			break
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// memoryBlobs is a BlobPort that stores whole objects only if the body
+// reads cleanly, like a real store.
+type memoryBlobs struct {
+	objects    map[string]string
+	failPut    bool
+	failPutKey string
+}
+
+func (b *memoryBlobs) Put(ctx context.Context, key string, body io.Reader) domerr.Result[model.Unit] {
+	if b.failPut || key == b.failPutKey {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("bucket full"))
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(fmt.Sprintf("blob put failed: %v", err)))
+	}
+	b.objects[key] = string(data)
+	return domerr.Ok(model.UnitValue)
+}
+
+func (b *memoryBlobs) Get(_ context.Context, key string) domerr.Result[io.ReadCloser] {
+	data, ok := b.objects[key]
+	if !ok {
+		return domerr.Err[io.ReadCloser](domerr.NewInfrastructureError(fmt.Sprintf("blob %q not found", key)))
+	}
+	return domerr.Ok(io.NopCloser(strings.NewReader(data)))
+}
+
+func (b *memoryBlobs) List(context.Context, string) domerr.Result[[]model.BlobInfo] {
+	return domerr.Ok([]model.BlobInfo{})
+}
+
+func (b *memoryBlobs) Delete(_ context.Context, key string) domerr.Result[model.Unit] {
+	delete(b.objects, key)
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationUsecaseExportHistory tests streaming CSV/JSON exports.
+func TestApplicationUsecaseExportHistory(t *testing.T) {
+	tf := test.New("Application.Usecase.ExportHistory")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	records := func(n int) []model.GreetingRecord {
+		out := make([]model.GreetingRecord, n)
+		for i := range out {
+			out[i] = model.GreetingRecord{Name: fmt.Sprintf("P%d", i), Text: fmt.Sprintf("Hello, P%d!", i),
+				Timestamp: at, CorrelationID: fmt.Sprintf("id-%d", i), Locale: "en"}
+		}
+		return out
+	}
+	newUC := func(h *sliceHistory) (*ExportHistoryUseCase[*sliceHistory, *memoryBlobs], *memoryBlobs) {
+		blobs := &memoryBlobs{objects: map[string]string{}}
+		return NewExportHistoryUseCase(h, blobs), blobs
+	}
+
+	// ========================================================================
+	// Test: Encoders
+	// ========================================================================
+
+	uc, _ := newUC(&sliceHistory{records: []model.GreetingRecord{
+		{Name: "Alice", Text: "Hello, Alice!", Timestamp: at, CorrelationID: "id-1", Locale: "en"},
+		{Name: `Bob "B", Jr`, Text: "Hello!", Timestamp: at, CorrelationID: "id-2", Locale: "en-US"},
+	}})
+	var csvOut bytes.Buffer
+	r1 := uc.ExportTo(ctx, &csvOut, ExportFormatCSV)
+	tf.RunTest("CSV - content", csvOut.String() == "name,text,timestamp,correlation_id,locale\n"+
+		"Alice,\"Hello, Alice!\",2025-06-01T12:00:00Z,id-1,en\n"+
+		"\"Bob \"\"B\"\", Jr\",Hello!,2025-06-01T12:00:00Z,id-2,en-US\n")
+	tf.RunTest("CSV - summary", r1.IsOk() && r1.Value().Records == 2 &&
+		r1.Value().Bytes == int64(csvOut.Len()) && r1.Value().Destination == "")
+
+	var jsonOut bytes.Buffer
+	uc.ExportTo(ctx, &jsonOut, ExportFormatJSON)
+	var decoded []model.GreetingRecord
+	tf.RunTest("JSON - valid array", json.Unmarshal(jsonOut.Bytes(), &decoded) == nil &&
+		len(decoded) == 2 && decoded[1].Name == `Bob "B", Jr`)
+
+	var emptyOut bytes.Buffer
+	empty, _ := newUC(&sliceHistory{})
+	empty.ExportTo(ctx, &emptyOut, ExportFormatJSON)
+	tf.RunTest("JSON - empty history is []", json.Unmarshal(emptyOut.Bytes(), &decoded) == nil && len(decoded) == 0)
+
+	r2 := uc.ExportTo(ctx, &bytes.Buffer{}, "xml")
+	tf.RunTest("Unknown format - ValidationError", r2.IsError() && r2.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: Blob export and progress
+	// ========================================================================
+
+	var progress []int
+	big, blobs := newUC(&sliceHistory{records: records(250)})
+	big.OnProgress(func(n int) { progress = append(progress, n) })
+	r3 := big.Execute(ctx, command.NewExportHistoryCommand(ExportFormatCSV, "reports/h.csv"))
+	tf.RunTest("Blob - IsOk", r3.IsOk() && r3.Value().Records == 250 && r3.Value().Destination == "blob:reports/h.csv")
+	tf.RunTest("Blob - stored", strings.Count(blobs.objects["reports/h.csv"], "\n") == 251)
+	tf.RunTest("Progress - every 100 and at end", fmt.Sprint(progress) == "[100 200 250]")
+
+	sum := sha256.Sum256([]byte(blobs.objects["reports/h.csv"]))
+	tf.RunTest("Blob - checksum in summary", r3.IsOk() && r3.Value().SHA256 == hex.EncodeToString(sum[:]))
+	tf.RunTest("Blob - sha256sum sidecar", blobs.objects["reports/h.csv.sha256"] == hex.EncodeToString(sum[:])+"  h.csv\n")
+
+	// ========================================================================
+	// Test: Failures leave no export
+	// ========================================================================
+
+	cctx, cancel := context.WithCancel(ctx)
+	h4 := &sliceHistory{records: records(10), onVisit: func(i int) {
+		if i == 5 {
+			cancel()
+		}
+	}}
+	uc4, blobs4 := newUC(h4)
+	r4 := uc4.Execute(cctx, command.NewExportHistoryCommand(ExportFormatJSON, "reports/h.json"))
+	tf.RunTest("Cancelled mid-scan - IsError", r4.IsError() && strings.Contains(r4.ErrorInfo().Message, "cancelled"))
+	tf.RunTest("Cancelled mid-scan - nothing stored", len(blobs4.objects) == 0)
+
+	uc5, blobs5 := newUC(&sliceHistory{records: records(3)})
+	blobs5.failPut = true
+	r5 := uc5.Execute(ctx, command.NewExportHistoryCommand(ExportFormatCSV, "k"))
+	tf.RunTest("Put failure - blob error returned", r5.IsError() && r5.ErrorInfo().Message == "bucket full")
+
+	uc5b, blobs5b := newUC(&sliceHistory{records: records(3)})
+	blobs5b.failPutKey = "k.sha256"
+	r5b := uc5b.Execute(ctx, command.NewExportHistoryCommand(ExportFormatCSV, "k"))
+	tf.RunTest("Sidecar failure - IsError", r5b.IsError())
+	tf.RunTest("Sidecar failure - export removed", len(blobs5b.objects) == 0)
+
+	uc6, _ := newUC(&sliceHistory{fail: true})
+	r6 := uc6.Execute(ctx, command.NewExportHistoryCommand(ExportFormatCSV, "k"))
+	tf.RunTest("History failure - IsError", r6.IsError() &&
+		strings.Contains(r6.ErrorInfo().Message, "history unavailable"))
+
+	tf.Summary(t)
+}
@@ -45,6 +45,8 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/abitofhelp/hybrid_lib_go/application/command"
 	"github.com/abitofhelp/hybrid_lib_go/application/model"
@@ -78,6 +80,7 @@ import (
 // Implements: inbound.GreetPort interface
 type GreetUseCase[W outbound.WriterPort] struct {
 	writer W
+	clock  outbound.ClockPort
 }
 
 // NewGreetUseCase creates a new GreetUseCase with injected dependencies.
@@ -89,13 +92,28 @@ type GreetUseCase[W outbound.WriterPort] struct {
 //   - But compiler knows the concrete type for static dispatch
 //   - Composition root wires them together: NewGreetUseCase[*ConsoleWriter](writer)
 //
+// Synthesized receipts are stamped with the system time; use
+// NewGreetUseCaseWithClock to inject a clock.
+//
 // Mapping to Ada:
 //   - Ada: package Greet_UC is new Application.Usecase.Greet(Writer => Console_Writer.Write);
 //   - Go: uc := NewGreetUseCase[*adapter.ConsoleWriter](consoleWriter)
 func NewGreetUseCase[W outbound.WriterPort](writer W) *GreetUseCase[W] {
-	return &GreetUseCase[W]{writer: writer}
+	return &GreetUseCase[W]{writer: writer, clock: systemClock{}}
+}
+
+// NewGreetUseCaseWithClock is NewGreetUseCase with clock stamping
+// synthesized receipts (writers without their own), so they can be frozen
+// in tests. A nil clock makes every Execute return NilClockError.
+func NewGreetUseCaseWithClock[W outbound.WriterPort](writer W, clock outbound.ClockPort) *GreetUseCase[W] {
+	return &GreetUseCase[W]{writer: writer, clock: clock}
 }
 
+// systemClock is the ClockPort NewGreetUseCase uses: the wall clock.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
 // Execute runs the greeting use case.
 //
 // Orchestration workflow:
@@ -124,7 +142,12 @@ func NewGreetUseCase[W outbound.WriterPort](writer W) *GreetUseCase[W] {
 //   - Post: Returns Ok(Unit) if greeting succeeded
 //   - Post: Returns Err(ValidationError) if name validation failed
 //   - Post: Returns Err(InfrastructureError) if write failed or ctx cancelled
+//     (or the use case was constructed with a nil clock)
 func (uc *GreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	if uc.clock == nil {
+		return domerr.Err[model.Unit](outbound.NilClockError("greet use case"))
+	}
+
 	// Step 1: Extract name from DTO
 	name := cmd.GetName()
 
@@ -152,3 +175,54 @@ func (uc *GreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand
 	// Step 5: Propagate result (success or failure) to caller
 	return writeResult
 }
+
+// ExecuteWithReceipt runs the greeting use case and returns delivery evidence.
+//
+// If W implements outbound.ReceiptWriterPort its receipt is returned as-is.
+// Otherwise the write goes through Write and a receipt is synthesized from
+// the message length, an empty Destination and the clock (see
+// outbound.WriteWithReceipt).
+//
+// Contract: same error scenarios as Execute.
+func (uc *GreetUseCase[W]) ExecuteWithReceipt(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.WriteReceipt] {
+	if uc.clock == nil {
+		return domerr.Err[model.WriteReceipt](outbound.NilClockError("greet use case"))
+	}
+	personResult := valueobject.CreatePerson(cmd.GetName())
+	if personResult.IsError() {
+		return domerr.Err[model.WriteReceipt](personResult.ErrorInfo())
+	}
+	message := personResult.Value().GreetingMessage()
+
+	return outbound.WriteWithReceipt(ctx, uc.writer, message, uc.clock)
+}
+
+// ExecuteAll greets every command's name in one batch.
+//
+// All names are validated before anything is written. If W implements
+// outbound.BatchWriterPort the greetings go out in a single WriteAll;
+// otherwise they are written one by one and a receipt is synthesized (see
+// outbound.WriteAll). Decorators hide WriteAll unless they forward it.
+//
+// Contract:
+//   - Returns Ok(BatchReceipt) once every greeting was written
+//   - Returns Err(ValidationError) naming the first invalid command; nothing
+//     is written
+//   - Returns Err(InfrastructureError) if a write fails; earlier greetings
+//     may have been written
+func (uc *GreetUseCase[W]) ExecuteAll(ctx context.Context, cmds []command.GreetCommand) domerr.Result[model.BatchReceipt] {
+	if uc.clock == nil {
+		return domerr.Err[model.BatchReceipt](outbound.NilClockError("greet use case"))
+	}
+	messages := make([]string, 0, len(cmds))
+	for i, cmd := range cmds {
+		personResult := valueobject.CreatePerson(cmd.GetName())
+		if personResult.IsError() {
+			return domerr.Err[model.BatchReceipt](domerr.NewValidationError(
+				fmt.Sprintf("command %d: %s", i, personResult.ErrorInfo().Message)))
+		}
+		messages = append(messages, personResult.Value().GreetingMessage())
+	}
+
+	return outbound.WriteAll(ctx, uc.writer, messages, uc.clock)
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// receiptWriter implements outbound.ReceiptWriterPort with a fixed receipt.
+type receiptWriter struct {
+	fakeWriter
+	receipt model.WriteReceipt
+}
+
+func (w *receiptWriter) WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt] {
+	return domerr.MapTo(w.Write(ctx, message), func(model.Unit) model.WriteReceipt { return w.receipt })
+}
+
+// batchWriter implements outbound.BatchWriterPort, keeping each batch.
+type batchWriter struct {
+	fakeWriter
+	batches [][]string
+}
+
+func (w *batchWriter) WriteAll(_ context.Context, messages []string) domerr.Result[model.BatchReceipt] {
+	w.batches = append(w.batches, messages)
+	return domerr.Ok(model.BatchReceipt{Messages: len(messages)})
+}
+
+// fixedClock implements outbound.ClockPort with a constant time.
+type fixedClock struct{ at time.Time }
+
+func (c fixedClock) Now() time.Time { return c.at }
+
+// TestApplicationUsecaseGreetReceipt tests ExecuteWithReceipt and ExecuteAll.
+func TestApplicationUsecaseGreetReceipt(t *testing.T) {
+	tf := test.New("Application.Usecase.GreetReceipt")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := fixedClock{at}
+
+	rw := &receiptWriter{fakeWriter: fakeWriter{log: &stepLog{}},
+		receipt: model.WriteReceipt{Bytes: 14, Destination: "stdout", WrittenAt: at}}
+	r1 := NewGreetUseCaseWithClock(rw, clock).ExecuteWithReceipt(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Receipt writer - adapter receipt returned", r1.IsOk() && r1.Value() == rw.receipt)
+
+	plain := &fakeWriter{log: &stepLog{}}
+	r2 := NewGreetUseCaseWithClock(plain, clock).ExecuteWithReceipt(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Plain writer - synthesized receipt", r2.IsOk() &&
+		r2.Value().Bytes == len("Hello, Alice!") && r2.Value().Destination == "" && !r2.Value().WrittenAt.IsZero())
+	tf.RunTest("Plain writer - written once", plain.log.String() == "write")
+
+	clocked := NewGreetUseCaseWithClock(&fakeWriter{log: &stepLog{}}, clock)
+	r2c := clocked.ExecuteWithReceipt(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Clock - receipt time from clock", r2c.IsOk() && r2c.Value().WrittenAt.Equal(at))
+	r2b := clocked.ExecuteAll(ctx, []command.GreetCommand{command.NewGreetCommand("Bob")})
+	tf.RunTest("Clock - batch receipt time from clock", r2b.IsOk() && r2b.Value().WrittenAt.Equal(at))
+
+	r3 := NewGreetUseCaseWithClock(rw, clock).ExecuteWithReceipt(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Invalid name - ValidationError", r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+
+	failing := &fakeWriter{log: &stepLog{}, fail: true}
+	r4 := NewGreetUseCaseWithClock(failing, clock).ExecuteWithReceipt(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Write failure - InfrastructureError", r4.IsError() && r4.ErrorInfo().Kind == domerr.InfrastructureError)
+
+	// ========================================================================
+	// Test: ExecuteAll uses batch writers and falls back to Write
+	// ========================================================================
+
+	names := []command.GreetCommand{command.NewGreetCommand("Alice"), command.NewGreetCommand("Bob")}
+	bw := &batchWriter{}
+	r5 := NewGreetUseCaseWithClock(bw, clock).ExecuteAll(ctx, names)
+	tf.RunTest("Batch writer - one WriteAll", r5.IsOk() && len(bw.batches) == 1 && len(bw.batches[0]) == 2)
+	tf.RunTest("Batch writer - greetings in order", bw.batches[0][0] == "Hello, Alice!" && bw.batches[0][1] == "Hello, Bob!")
+
+	plain2 := &fakeWriter{log: &stepLog{}}
+	r6 := NewGreetUseCaseWithClock(plain2, clock).ExecuteAll(ctx, names)
+	tf.RunTest("Plain writer - one Write each", r6.IsOk() && plain2.log.String() == "write,write")
+	tf.RunTest("Plain writer - synthesized batch receipt", r6.IsOk() && r6.Value().Messages == 2 &&
+		r6.Value().Bytes == len("Hello, Alice!")+len("Hello, Bob!"))
+
+	bw2 := &batchWriter{}
+	r7 := NewGreetUseCaseWithClock(bw2, clock).ExecuteAll(ctx, append(names, command.NewGreetCommand("")))
+	tf.RunTest("Invalid name - ValidationError names command", r7.IsError() &&
+		r7.ErrorInfo().Kind == domerr.ValidationError && strings.HasPrefix(r7.ErrorInfo().Message, "command 2: "))
+	tf.RunTest("Invalid name - nothing written", len(bw2.batches) == 0)
+
+	r8 := NewGreetUseCaseWithClock(failing, clock).ExecuteAll(ctx, names)
+	tf.RunTest("Fallback write failure - stops", r8.IsError() && failing.log.String() == "write,write")
+
+	// ========================================================================
+	// Test: Default system clock and nil clock
+	// ========================================================================
+
+	r9 := NewGreetUseCase(&fakeWriter{log: &stepLog{}}).ExecuteWithReceipt(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Default clock - receipt stamped", r9.IsOk() && !r9.Value().WrittenAt.IsZero())
+
+	unclocked := &fakeWriter{log: &stepLog{}}
+	nilClock := NewGreetUseCaseWithClock(unclocked, nil)
+	r10 := nilClock.ExecuteWithReceipt(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Nil clock - InfrastructureError", r10.IsError() &&
+		r10.ErrorInfo().Message == "greet use case misconfigured: clock is nil")
+	tf.RunTest("Nil clock - ExecuteAll fails", nilClock.ExecuteAll(ctx, names).IsError())
+	tf.RunTest("Nil clock - Execute fails", nilClock.Execute(ctx, command.NewGreetCommand("Alice")).IsError())
+	tf.RunTest("Nil clock - nothing written", unclocked.log.String() == "")
+
+	tf.Summary(t)
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Greet-and-record use case (multi-port transaction script)
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// GreetAndRecordUseCase greets a person, persists a history record and
+// publishes a "greeted" event as one unit of work.
+//
+// It is the multi-port reference for this template: every step is an
+// outbound port, and the orchestration (ordering, compensation) stays here
+// in the application layer rather than leaking into adapters.
+//
+// Unit of Work:
+//  1. Validate the name (domain) and build the GreetingRecord
+//  2. history.Append       - reversible (compensated by history.Remove)
+//  3. writer.Write         - irreversible: the commit point
+//  4. events.Publish       - after the commit point, never compensated
+//
+// Partial Failure:
+//   - Append fails: nothing happened, the error is returned
+//   - Write fails: the record is removed, the write error is returned; if
+//     the removal also fails, both are reported so an operator can clean up
+//   - Publish fails: the greeting was delivered and stays recorded; an
+//     InfrastructureError says so, and the history record is the source for
+//     republishing. Do not wrap this use case in Retry - a retry would greet
+//     twice.
+//
+// Static Dispatch: generic over every port, like GreetUseCase.
+//
+// Implements: a GreetPort-shaped handler returning the stored record
+type GreetAndRecordUseCase[
+	W outbound.WriterPort,
+	H outbound.HistoryPort,
+	E outbound.EventPublisherPort,
+	I outbound.IDGeneratorPort,
+] struct {
+	writer  W
+	history H
+	events  E
+	ids     I
+	clock   outbound.ClockPort
+}
+
+// NewGreetAndRecordUseCase creates the use case with injected ports; clock
+// stamps each record. A nil clock makes Execute return NilClockError.
+//
+// Usage:
+//
+//	uc := usecase.NewGreetAndRecordUseCase(writer, history, events, ids, clock)
+//	result := uc.Execute(ctx, command.NewGreetCommand("Alice")) // Result[GreetingRecord]
+func NewGreetAndRecordUseCase[
+	W outbound.WriterPort,
+	H outbound.HistoryPort,
+	E outbound.EventPublisherPort,
+	I outbound.IDGeneratorPort,
+](writer W, history H, events E, ids I, clock outbound.ClockPort) *GreetAndRecordUseCase[W, H, E, I] {
+	return &GreetAndRecordUseCase[W, H, E, I]{
+		writer: writer, history: history, events: events, ids: ids, clock: clock,
+	}
+}
+
+// Execute runs the unit of work described on GreetAndRecordUseCase.
+//
+// Contract:
+//   - Returns Ok(record) once the greeting is written, recorded and published
+//   - Returns Err(ValidationError) for an invalid name; no port is called
+//     except the ID generator
+//   - Returns Err(InfrastructureError) for any port failure, after compensation
+func (uc *GreetAndRecordUseCase[W, H, E, I]) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.GreetingRecord] {
+	if uc.clock == nil {
+		return domerr.Err[model.GreetingRecord](outbound.NilClockError("greet-and-record use case"))
+	}
+	personResult := valueobject.CreatePerson(cmd.GetName())
+	if personResult.IsError() {
+		return domerr.Err[model.GreetingRecord](personResult.ErrorInfo())
+	}
+	person := personResult.Value()
+
+	idResult := uc.ids.NewID()
+	if idResult.IsError() {
+		return domerr.Err[model.GreetingRecord](idResult.ErrorInfo())
+	}
+
+	recordResult := model.NewGreetingRecordBuilder().
+		Name(person.GetName()).
+		Text(person.GreetingMessage()).
+		Timestamp(uc.clock.Now()).
+		CorrelationID(idResult.Value()).
+		Build()
+	if recordResult.IsError() {
+		return recordResult
+	}
+	record := recordResult.Value()
+
+	uow := unitOfWork{}
+
+	if appended := uc.history.Append(ctx, record); appended.IsError() {
+		return domerr.Err[model.GreetingRecord](appended.ErrorInfo())
+	}
+	uow.onRollback(func() domerr.Result[model.Unit] {
+		// Compensation runs even if ctx was the reason the write failed.
+		return uc.history.Remove(context.WithoutCancel(ctx), record.CorrelationID)
+	})
+
+	if written := uc.writer.Write(ctx, record.Text); written.IsError() {
+		return domerr.Err[model.GreetingRecord](uow.rollback(written.ErrorInfo()))
+	}
+
+	if published := uc.events.Publish(ctx, record); published.IsError() {
+		return domerr.Err[model.GreetingRecord](domerr.NewInfrastructureError(fmt.Sprintf(
+			"greeting %s delivered and recorded but not published: %s",
+			record.CorrelationID, published.ErrorInfo().Message)))
+	}
+	return domerr.Ok(record)
+}
+
+// unitOfWork collects compensating actions for the reversible steps taken
+// so far and runs them newest-first on rollback.
+type unitOfWork struct {
+	compensations []func() domerr.Result[model.Unit]
+}
+
+// onRollback registers undo for the step that just succeeded.
+func (u *unitOfWork) onRollback(undo func() domerr.Result[model.Unit]) {
+	u.compensations = append(u.compensations, undo)
+}
+
+// rollback runs every compensation and returns cause, annotated with any
+// compensation failures.
+func (u *unitOfWork) rollback(cause domerr.ErrorType) domerr.ErrorType {
+	var failed []string
+	for i := len(u.compensations) - 1; i >= 0; i-- {
+		if r := u.compensations[i](); r.IsError() {
+			failed = append(failed, r.ErrorInfo().Message)
+		}
+	}
+	if len(failed) == 0 {
+		return cause
+	}
+	return domerr.NewInfrastructureError(fmt.Sprintf(
+		"%s (compensation failed: %v)", cause.Message, failed))
+}
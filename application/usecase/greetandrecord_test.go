@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// stepLog records the order in which fake ports are called.
+type stepLog struct{ steps []string }
+
+func (l *stepLog) add(step string) { l.steps = append(l.steps, step) }
+
+func (l *stepLog) String() string { return strings.Join(l.steps, ",") }
+
+// fakeWriter, fakeHistory and fakeEvents fail when their fail flag is set.
+type fakeWriter struct {
+	log  *stepLog
+	fail bool
+}
+
+func (w *fakeWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	w.log.add("write")
+	if w.fail {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("write failed"))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+type fakeHistory struct {
+	log        *stepLog
+	records    map[string]model.GreetingRecord
+	failAppend bool
+	failRemove bool
+}
+
+func (h *fakeHistory) Append(_ context.Context, r model.GreetingRecord) domerr.Result[model.Unit] {
+	h.log.add("append")
+	if h.failAppend {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("append failed"))
+	}
+	h.records[r.CorrelationID] = r
+	return domerr.Ok(model.UnitValue)
+}
+
+func (h *fakeHistory) Remove(_ context.Context, id string) domerr.Result[model.Unit] {
+	h.log.add("remove")
+	if h.failRemove {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("remove failed"))
+	}
+	delete(h.records, id)
+	return domerr.Ok(model.UnitValue)
+}
+
+type fakeEvents struct {
+	log  *stepLog
+	fail bool
+}
+
+func (e *fakeEvents) Publish(_ context.Context, _ model.GreetingRecord) domerr.Result[model.Unit] {
+	e.log.add("publish")
+	if e.fail {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("broker down"))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+type fixedID string
+
+func (f fixedID) NewID() domerr.Result[string] { return domerr.Ok(string(f)) }
+
+// TestApplicationUsecaseGreetAndRecord tests the multi-port unit of work.
+func TestApplicationUsecaseGreetAndRecord(t *testing.T) {
+	tf := test.New("Application.Usecase.GreetAndRecord")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	setup := func() (*stepLog, *fakeWriter, *fakeHistory, *fakeEvents,
+		*GreetAndRecordUseCase[*fakeWriter, *fakeHistory, *fakeEvents, fixedID]) {
+		log := &stepLog{}
+		w := &fakeWriter{log: log}
+		h := &fakeHistory{log: log, records: map[string]model.GreetingRecord{}}
+		e := &fakeEvents{log: log}
+		uc := NewGreetAndRecordUseCase(w, h, e, fixedID("req-1"), fixedClock{at})
+		return log, w, h, e, uc
+	}
+
+	// ========================================================================
+	// Test: Happy path
+	// ========================================================================
+
+	log1, _, h1, _, uc1 := setup()
+	r1 := uc1.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Success - IsOk", r1.IsOk())
+	tf.RunTest("Success - record fields", r1.IsOk() && r1.Value().Text == "Hello, Alice!" &&
+		r1.Value().CorrelationID == "req-1" && r1.Value().Timestamp.Equal(at))
+	tf.RunTest("Success - step order", log1.String() == "append,write,publish")
+	tf.RunTest("Success - recorded", len(h1.records) == 1)
+
+	// ========================================================================
+	// Test: Validation short-circuits before any side effect
+	// ========================================================================
+
+	log2, _, _, _, uc2 := setup()
+	r2 := uc2.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Invalid name - ValidationError", r2.IsError() && r2.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Invalid name - no port called", log2.String() == "")
+
+	// ========================================================================
+	// Test: Partial failures and compensation
+	// ========================================================================
+
+	log3, _, h3, _, uc3 := setup()
+	h3.failAppend = true
+	r3 := uc3.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Append fails - IsError", r3.IsError())
+	tf.RunTest("Append fails - nothing written", log3.String() == "append")
+
+	log4, w4, h4, _, uc4 := setup()
+	w4.fail = true
+	r4 := uc4.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Write fails - write error returned", r4.IsError() && r4.ErrorInfo().Message == "write failed")
+	tf.RunTest("Write fails - record compensated", log4.String() == "append,write,remove" && len(h4.records) == 0)
+
+	_, w5, h5, _, uc5 := setup()
+	w5.fail, h5.failRemove = true, true
+	r5 := uc5.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Compensation fails - both reported", r5.IsError() &&
+		strings.Contains(r5.ErrorInfo().Message, "write failed") &&
+		strings.Contains(r5.ErrorInfo().Message, "remove failed"))
+
+	log6, _, h6, e6, uc6 := setup()
+	e6.fail = true
+	r6 := uc6.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Publish fails - IsError", r6.IsError() && r6.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("Publish fails - message names delivery", r6.IsError() &&
+		strings.Contains(r6.ErrorInfo().Message, "req-1 delivered and recorded but not published"))
+	tf.RunTest("Publish fails - record kept", log6.String() == "append,write,publish" && len(h6.records) == 1)
+
+	// ========================================================================
+	// Test: Nil clock is misconfiguration
+	// ========================================================================
+
+	log7 := &stepLog{}
+	uc7 := NewGreetAndRecordUseCase(&fakeWriter{log: log7}, &fakeHistory{log: log7, records: map[string]model.GreetingRecord{}},
+		&fakeEvents{log: log7}, fixedID("req-1"), nil)
+	r7 := uc7.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Nil clock - InfrastructureError", r7.IsError() &&
+		r7.ErrorInfo().Message == "greet-and-record use case misconfigured: clock is nil")
+	tf.RunTest("Nil clock - no port called", log7.String() == "")
+
+	tf.Summary(t)
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: History query use case (pages and lazy iteration)
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/query"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// HistoryQueryFields are the GreetingRecord fields history filters accept
+// (JSON names). "timestamp" values are RFC 3339.
+var HistoryQueryFields = []string{"name", "text", "timestamp", "correlation_id", "locale"}
+
+// QueryHistoryUseCase reads greeting history with filters, either as
+// pages or as a lazy iterator.
+//
+// Both read through HistoryReaderPort.Scan, so memory use is bounded by
+// the page size (Execute) or one record (Iterate), not the history size.
+//
+// Ordering: records come oldest first, as Scan delivers them; sorting is
+// not supported. Cursors count matching records, which is stable because
+// history is append-only (a compensating Remove can shift a page by one).
+//
+// Implements: inbound.QueryHistoryPort
+type QueryHistoryUseCase[H outbound.HistoryReaderPort] struct {
+	history H
+}
+
+// NewQueryHistoryUseCase creates the use case with an injected history reader.
+func NewQueryHistoryUseCase[H outbound.HistoryReaderPort](history H) *QueryHistoryUseCase[H] {
+	return &QueryHistoryUseCase[H]{history: history}
+}
+
+// Execute returns one page of records matching q.Filters.
+//
+// Contract:
+//   - Returns Ok(Page) with at most q.Page.Limit records; Next is set when
+//     more matching records follow
+//   - Returns Err(ValidationError) for an invalid query or if q.Sort is set
+//   - Returns Err(InfrastructureError) on read failure or cancellation
+func (uc *QueryHistoryUseCase[H]) Execute(ctx context.Context, q query.Query) domerr.Result[query.Page[model.GreetingRecord]] {
+	valid := q.Validate(HistoryQueryFields...)
+	if valid.IsError() {
+		return domerr.Err[query.Page[model.GreetingRecord]](valid.ErrorInfo())
+	}
+	q = valid.Value()
+	if len(q.Sort) > 0 {
+		return domerr.Err[query.Page[model.GreetingRecord]](domerr.NewValidationError(
+			"history is returned oldest first; sorting is not supported"))
+	}
+	offset := historyOffset(q.Page.After)
+	if offset.IsError() {
+		return domerr.Err[query.Page[model.GreetingRecord]](offset.ErrorInfo())
+	}
+	match := compileHistoryFilters(q.Filters)
+	if match.IsError() {
+		return domerr.Err[query.Page[model.GreetingRecord]](match.ErrorInfo())
+	}
+
+	skip, limit := offset.Value(), q.Page.Limit
+	items := make([]model.GreetingRecord, 0, limit)
+	more := false
+	scanned := uc.history.Scan(ctx, func(r model.GreetingRecord) bool {
+		if !match.Value()(r) {
+			return true
+		}
+		if skip > 0 {
+			skip--
+			return true
+		}
+		if len(items) == limit {
+			more = true
+			return false
+		}
+		items = append(items, r)
+		return true
+	})
+	if scanned.IsError() {
+		return domerr.Err[query.Page[model.GreetingRecord]](scanned.ErrorInfo())
+	}
+
+	var next query.Cursor
+	if more {
+		next = query.NewCursor(strconv.Itoa(offset.Value() + limit))
+	}
+	return domerr.Ok(query.NewPage(items, next))
+}
+
+// Iterate lazily yields every record matching filters, fetching as the
+// caller ranges; breaking out of the loop stops the underlying Scan.
+//
+// Errors are yielded once, with a zero record, and end the sequence; the
+// error is an ErrorType (recover it with domerr.FromError):
+//
+//	for rec, err := range uc.Iterate(ctx, query.Filter{Field: "locale", Op: query.OpEq, Value: "fr"}) {
+//	    if err != nil { return err }
+//	    ...
+//	}
+func (uc *QueryHistoryUseCase[H]) Iterate(ctx context.Context, filters ...query.Filter) iter.Seq2[model.GreetingRecord, error] {
+	return func(yield func(model.GreetingRecord, error) bool) {
+		for _, f := range filters {
+			if r := f.Validate(HistoryQueryFields...); r.IsError() {
+				yield(model.GreetingRecord{}, r.ErrorInfo())
+				return
+			}
+		}
+		match := compileHistoryFilters(filters)
+		if match.IsError() {
+			yield(model.GreetingRecord{}, match.ErrorInfo())
+			return
+		}
+
+		// A panic in the caller's loop body must reach the caller: it is
+		// caught here before the adapter's Scan can turn it into an Err,
+		// and re-raised once Scan has returned.
+		stopped, bodyPanicked := false, false
+		var bodyPanic any
+		scanned := uc.history.Scan(ctx, func(r model.GreetingRecord) bool {
+			if !match.Value()(r) {
+				return true
+			}
+			func() {
+				done := false
+				defer func() {
+					if !done {
+						bodyPanicked, bodyPanic = true, recover()
+					}
+				}()
+				stopped = !yield(r, nil)
+				done = true
+			}()
+			return !stopped && !bodyPanicked
+		})
+		if bodyPanicked {
+			panic(bodyPanic)
+		}
+		if scanned.IsError() && !stopped {
+			yield(model.GreetingRecord{}, scanned.ErrorInfo())
+		}
+	}
+}
+
+// historyOffset decodes a history cursor into the number of matching
+// records to skip.
+func historyOffset(c query.Cursor) domerr.Result[int] {
+	pos := c.Position()
+	if pos.IsError() || pos.Value() == "" {
+		return domerr.MapTo(pos, func(string) int { return 0 })
+	}
+	n, err := strconv.Atoi(pos.Value())
+	if err != nil || n < 0 {
+		return domerr.Err[int](domerr.NewValidationError("cursor is malformed"))
+	}
+	return domerr.Ok(n)
+}
+
+// compileHistoryFilters turns validated filters into one predicate that
+// requires every filter to match.
+func compileHistoryFilters(filters []query.Filter) domerr.Result[func(model.GreetingRecord) bool] {
+	preds := make([]func(model.GreetingRecord) bool, 0, len(filters))
+	for _, f := range filters {
+		p := compileHistoryFilter(f)
+		if p.IsError() {
+			return domerr.Err[func(model.GreetingRecord) bool](p.ErrorInfo())
+		}
+		preds = append(preds, p.Value())
+	}
+	return domerr.Ok(func(r model.GreetingRecord) bool {
+		for _, p := range preds {
+			if !p(r) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// compileHistoryFilter builds the predicate for one filter. Text fields
+// compare lexically; timestamp compares chronologically and only supports
+// the ordering operators.
+func compileHistoryFilter(f query.Filter) domerr.Result[func(model.GreetingRecord) bool] {
+	if f.Field == "timestamp" {
+		at, err := time.Parse(time.RFC3339, f.Value)
+		if err != nil {
+			return domerr.Err[func(model.GreetingRecord) bool](domerr.NewValidationError(
+				fmt.Sprintf("filter value %q for timestamp is not RFC 3339", f.Value)))
+		}
+		if f.Op == query.OpPrefix || f.Op == query.OpContains {
+			return domerr.Err[func(model.GreetingRecord) bool](domerr.NewValidationError(
+				fmt.Sprintf("filter operator %q is not supported for timestamp", f.Op)))
+		}
+		return domerr.Ok(func(r model.GreetingRecord) bool {
+			return compareOp(f.Op, r.Timestamp.Compare(at))
+		})
+	}
+
+	field := map[string]func(model.GreetingRecord) string{
+		"name":           func(r model.GreetingRecord) string { return r.Name },
+		"text":           func(r model.GreetingRecord) string { return r.Text },
+		"correlation_id": func(r model.GreetingRecord) string { return r.CorrelationID },
+		"locale":         func(r model.GreetingRecord) string { return r.Locale },
+	}[f.Field]
+	if field == nil {
+		return domerr.Err[func(model.GreetingRecord) bool](domerr.NewValidationError(
+			fmt.Sprintf("filter field %q is not supported", f.Field)))
+	}
+	switch f.Op {
+	case query.OpPrefix:
+		return domerr.Ok(func(r model.GreetingRecord) bool { return strings.HasPrefix(field(r), f.Value) })
+	case query.OpContains:
+		return domerr.Ok(func(r model.GreetingRecord) bool { return strings.Contains(field(r), f.Value) })
+	default:
+		return domerr.Ok(func(r model.GreetingRecord) bool {
+			return compareOp(f.Op, strings.Compare(field(r), f.Value))
+		})
+	}
+}
+
+// compareOp applies an ordering operator to a three-way comparison result.
+func compareOp(op query.Op, cmp int) bool {
+	switch op {
+	case query.OpEq:
+		return cmp == 0
+	case query.OpNe:
+		return cmp != 0
+	case query.OpLt:
+		return cmp < 0
+	case query.OpLte:
+		return cmp <= 0
+	case query.OpGt:
+		return cmp > 0
+	case query.OpGte:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
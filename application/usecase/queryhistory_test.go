@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/query"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// recoveringHistory turns panics during Scan into an Err, as the history
+// adapters do.
+type recoveringHistory struct{ *sliceHistory }
+
+func (h recoveringHistory) Scan(ctx context.Context, visit func(model.GreetingRecord) bool) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](domerr.NewInfrastructureError(fmt.Sprint("scan panicked: ", r)))
+		}
+	}()
+	return h.sliceHistory.Scan(ctx, visit)
+}
+
+// TestApplicationUsecaseQueryHistory tests paged and iterated history reads.
+func TestApplicationUsecaseQueryHistory(t *testing.T) {
+	tf := test.New("Application.Usecase.QueryHistory")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// Ten records, one minute apart; even ones in "fr".
+	var records []model.GreetingRecord
+	for i := 0; i < 10; i++ {
+		locale := "en"
+		if i%2 == 0 {
+			locale = "fr"
+		}
+		records = append(records, model.GreetingRecord{Name: fmt.Sprintf("P%d", i), Text: "Hi",
+			Timestamp: at.Add(time.Duration(i) * time.Minute), CorrelationID: fmt.Sprintf("id-%d", i), Locale: locale})
+	}
+	visited := 0
+	history := &sliceHistory{records: records, onVisit: func(int) { visited++ }}
+	uc := NewQueryHistoryUseCase(history)
+	names := func(rs []model.GreetingRecord) string {
+		out := ""
+		for _, r := range rs {
+			out += r.Name + " "
+		}
+		return out
+	}
+	fr := query.Filter{Field: "locale", Op: query.OpEq, Value: "fr"}
+
+	// ========================================================================
+	// Test: Pages
+	// ========================================================================
+
+	p1 := uc.Execute(ctx, query.Query{Page: query.PageRequest{Limit: 2}, Filters: []query.Filter{fr}})
+	tf.RunTest("Page 1 - first matches", p1.IsOk() && names(p1.Value().Items) == "P0 P2 " && p1.Value().HasMore())
+	p2 := uc.Execute(ctx, query.Query{Page: query.PageRequest{Limit: 2, After: p1.Value().Next}, Filters: []query.Filter{fr}})
+	tf.RunTest("Page 2 - continues", p2.IsOk() && names(p2.Value().Items) == "P4 P6 ")
+	p3 := uc.Execute(ctx, query.Query{Page: query.PageRequest{Limit: 2, After: p2.Value().Next}, Filters: []query.Filter{fr}})
+	tf.RunTest("Page 3 - last", p3.IsOk() && names(p3.Value().Items) == "P8 " && !p3.Value().HasMore())
+
+	since := query.Filter{Field: "timestamp", Op: query.OpGte, Value: "2025-06-01T12:07:00Z"}
+	p4 := uc.Execute(ctx, query.Query{Filters: []query.Filter{since}})
+	tf.RunTest("Timestamp filter - chronological", p4.IsOk() && names(p4.Value().Items) == "P7 P8 P9 ")
+
+	sorted := uc.Execute(ctx, query.Query{Sort: []query.Sort{{Field: "name"}}})
+	tf.RunTest("Sort - ValidationError", sorted.IsError() && sorted.ErrorInfo().Kind == domerr.ValidationError)
+	badCursor := uc.Execute(ctx, query.Query{Page: query.PageRequest{After: query.NewCursor("x")}})
+	tf.RunTest("Foreign cursor - ValidationError", badCursor.IsError() && badCursor.ErrorInfo().Kind == domerr.ValidationError)
+	badTime := uc.Execute(ctx, query.Query{Filters: []query.Filter{{Field: "timestamp", Op: query.OpEq, Value: "today"}}})
+	tf.RunTest("Bad timestamp - ValidationError", badTime.IsError() && badTime.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: Iterate
+	// ========================================================================
+
+	var got []model.GreetingRecord
+	for rec, err := range uc.Iterate(ctx, fr) {
+		if err != nil {
+			break
+		}
+		got = append(got, rec)
+	}
+	tf.RunTest("Iterate - all matches", names(got) == "P0 P2 P4 P6 P8 ")
+
+	visited, got = 0, nil
+	for rec := range uc.Iterate(ctx) {
+		got = append(got, rec)
+		if len(got) == 3 {
+			break
+		}
+	}
+	tf.RunTest("Iterate break - lazy, scan stopped", len(got) == 3 && visited == 3)
+
+	var errs []error
+	for _, err := range uc.Iterate(ctx, query.Filter{Field: "secret", Op: query.OpEq}) {
+		errs = append(errs, err)
+	}
+	tf.RunTest("Iterate invalid filter - one error", len(errs) == 1 &&
+		domerr.FromError(0, errs[0]).ErrorInfo().Kind == domerr.ValidationError)
+
+	failing := NewQueryHistoryUseCase(&sliceHistory{fail: true})
+	errs = nil
+	for _, err := range failing.Iterate(ctx) {
+		errs = append(errs, err)
+	}
+	tf.RunTest("Iterate scan failure - one error", len(errs) == 1 && errs[0] != nil)
+	tf.RunTest("Execute scan failure - IsError", failing.Execute(ctx, query.Query{}).IsError())
+
+	recovering := NewQueryHistoryUseCase(recoveringHistory{&sliceHistory{records: records}})
+	bodyRuns := 0
+	caught := func() (r any) {
+		defer func() { r = recover() }()
+		for range recovering.Iterate(ctx) {
+			bodyRuns++
+			panic("boom")
+		}
+		return nil
+	}()
+	tf.RunTest("Iterate body panic - reaches caller unchanged", caught == "boom" && bodyRuns == 1)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Greet use case with randomly chosen salutations
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// SalutationGreetUseCase greets like GreetUseCase but picks the salutation
+// ("Hello", "Hi", "Good morning", ...) for each greeting from a configured
+// set, using a RandomPort so tests can seed the choice.
+//
+// Static Dispatch: generic over both ports, like GreetUseCase.
+//
+// Implements: inbound.GreetPort
+type SalutationGreetUseCase[W outbound.WriterPort, R outbound.RandomPort] struct {
+	writer      W
+	random      R
+	salutations []string
+}
+
+// NewSalutationGreetUseCase creates the use case. With no salutations,
+// "Hello" is always used.
+//
+// Usage:
+//
+//	uc := usecase.NewSalutationGreetUseCase(writer, random, "Hello", "Hi", "Welcome")
+//	uc.Execute(ctx, command.NewGreetCommand("Alice")) // e.g. "Hi, Alice!"
+func NewSalutationGreetUseCase[W outbound.WriterPort, R outbound.RandomPort](
+	writer W, random R, salutations ...string,
+) *SalutationGreetUseCase[W, R] {
+	if len(salutations) == 0 {
+		salutations = []string{"Hello"}
+	}
+	return &SalutationGreetUseCase[W, R]{
+		writer: writer, random: random, salutations: append([]string(nil), salutations...),
+	}
+}
+
+// Execute validates the name, picks a salutation and writes the greeting.
+//
+// Contract: same as GreetUseCase.Execute.
+func (uc *SalutationGreetUseCase[W, R]) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	personResult := valueobject.CreatePerson(cmd.GetName())
+	if personResult.IsError() {
+		return domerr.Err[model.Unit](personResult.ErrorInfo())
+	}
+	salutation := uc.salutations[0]
+	if len(uc.salutations) > 1 {
+		salutation = uc.salutations[uc.random.IntN(len(uc.salutations))]
+	}
+	return uc.writer.Write(ctx, personResult.Value().GreetingWith(salutation))
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// messageWriter keeps every message written.
+type messageWriter struct{ messages []string }
+
+func (w *messageWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	w.messages = append(w.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// scriptedRandom returns its values in order and counts calls.
+type scriptedRandom struct {
+	values []int
+	calls  int
+}
+
+func (r *scriptedRandom) IntN(n int) int {
+	v := r.values[r.calls%len(r.values)] % n
+	r.calls++
+	return v
+}
+
+// TestApplicationUsecaseSalutationGreet tests salutation selection.
+func TestApplicationUsecaseSalutationGreet(t *testing.T) {
+	tf := test.New("Application.Usecase.SalutationGreet")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Salutation chosen through RandomPort
+	// ========================================================================
+
+	w := &messageWriter{}
+	random := &scriptedRandom{values: []int{2, 0, 1}}
+	uc := NewSalutationGreetUseCase(w, random, "Hello", "Hi", "Welcome")
+	for i := 0; i < 3; i++ {
+		uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	}
+	tf.RunTest("Execute - scripted choices", len(w.messages) == 3 &&
+		w.messages[0] == "Welcome, Alice!" && w.messages[1] == "Hello, Alice!" && w.messages[2] == "Hi, Alice!")
+
+	// ========================================================================
+	// Test: Degenerate sets skip the port
+	// ========================================================================
+
+	single := &scriptedRandom{values: []int{0}}
+	w2 := &messageWriter{}
+	NewSalutationGreetUseCase(w2, single, "Howdy").Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("One salutation - used without RandomPort", w2.messages[0] == "Howdy, Bob!" && single.calls == 0)
+
+	w3 := &messageWriter{}
+	NewSalutationGreetUseCase(w3, single).Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.RunTest("No salutations - Hello", w3.messages[0] == "Hello, Bob!")
+
+	// ========================================================================
+	// Test: Validation happens before choosing
+	// ========================================================================
+
+	r := uc.Execute(ctx, command.NewGreetCommand(""))
+	tf.RunTest("Invalid name - ValidationError", r.IsError() && r.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Invalid name - nothing written", len(w.messages) == 3 && random.calls == 3)
+
+	tf.Summary(t)
+}
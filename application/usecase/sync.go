@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Spool sync use case (replays commands spooled offline)
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// SyncTarget is the handler spooled commands are replayed to - normally
+// the same use case the spooling decorator wrapped (e.g. a GreetPort).
+type SyncTarget[C any] interface {
+	Execute(ctx context.Context, cmd C) domerr.Result[model.Unit]
+}
+
+// SyncUseCase replays commands spooled while offline
+// (middleware.Spooling), oldest first, once connectivity returns.
+//
+// Workflow, for each spooled entry:
+//  1. Decode the command
+//  2. Execute it with its original idempotency key
+//     (command.WithIdempotencyKey)
+//  3. Ok: remove the entry (Replayed). InfrastructureError (still offline)
+//     or OverloadedError (target shedding load): stop the pass and leave
+//     the rest spooled (Remaining). Any other failure, or an entry that
+//     does not decode: remove it (Rejected) - replaying it again cannot
+//     succeed
+//
+// Idempotence: an entry is removed only after delivery, so a crash or a
+// failed removal replays it again - under the same key, which lets the
+// receiver apply it once. Run one sync at a time per spool.
+//
+// Implements: inbound.SyncPort
+type SyncUseCase[C any, H SyncTarget[C], S outbound.SpoolPort] struct {
+	target H
+	spool  S
+}
+
+// NewSyncUseCase creates the use case replaying spool to target.
+func NewSyncUseCase[C any, H SyncTarget[C], S outbound.SpoolPort](target H, spool S) *SyncUseCase[C, H, S] {
+	return &SyncUseCase[C, H, S]{target: target, spool: spool}
+}
+
+// Execute replays up to cmd.Limit spooled commands (all if 0).
+func (uc *SyncUseCase[C, H, S]) Execute(ctx context.Context, cmd command.SyncCommand) domerr.Result[model.SyncReport] {
+	if cmd.GetLimit() < 0 {
+		return domerr.Err[model.SyncReport](domerr.NewValidationError(
+			fmt.Sprintf("sync limit must not be negative, got %d", cmd.GetLimit())))
+	}
+
+	// Entries are collected first: spools need not support removal during
+	// a Scan.
+	var entries []model.SpoolEntry
+	scanned := uc.spool.Scan(ctx, func(e model.SpoolEntry) bool {
+		entries = append(entries, e)
+		return true
+	})
+	if scanned.IsError() {
+		return domerr.Err[model.SyncReport](scanned.ErrorInfo())
+	}
+
+	report := model.SyncReport{Remaining: len(entries)}
+	if cmd.GetLimit() > 0 {
+		entries = entries[:min(cmd.GetLimit(), len(entries))]
+	}
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return domerr.Err[model.SyncReport](domerr.NewInfrastructureError(
+				fmt.Sprintf("sync cancelled: %v", context.Cause(ctx))))
+		}
+
+		var c C
+		if err := json.Unmarshal(entry.Command, &c); err != nil {
+			report.Rejected++
+		} else {
+			result := uc.target.Execute(command.WithIdempotencyKey(ctx, entry.Key), c)
+			switch {
+			case result.IsOk():
+				report.Replayed++
+			case ctx.Err() != nil:
+				return domerr.Err[model.SyncReport](domerr.NewInfrastructureError(
+					fmt.Sprintf("sync cancelled: %v", context.Cause(ctx))))
+			case result.ErrorInfo().Kind.IsTransient():
+				return domerr.Ok(report) // still offline, or shedding load
+			default:
+				report.Rejected++
+			}
+		}
+
+		if removed := uc.spool.Remove(ctx, entry.Key); removed.IsError() {
+			return domerr.Err[model.SyncReport](removed.ErrorInfo())
+		}
+		report.Remaining--
+	}
+	return domerr.Ok(report)
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// memorySpool is an in-memory SpoolPort.
+type memorySpool struct {
+	entries  []model.SpoolEntry
+	failScan bool
+}
+
+func (s *memorySpool) Append(_ context.Context, e model.SpoolEntry) domerr.Result[model.Unit] {
+	s.entries = append(s.entries, e)
+	return domerr.Ok(model.UnitValue)
+}
+
+func (s *memorySpool) Scan(_ context.Context, visit func(model.SpoolEntry) bool) domerr.Result[model.Unit] {
+	if s.failScan {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("spool unreadable"))
+	}
+	for _, e := range s.entries {
+		if !visit(e) {
+			break
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+func (s *memorySpool) Remove(_ context.Context, key string) domerr.Result[model.Unit] {
+	s.entries = slices.DeleteFunc(s.entries, func(e model.SpoolEntry) bool { return e.Key == key })
+	return domerr.Ok(model.UnitValue)
+}
+
+func (s *memorySpool) spool(key, name string) {
+	encoded, _ := json.Marshal(command.NewGreetCommand(name))
+	s.Append(context.Background(), model.SpoolEntry{Key: key, Command: encoded})
+}
+
+// replayTarget records deliveries and fails names listed in failures.
+type replayTarget struct {
+	delivered []string
+	keys      []string
+	failures  map[string]domerr.ErrorType
+}
+
+func (h *replayTarget) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	if err, ok := h.failures[cmd.GetName()]; ok {
+		return domerr.Err[model.Unit](err)
+	}
+	key, _ := command.IdempotencyKey(ctx)
+	h.delivered = append(h.delivered, cmd.GetName())
+	h.keys = append(h.keys, key)
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationUseCaseSync tests replaying the offline spool.
+func TestApplicationUseCaseSync(t *testing.T) {
+	tf := test.New("Application.UseCase.Sync")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Replays in order with the original keys
+	// ========================================================================
+
+	spool := &memorySpool{}
+	spool.spool("k1", "Alice")
+	spool.spool("k2", "Bob")
+	target := &replayTarget{}
+	sync := NewSyncUseCase[command.GreetCommand](target, spool)
+
+	report := sync.Execute(ctx, command.NewSyncCommand())
+	tf.RunTest("Sync - IsOk", report.IsOk())
+	tf.RunTest("Sync - report", report.Value() == model.SyncReport{Replayed: 2})
+	tf.RunTest("Sync - oldest first", slices.Equal(target.delivered, []string{"Alice", "Bob"}))
+	tf.RunTest("Sync - original idempotency keys", slices.Equal(target.keys, []string{"k1", "k2"}))
+	tf.RunTest("Sync - spool emptied", len(spool.entries) == 0)
+	tf.RunTest("Empty spool - nothing to do", sync.Execute(ctx, command.NewSyncCommand()).Value() == model.SyncReport{})
+
+	// ========================================================================
+	// Test: Still offline stops the pass
+	// ========================================================================
+
+	spool.spool("k3", "Carol")
+	spool.spool("k4", "Dave")
+	spool.spool("k5", "Erin")
+	target.failures = map[string]domerr.ErrorType{"Dave": domerr.NewInfrastructureError("unreachable")}
+	offline := sync.Execute(ctx, command.NewSyncCommand())
+	tf.RunTest("Offline - IsOk", offline.IsOk())
+	tf.RunTest("Offline - report", offline.Value() == model.SyncReport{Replayed: 1, Remaining: 2})
+	tf.RunTest("Offline - rest still spooled", len(spool.entries) == 2 && spool.entries[0].Key == "k4")
+
+	// ========================================================================
+	// Test: Overloaded target stops the pass
+	// ========================================================================
+
+	target.failures = map[string]domerr.ErrorType{"Dave": domerr.NewOverloadedError("shedding")}
+	overloaded := sync.Execute(ctx, command.NewSyncCommand())
+	tf.RunTest("Overloaded - IsOk", overloaded.IsOk())
+	tf.RunTest("Overloaded - report", overloaded.Value() == model.SyncReport{Remaining: 2})
+	tf.RunTest("Overloaded - entry kept", len(spool.entries) == 2 && spool.entries[0].Key == "k4")
+
+	// ========================================================================
+	// Test: Rejected and undecodable entries are removed
+	// ========================================================================
+
+	target.failures = map[string]domerr.ErrorType{"Dave": domerr.NewValidationError("bad name")}
+	spool.entries = append(spool.entries, model.SpoolEntry{Key: "k6", Command: []byte("not json")})
+	rejected := sync.Execute(ctx, command.NewSyncCommand())
+	tf.RunTest("Rejected - report", rejected.Value() == model.SyncReport{Replayed: 1, Rejected: 2})
+	tf.RunTest("Rejected - spool emptied", len(spool.entries) == 0)
+
+	// ========================================================================
+	// Test: Limit
+	// ========================================================================
+
+	for _, name := range []string{"F", "G", "H"} {
+		spool.spool("k-"+name, name)
+	}
+	limited := sync.Execute(ctx, command.SyncCommand{Limit: 2})
+	tf.RunTest("Limit - report", limited.Value() == model.SyncReport{Replayed: 2, Remaining: 1})
+	tf.RunTest("Limit - last entry kept", len(spool.entries) == 1 && spool.entries[0].Key == "k-H")
+	negative := sync.Execute(ctx, command.SyncCommand{Limit: -1})
+	tf.RunTest("Negative limit - ValidationError",
+		negative.IsError() && negative.ErrorInfo().Kind == domerr.ValidationError)
+
+	// ========================================================================
+	// Test: Failures
+	// ========================================================================
+
+	tf.RunTest("Spool unreadable - IsError",
+		NewSyncUseCase[command.GreetCommand](target, &memorySpool{failScan: true}).Execute(ctx, command.NewSyncCommand()).IsError())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled ctx - IsError, entry kept",
+		sync.Execute(cancelled, command.NewSyncCommand()).IsError() && len(spool.entries) == 1)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Verify an exported artifact against its SHA-256 sidecar
+
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// checksumSidecarLimit caps how much of a sidecar is read.
+const checksumSidecarLimit = 4 << 10
+
+// VerifyExportUseCase checks a blob against the SHA-256 recorded in its
+// "<key>.sha256" sidecar, as written by ExportHistoryUseCase.
+//
+// The object is hashed while streaming; it is never held in memory.
+type VerifyExportUseCase[B outbound.BlobPort] struct {
+	blobs B
+}
+
+// NewVerifyExportUseCase creates the use case with an injected blob store.
+func NewVerifyExportUseCase[B outbound.BlobPort](blobs B) *VerifyExportUseCase[B] {
+	return &VerifyExportUseCase[B]{blobs: blobs}
+}
+
+// Execute verifies the object stored under key.
+//
+// Contract:
+//   - Returns Ok(hex digest) when the object matches its sidecar
+//   - Returns Err(ValidationError) if the sidecar is malformed
+//   - Returns Err(InfrastructureError) on a mismatch, a missing object or
+//     sidecar, a read failure or cancellation
+func (uc *VerifyExportUseCase[B]) Execute(ctx context.Context, key string) domerr.Result[string] {
+	expected := uc.readSidecar(ctx, key)
+	if expected.IsError() {
+		return expected
+	}
+
+	object := uc.blobs.Get(ctx, key)
+	if object.IsError() {
+		return domerr.Err[string](object.ErrorInfo())
+	}
+	body := object.Value()
+	defer body.Close()
+
+	digest := sha256.New()
+	if _, err := io.Copy(digest, body); err != nil {
+		return domerr.Err[string](domerr.NewInfrastructureError(
+			fmt.Sprintf("verify %q failed: %v", key, err)))
+	}
+	actual := hex.EncodeToString(digest.Sum(nil))
+	if actual != expected.Value() {
+		return domerr.Err[string](domerr.NewInfrastructureError(fmt.Sprintf(
+			"verify %q failed: checksum mismatch (expected %s, got %s)", key, expected.Value(), actual)))
+	}
+	return domerr.Ok(actual)
+}
+
+// readSidecar returns the digest recorded for key.
+func (uc *VerifyExportUseCase[B]) readSidecar(ctx context.Context, key string) domerr.Result[string] {
+	sidecar := uc.blobs.Get(ctx, key+ChecksumSuffix)
+	if sidecar.IsError() {
+		return domerr.Err[string](sidecar.ErrorInfo())
+	}
+	defer sidecar.Value().Close()
+
+	content, err := io.ReadAll(io.LimitReader(sidecar.Value(), checksumSidecarLimit))
+	if err != nil {
+		return domerr.Err[string](domerr.NewInfrastructureError(
+			fmt.Sprintf("verify %q failed: reading checksum: %v", key, err)))
+	}
+	digest, ok := parseChecksum(string(content))
+	if !ok {
+		return domerr.Err[string](domerr.NewValidationError(
+			fmt.Sprintf("verify %q failed: %s is not a SHA-256 checksum file", key, key+ChecksumSuffix)))
+	}
+	return domerr.Ok(digest)
+}
+
+// formatChecksum renders a sidecar line in sha256sum format.
+func formatChecksum(digest, name string) string {
+	return digest + "  " + name + "\n"
+}
+
+// parseChecksum reads the digest from a sidecar: sha256sum format, or a
+// bare digest. Hex case is ignored.
+func parseChecksum(content string) (string, bool) {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return "", false
+	}
+	digest := strings.ToLower(fields[0])
+	if _, err := hex.DecodeString(digest); err != nil || len(digest) != sha256.Size*2 {
+		return "", false
+	}
+	return digest, true
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestApplicationUsecaseVerifyExport tests checksum verification of exports.
+func TestApplicationUsecaseVerifyExport(t *testing.T) {
+	tf := test.New("Application.Usecase.VerifyExport")
+	ctx := context.Background()
+
+	history := &sliceHistory{records: []model.GreetingRecord{{Name: "Alice", Text: "Hello, Alice!", Locale: "en"}}}
+	blobs := &memoryBlobs{objects: map[string]string{}}
+	exported := NewExportHistoryUseCase(history, blobs).
+		Execute(ctx, command.NewExportHistoryCommand(ExportFormatJSON, "reports/h.json"))
+	verify := NewVerifyExportUseCase(blobs)
+
+	// ========================================================================
+	// Test: A fresh export verifies
+	// ========================================================================
+
+	r1 := verify.Execute(ctx, "reports/h.json")
+	tf.RunTest("Fresh export - IsOk", r1.IsOk())
+	tf.RunTest("Fresh export - digest matches summary", r1.IsOk() && exported.IsOk() && r1.Value() == exported.Value().SHA256)
+
+	// ========================================================================
+	// Test: Tampering and missing pieces are reported
+	// ========================================================================
+
+	original := blobs.objects["reports/h.json"]
+	blobs.objects["reports/h.json"] = strings.Replace(original, "Alice", "Alicf", 1)
+	r2 := verify.Execute(ctx, "reports/h.json")
+	tf.RunTest("Tampered - InfrastructureError", r2.IsError() && r2.ErrorInfo().Kind == domerr.InfrastructureError)
+	tf.RunTest("Tampered - mismatch reported", r2.IsError() && strings.Contains(r2.ErrorInfo().Message, "checksum mismatch"))
+	blobs.objects["reports/h.json"] = original
+
+	blobs.objects["bare.json"] = original
+	blobs.objects["bare.json.sha256"] = strings.ToUpper(exported.Value().SHA256) + "\n"
+	tf.RunTest("Bare upper-case digest - accepted", verify.Execute(ctx, "bare.json").IsOk())
+
+	blobs.objects["orphan.json"] = original
+	tf.RunTest("Missing sidecar - IsError", verify.Execute(ctx, "orphan.json").IsError())
+
+	blobs.objects["orphan.json.sha256"] = "not a checksum\n"
+	r3 := verify.Execute(ctx, "orphan.json")
+	tf.RunTest("Malformed sidecar - ValidationError", r3.IsError() && r3.ErrorInfo().Kind == domerr.ValidationError)
+
+	delete(blobs.objects, "reports/h.json")
+	tf.RunTest("Missing object - IsError", verify.Execute(ctx, "reports/h.json").IsError())
+
+	tf.Summary(t)
+}
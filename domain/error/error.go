@@ -28,7 +28,10 @@
 //	}
 package error
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrorKind represents categories of errors that can occur in the application.
 // This enables pattern matching and different handling strategies per category.
@@ -45,6 +48,14 @@ const (
 
 	// InfrastructureError indicates infrastructure failures (I/O, network, DB)
 	InfrastructureError
+
+	// OverloadedError indicates the request was rejected by admission
+	// control (too many in flight) without being attempted; it is transient
+	OverloadedError
+
+	// StaleVersionError indicates an update was based on an outdated version
+	// of an entity (optimistic concurrency conflict); re-read and retry
+	StaleVersionError
 )
 
 // String returns a human-readable representation of the ErrorKind.
@@ -54,11 +65,30 @@ func (k ErrorKind) String() string {
 		return "ValidationError"
 	case InfrastructureError:
 		return "InfrastructureError"
+	case OverloadedError:
+		return "OverloadedError"
+	case StaleVersionError:
+		return "StaleVersionError"
 	default:
 		return "UnknownError"
 	}
 }
 
+// IsValid reports whether k is one of the declared kinds. StaleVersionError
+// must stay the last kind declared; add new kinds before it or move the
+// bound.
+func (k ErrorKind) IsValid() bool {
+	return k >= ValidationError && k <= StaleVersionError
+}
+
+// IsTransient reports whether an error of kind k may succeed if the same
+// operation is tried again later: infrastructure failures and admission
+// control rejections. Retry, offline spooling and sync replay share this
+// test.
+func (k ErrorKind) IsTransient() bool {
+	return k == InfrastructureError || k == OverloadedError
+}
+
 // ErrorType is the concrete error type used throughout the application.
 // It combines an error category (Kind) with a descriptive message.
 //
@@ -70,9 +100,18 @@ func (k ErrorKind) String() string {
 // Contract:
 //   - Message should be non-empty when creating errors
 //   - Kind should be a valid ErrorKind value
+//   - RetryAfter is an optional hint (0 = none) set by adapters that know
+//     when the failing dependency will accept requests again (HTTP 429/503
+//     Retry-After, an open circuit breaker); retry policies should wait at
+//     least this long before the next attempt
+//   - ErrorType stays comparable: the optional stack trace is held by pointer
+//     (see NewInfrastructureErrorTrace)
 type ErrorType struct {
-	Kind    ErrorKind
-	Message string
+	Kind       ErrorKind
+	Message    string
+	RetryAfter time.Duration
+
+	trace *StackTrace
 }
 
 // Error implements the error interface for ErrorType.
@@ -96,3 +135,31 @@ func NewInfrastructureError(message string) ErrorType {
 		Message: message,
 	}
 }
+
+// NewOverloadedError creates a new overload (admission control) error with
+// the given message.
+func NewOverloadedError(message string) ErrorType {
+	return ErrorType{
+		Kind:    OverloadedError,
+		Message: message,
+	}
+}
+
+// NewStaleVersionError creates a new optimistic concurrency conflict error
+// with the given message.
+func NewStaleVersionError(message string) ErrorType {
+	return ErrorType{
+		Kind:    StaleVersionError,
+		Message: message,
+	}
+}
+
+// WithRetryAfter returns a copy of e carrying the retry hint d.
+//
+// Example:
+//
+//	return domerr.Err[T](apperr.NewInfrastructureError("rate limited").WithRetryAfter(30 * time.Second))
+func (e ErrorType) WithRetryAfter(d time.Duration) ErrorType {
+	e.RetryAfter = d
+	return e
+}
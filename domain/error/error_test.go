@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestDomainErrorType tests ErrorType construction and retry hints.
+func TestDomainErrorType(t *testing.T) {
+	tf := test.New("Domain.Error.ErrorType")
+
+	base := domerr.NewInfrastructureError("rate limited")
+	tf.RunTest("New error - no retry hint", base.RetryAfter == 0)
+	tf.RunTest("Error string - kind and message", base.Error() == "InfrastructureError: rate limited")
+
+	hinted := base.WithRetryAfter(30 * time.Second)
+	tf.RunTest("WithRetryAfter - hint set", hinted.RetryAfter == 30*time.Second)
+	tf.RunTest("WithRetryAfter - original unchanged", base.RetryAfter == 0)
+	tf.RunTest("WithRetryAfter - kind and message kept",
+		hinted.Kind == domerr.InfrastructureError && hinted.Message == "rate limited")
+
+	overloaded := domerr.NewOverloadedError("busy")
+	tf.RunTest("Overloaded - kind string", overloaded.Error() == "OverloadedError: busy")
+
+	stale := domerr.NewStaleVersionError("read 1, stored 2")
+	tf.RunTest("StaleVersion - kind string", stale.Error() == "StaleVersionError: read 1, stored 2")
+
+	tf.RunTest("IsValid - declared kinds",
+		domerr.ValidationError.IsValid() && domerr.InfrastructureError.IsValid() &&
+			domerr.OverloadedError.IsValid() && domerr.StaleVersionError.IsValid())
+	tf.RunTest("IsValid - out of range", !domerr.ErrorKind(-1).IsValid() && !domerr.ErrorKind(99).IsValid())
+	tf.RunTest("IsTransient - infrastructure and overload",
+		domerr.InfrastructureError.IsTransient() && domerr.OverloadedError.IsTransient())
+	tf.RunTest("IsTransient - not validation or conflict",
+		!domerr.ValidationError.IsTransient() && !domerr.StaleVersionError.IsTransient())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+func parsePort(s string) domerr.Result[int] {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return domerr.Err[int](domerr.NewValidationError(fmt.Sprintf("invalid port %q", s)))
+	}
+	return domerr.Ok(port)
+}
+
+func ExampleOk() {
+	r := domerr.Ok(42)
+	fmt.Println(r.IsOk(), r.Value())
+	// Output: true 42
+}
+
+func ExampleErr() {
+	r := domerr.Err[int](domerr.NewValidationError("name is empty"))
+	fmt.Println(r.IsError(), r.ErrorInfo())
+	// Output: true ValidationError: name is empty
+}
+
+func ExampleResult_Map() {
+	r := domerr.Ok("alice").Map(strings.ToUpper)
+	fmt.Println(r.Value())
+	// Output: ALICE
+}
+
+func ExampleMapTo() {
+	r := domerr.MapTo(parsePort("8080"), func(port int) string { return fmt.Sprintf("localhost:%d", port) })
+	fmt.Println(r.Value())
+	// Output: localhost:8080
+}
+
+func ExampleAndThenTo() {
+	address := func(host, port string) domerr.Result[string] {
+		return domerr.AndThenTo(parsePort(port), func(p int) domerr.Result[string] {
+			if host == "" {
+				return domerr.Err[string](domerr.NewValidationError("host is empty"))
+			}
+			return domerr.Ok(fmt.Sprintf("%s:%d", host, p))
+		})
+	}
+	fmt.Println(address("example.com", "443").Value())
+	fmt.Println(address("example.com", "http").ErrorInfo().Message)
+	fmt.Println(address("", "443").ErrorInfo().Message)
+	// Output:
+	// example.com:443
+	// invalid port "http"
+	// host is empty
+}
+
+func ExampleResult_UnwrapOr() {
+	fmt.Println(parsePort("9090").UnwrapOr(80))
+	fmt.Println(parsePort("none").UnwrapOr(80))
+	// Output:
+	// 9090
+	// 80
+}
+
+func ExampleResult_MapError() {
+	r := parsePort("x").MapError(func(e domerr.ErrorType) domerr.ErrorType {
+		return domerr.NewValidationError("config: " + e.Message)
+	})
+	fmt.Println(r.ErrorInfo().Message)
+	// Output: config: invalid port "x"
+}
+
+func ExampleResult_Fallback() {
+	fromEnv := parsePort("")
+	fmt.Println(fromEnv.Fallback(parsePort("8080")).Value())
+	// Output: 8080
+}
+
+func ExampleResult_Recover() {
+	port := parsePort("bad").Recover(func(e domerr.ErrorType) int {
+		fmt.Println("using default:", e.Message)
+		return 80
+	})
+	fmt.Println(port)
+	// Output:
+	// using default: invalid port "bad"
+	// 80
+}
+
+func ExampleResult_Tap() {
+	parsePort("8080").Tap(
+		func(p int) { fmt.Println("listening on", p) },
+		func(e domerr.ErrorType) { fmt.Println("failed:", e.Message) },
+	)
+	// Output: listening on 8080
+}
+
+func ExampleResult_Unpack() {
+	port, err := parsePort("0").Unpack()
+	fmt.Println(port, err)
+	// Output: 0 ValidationError: invalid port "0"
+}
+
+func ExampleFromError() {
+	fmt.Println(domerr.FromError(strconv.Atoi("12")).Value())
+	r := domerr.FromError(strconv.Atoi("twelve"))
+	fmt.Println(r.ErrorInfo().Kind)
+	// Output:
+	// 12
+	// InfrastructureError
+}
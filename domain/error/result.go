@@ -24,6 +24,12 @@
 // Package error provides domain error types and Result monad for error handling.
 package error
 
+import (
+	"errors"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/internal/assert"
+)
+
 // Result represents either a successful value of type T or an error.
 // This is the core functional error handling type.
 //
@@ -65,6 +71,9 @@ func Ok[T any](value T) Result[T] {
 //
 //	result := Err[int](NewValidationError("invalid input"))
 func Err[T any](err ErrorType) Result[T] {
+	if assert.Enabled {
+		assert.That(err.Kind.IsValid(), "error %q has unknown kind %d", err.Message, int(err.Kind))
+	}
 	return Result[T]{
 		err:  err,
 		isOk: false,
@@ -335,3 +344,70 @@ func (r Result[T]) Tap(onOk func(T), onError func(ErrorType)) Result[T] {
 	}
 	return r
 }
+
+// Inspect calls f with the value if Ok; does nothing if Err.
+// Returns the same Result for chaining.
+//
+// Example:
+//
+//	result := greet(cmd).Inspect(func(u Unit) { metrics.Inc("greet.ok") })
+func (r Result[T]) Inspect(f func(T)) Result[T] {
+	if r.isOk {
+		f(r.value)
+	}
+	return r
+}
+
+// InspectErr calls f with the error if Err; does nothing if Ok.
+// Returns the same Result for chaining.
+//
+// Example:
+//
+//	result := greet(cmd).InspectErr(func(e ErrorType) { log.Warn(e.Message) })
+func (r Result[T]) InspectErr(f func(ErrorType)) Result[T] {
+	if !r.isOk {
+		f(r.err)
+	}
+	return r
+}
+
+// ============================================================================
+// Interop with (T, error) APIs
+// ============================================================================
+
+// Unpack converts the Result to Go's (value, error) convention, for APIs
+// such as errgroup that expect a plain error. The error is the ErrorType
+// itself, so FromError recovers it unchanged.
+//
+// Example:
+//
+//	g.Go(func() error { _, err := fetch(ctx).Unpack(); return err })
+func (r Result[T]) Unpack() (T, error) {
+	if !r.isOk {
+		var zero T
+		return zero, r.err
+	}
+	return r.value, nil
+}
+
+// FromError converts a (value, error) pair into a Result.
+//
+// Mapping:
+//   - err == nil: Ok(value)
+//   - err is or wraps an ErrorType: Err(that ErrorType), with Kind and
+//     RetryAfter preserved
+//   - any other error: Err(InfrastructureError) with err's message
+//
+// Example:
+//
+//	result := FromError(strconv.Atoi(s))
+func FromError[T any](value T, err error) Result[T] {
+	if err == nil {
+		return Ok(value)
+	}
+	var typed ErrorType
+	if errors.As(err, &typed) {
+		return Err[T](typed)
+	}
+	return Err[T](NewInfrastructureError(err.Error()))
+}
@@ -4,9 +4,13 @@
 package error_test
 
 import (
+	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/internal/assert"
 	"github.com/abitofhelp/hybrid_lib_go/domain/test"
 )
 
@@ -141,6 +145,63 @@ func TestDomainErrorResult(t *testing.T) {
 	})
 	tf.RunTest("UnwrapOr with Error - returns default", r12.UnwrapOr(99) == 99)
 
+	// ========================================================================
+	// Test: Inspect / InspectErr observe without changing the Result
+	// ========================================================================
+
+	seen, seenErr := 0, ""
+	r13 := domerr.Ok(7).
+		Inspect(func(v int) { seen = v }).
+		InspectErr(func(e domerr.ErrorType) { seenErr = e.Message })
+	tf.RunTest("Inspect with Ok - called with value", seen == 7)
+	tf.RunTest("InspectErr with Ok - not called", seenErr == "")
+	tf.RunTest("Inspect with Ok - Result unchanged", r13.IsOk() && r13.Value() == 7)
+
+	seen = 0
+	r14 := r12.
+		Inspect(func(v int) { seen = v }).
+		InspectErr(func(e domerr.ErrorType) { seenErr = e.Message })
+	tf.RunTest("Inspect with Error - not called", seen == 0)
+	tf.RunTest("InspectErr with Error - called with error", seenErr == "error")
+	tf.RunTest("InspectErr with Error - Result unchanged",
+		r14.IsError() && r14.ErrorInfo() == r12.ErrorInfo())
+
+	// ========================================================================
+	// Test: Interop with (T, error)
+	// ========================================================================
+
+	v15, err15 := domerr.Ok(3).Unpack()
+	tf.RunTest("Unpack Ok - value and nil error", v15 == 3 && err15 == nil)
+	hinted := domerr.NewInfrastructureError("busy").WithRetryAfter(time.Second)
+	v16, err16 := domerr.Err[int](hinted).Unpack()
+	tf.RunTest("Unpack Err - zero value and error", v16 == 0 && err16 != nil)
+
+	r17 := domerr.FromError(v16, fmt.Errorf("wrapped: %w", err16))
+	tf.RunTest("FromError ErrorType - lossless round trip", r17.IsError() && r17.ErrorInfo() == hinted)
+	r18 := domerr.FromError(0, io.EOF)
+	tf.RunTest("FromError plain error - InfrastructureError", r18.IsError() &&
+		r18.ErrorInfo().Kind == domerr.InfrastructureError && r18.ErrorInfo().Message == "EOF")
+	r19 := domerr.FromError(5, nil)
+	tf.RunTest("FromError nil - Ok", r19.IsOk() && r19.Value() == 5)
+
+	// ========================================================================
+	// Test: Invariant assertions (-tags assert)
+	// ========================================================================
+
+	unknown := domerr.ErrorType{Kind: domerr.ErrorKind(99), Message: "bogus"}
+	panicked := func() (p bool) {
+		defer func() { p = recover() != nil }()
+		domerr.Err[int](unknown)
+		return false
+	}()
+	tf.RunTest("Err unknown kind - panics only with assertions", panicked == assert.Enabled)
+	negative := func() (p bool) {
+		defer func() { p = recover() != nil }()
+		domerr.Err[int](domerr.ErrorType{Kind: domerr.ErrorKind(-1), Message: "bogus"})
+		return false
+	}()
+	tf.RunTest("Err negative kind - panics only with assertions", negative == assert.Enabled)
+
 	// Print summary and fail test if any failed
 	tf.Summary(t)
 }
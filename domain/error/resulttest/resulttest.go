@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: resulttest
+// Description: Assertion, equality and diff helpers for Result in tests
+
+// Package resulttest provides test helpers for domerr.Result, replacing
+// hand-rolled checks such as
+//
+//	if r.IsError() || r.Value() != want { t.Fatalf(...) }
+//
+// with assertions whose failure output shows what actually came back.
+//
+// Usage:
+//
+//	receipt := resulttest.AssertOk(t, writer.WriteWithReceipt(ctx, "hi"))
+//	resulttest.AssertErrKind(t, greeter.Execute(ctx, cmd), domerr.ValidationError)
+//	if d := resulttest.Diff(want, got); d != "" {
+//	    t.Errorf("report mismatch (-want +got):\n%s", d)
+//	}
+//
+// Equal and Diff also suit the test framework's boolean checks:
+//
+//	tf.RunTest("Report - metadata", resulttest.Equal(want, got))
+package resulttest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// AssertOk fails the test immediately unless r is Ok, and returns r's
+// value.
+func AssertOk[T any](t testing.TB, r domerr.Result[T]) T {
+	t.Helper()
+	if r.IsError() {
+		t.Fatalf("expected Ok, got Err(%s)", r.ErrorInfo())
+	}
+	return r.Value()
+}
+
+// AssertErrKind fails the test immediately unless r is an Err of kind,
+// and returns the error.
+func AssertErrKind[T any](t testing.TB, r domerr.Result[T], kind domerr.ErrorKind) domerr.ErrorType {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("expected Err(%s), got Ok(%#v)", kind, r.Value())
+	}
+	if got := r.ErrorInfo(); got.Kind != kind {
+		t.Fatalf("expected Err(%s), got Err(%s)", kind, got)
+	}
+	return r.ErrorInfo()
+}
+
+// AssertEqual fails the test unless want and got are Equal, reporting
+// their Diff.
+func AssertEqual[T any](t testing.TB, want, got domerr.Result[T]) {
+	t.Helper()
+	if d := Diff(want, got); d != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", d)
+	}
+}
+
+// Equal reports whether want and got are both Ok with deeply equal values,
+// or both Err with the same kind, message and retry hint. Stack traces are
+// not compared.
+func Equal[T any](want, got domerr.Result[T]) bool {
+	return Diff(want, got) == ""
+}
+
+// Diff returns "" if want and got are Equal, and otherwise one line per
+// difference, e.g.
+//
+//	value.Metadata["name"]: -"Alice" +"Bob"
+//	error.Kind: -ValidationError +InfrastructureError
+//
+// Struct fields, map entries and slice elements of the values are compared
+// one by one, so a mismatch in a large value is reported where it occurs.
+// Structs with only unexported fields (time.Time) are compared whole.
+func Diff[T any](want, got domerr.Result[T]) string {
+	var lines []string
+	switch {
+	case want.IsOk() && got.IsOk():
+		diffValue(&lines, "value", reflect.ValueOf(want.Value()), reflect.ValueOf(got.Value()))
+	case want.IsError() && got.IsError():
+		w, g := want.ErrorInfo(), got.ErrorInfo()
+		if w.Kind != g.Kind {
+			lines = append(lines, fmt.Sprintf("error.Kind: -%s +%s", w.Kind, g.Kind))
+		}
+		if w.Message != g.Message {
+			lines = append(lines, fmt.Sprintf("error.Message: -%q +%q", w.Message, g.Message))
+		}
+		if w.RetryAfter != g.RetryAfter {
+			lines = append(lines, fmt.Sprintf("error.RetryAfter: -%s +%s", w.RetryAfter, g.RetryAfter))
+		}
+	default:
+		lines = append(lines, fmt.Sprintf("result: -%s +%s", describe(want), describe(got)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// describe renders r on one line.
+func describe[T any](r domerr.Result[T]) string {
+	if r.IsOk() {
+		return fmt.Sprintf("Ok(%#v)", r.Value())
+	}
+	return fmt.Sprintf("Err(%s)", r.ErrorInfo())
+}
+
+// diffValue appends the differences between want and got under path.
+func diffValue(lines *[]string, path string, want, got reflect.Value) {
+	if equal(want, got) {
+		return
+	}
+	if !want.IsValid() || !got.IsValid() || want.Type() != got.Type() {
+		*lines = append(*lines, fmt.Sprintf("%s: -%s +%s", path, format(want), format(got)))
+		return
+	}
+
+	switch want.Kind() {
+	case reflect.Struct:
+		if opaque(want.Type()) {
+			break
+		}
+		for i := range want.NumField() {
+			diffValue(lines, path+"."+want.Type().Field(i).Name, want.Field(i), got.Field(i))
+		}
+		return
+	case reflect.Map:
+		if want.IsNil() == got.IsNil() {
+			diffMap(lines, path, want, got)
+			return
+		}
+	case reflect.Slice, reflect.Array:
+		if want.Len() == got.Len() && (want.Kind() == reflect.Array || want.IsNil() == got.IsNil()) {
+			for i := range want.Len() {
+				diffValue(lines, fmt.Sprintf("%s[%d]", path, i), want.Index(i), got.Index(i))
+			}
+			return
+		}
+	case reflect.Pointer, reflect.Interface:
+		if !want.IsNil() && !got.IsNil() {
+			diffValue(lines, path, want.Elem(), got.Elem())
+			return
+		}
+	}
+	*lines = append(*lines, fmt.Sprintf("%s: -%s +%s", path, format(want), format(got)))
+}
+
+// equal compares deeply; values of unexported fields, which reflect
+// cannot hand out, are compared by their printed form.
+func equal(want, got reflect.Value) bool {
+	switch {
+	case !want.IsValid() || !got.IsValid():
+		return want.IsValid() == got.IsValid()
+	case want.Type() != got.Type():
+		return false
+	case want.CanInterface() && got.CanInterface():
+		return reflect.DeepEqual(want.Interface(), got.Interface())
+	default:
+		return format(want) == format(got)
+	}
+}
+
+// opaque reports whether t is a struct with only unexported fields, such
+// as time.Time; its internals would not make a readable diff.
+func opaque(t reflect.Type) bool {
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
+
+// diffMap appends per-key differences, keys in printed order.
+func diffMap(lines *[]string, path string, want, got reflect.Value) {
+	keys := map[string]reflect.Value{}
+	for _, k := range append(want.MapKeys(), got.MapKeys()...) {
+		keys[fmt.Sprintf("%#v", k)] = k
+	}
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		k := keys[name]
+		diffValue(lines, path+"["+name+"]", want.MapIndex(k), got.MapIndex(k))
+	}
+}
+
+// format renders v for a diff line; a missing value is "<missing>".
+func format(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<missing>"
+	}
+	return fmt.Sprintf("%#v", v)
+}
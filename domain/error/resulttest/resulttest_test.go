@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package resulttest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// stopped is what recordingT panics with on Fatalf.
+type stopped struct{}
+
+// recordingT records failures instead of failing the enclosing test.
+type recordingT struct {
+	testing.TB
+	failures []string
+	fatal    bool
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+	panic(stopped{})
+}
+
+// run calls f with a recordingT, stopping at the first Fatalf.
+func run(f func(t testing.TB)) (r *recordingT) {
+	r = &recordingT{}
+	defer func() {
+		if p := recover(); p != nil && p != (stopped{}) {
+			panic(p)
+		}
+	}()
+	f(r)
+	return r
+}
+
+type report struct {
+	Message  string
+	Metadata map[string]string
+	Tags     []string
+	At       *time.Time
+	note     string
+}
+
+// TestDomainErrorResulttestAssert tests the assertion helpers.
+func TestDomainErrorResulttestAssert(t *testing.T) {
+	tf := test.New("Domain.Error.Resulttest.Assert")
+	invalid := domerr.Err[int](domerr.NewValidationError("name is empty"))
+
+	// ========================================================================
+	// Test: AssertOk
+	// ========================================================================
+
+	var value int
+	passed := run(func(t testing.TB) { value = AssertOk(t, domerr.Ok(42)) })
+	tf.RunTest("AssertOk Ok - returns value, no failure", value == 42 && len(passed.failures) == 0)
+	failed := run(func(t testing.TB) { AssertOk(t, invalid) })
+	tf.RunTest("AssertOk Err - fatal with the error", failed.fatal &&
+		failed.failures[0] == "expected Ok, got Err(ValidationError: name is empty)")
+
+	// ========================================================================
+	// Test: AssertErrKind
+	// ========================================================================
+
+	var info domerr.ErrorType
+	passed = run(func(t testing.TB) { info = AssertErrKind(t, invalid, domerr.ValidationError) })
+	tf.RunTest("AssertErrKind match - returns error", len(passed.failures) == 0 && info.Message == "name is empty")
+	failed = run(func(t testing.TB) { AssertErrKind(t, invalid, domerr.InfrastructureError) })
+	tf.RunTest("AssertErrKind other kind - names both", failed.fatal &&
+		failed.failures[0] == "expected Err(InfrastructureError), got Err(ValidationError: name is empty)")
+	failed = run(func(t testing.TB) { AssertErrKind(t, domerr.Ok(7), domerr.ValidationError) })
+	tf.RunTest("AssertErrKind Ok - shows value", failed.fatal &&
+		failed.failures[0] == "expected Err(ValidationError), got Ok(7)")
+
+	// ========================================================================
+	// Test: AssertEqual
+	// ========================================================================
+
+	passed = run(func(t testing.TB) { AssertEqual(t, domerr.Ok("a"), domerr.Ok("a")) })
+	tf.RunTest("AssertEqual equal - no failure", len(passed.failures) == 0)
+	failed = run(func(t testing.TB) { AssertEqual(t, domerr.Ok("a"), domerr.Ok("b")) })
+	tf.RunTest("AssertEqual different - non-fatal diff", !failed.fatal && len(failed.failures) == 1 &&
+		strings.HasSuffix(failed.failures[0], `value: -"a" +"b"`))
+
+	tf.Summary(t)
+}
+
+// TestDomainErrorResulttestDiff tests Equal and Diff.
+func TestDomainErrorResulttestDiff(t *testing.T) {
+	tf := test.New("Domain.Error.Resulttest.Diff")
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := at.Add(time.Hour)
+	base := report{Message: "hi", Metadata: map[string]string{"name": "Alice", "lang": "en"},
+		Tags: []string{"a", "b"}, At: &at, note: "x"}
+
+	// ========================================================================
+	// Test: Equal values
+	// ========================================================================
+
+	same := base
+	same.Metadata = map[string]string{"lang": "en", "name": "Alice"}
+	atCopy := at
+	same.At = &atCopy
+	tf.RunTest("Equal - deeply equal values", Equal(domerr.Ok(base), domerr.Ok(same)))
+	tf.RunTest("Diff - empty when equal", Diff(domerr.Ok(base), domerr.Ok(same)) == "")
+	traced := domerr.NewInfrastructureErrorTrace("down")
+	tf.RunTest("Equal - stack traces ignored",
+		Equal(domerr.Err[int](traced), domerr.Err[int](domerr.NewInfrastructureError("down"))))
+
+	// ========================================================================
+	// Test: Value differences
+	// ========================================================================
+
+	changed := base
+	changed.Metadata = map[string]string{"name": "Bob", "tz": "UTC"}
+	changed.Tags = []string{"a", "c"}
+	changed.At = &later
+	changed.note = "y"
+	want := strings.Join([]string{
+		`value.Metadata["lang"]: -"en" +<missing>`,
+		`value.Metadata["name"]: -"Alice" +"Bob"`,
+		`value.Metadata["tz"]: -<missing> +"UTC"`,
+		`value.Tags[1]: -"b" +"c"`,
+		`value.At: -time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC) +time.Date(2025, time.January, 1, 1, 0, 0, 0, time.UTC)`,
+		`value.note: -"x" +"y"`,
+	}, "\n")
+	tf.RunTest("Diff - field by field, metadata by key", Diff(domerr.Ok(base), domerr.Ok(changed)) == want)
+	tf.RunTest("Diff - scalar value", Diff(domerr.Ok(1), domerr.Ok(2)) == "value: -1 +2")
+
+	// ========================================================================
+	// Test: Error and state differences
+	// ========================================================================
+
+	hinted := domerr.NewInfrastructureError("busy").WithRetryAfter(time.Second)
+	d := Diff(domerr.Err[int](domerr.NewValidationError("bad")), domerr.Err[int](hinted))
+	tf.RunTest("Diff - error fields", d == "error.Kind: -ValidationError +InfrastructureError\n"+
+		`error.Message: -"bad" +"busy"`+"\nerror.RetryAfter: -0s +1s")
+	tf.RunTest("Diff - Ok vs Err", Diff(domerr.Ok(1), domerr.Err[int](hinted)) ==
+		"result: -Ok(1) +Err(InfrastructureError: busy)")
+	tf.RunTest("Equal - Ok vs Err", !Equal(domerr.Err[int](hinted), domerr.Ok(1)))
+
+	tf.Summary(t)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: error
+// Description: Optional stack trace capture for infrastructure errors
+
+package error
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// traceDepth is the maximum number of frames captured by
+// NewInfrastructureErrorTrace; 0 disables capture.
+var traceDepth atomic.Int32
+
+// MaxTraceDepth bounds SetTraceDepth; deeper stacks are truncated.
+const MaxTraceDepth = 256
+
+// SetTraceDepth sets how many stack frames NewInfrastructureErrorTrace
+// captures, process-wide. 0 (the default) disables capture, so traced
+// constructors cost no more than their untraced counterparts.
+//
+// Call it once from the composition root (e.g. when a debug flag is set);
+// negative values are treated as 0 and values above MaxTraceDepth as
+// MaxTraceDepth. The setting is per process, not per
+// composition root: a desktop binary, the cexport shared library and a
+// wasm module each have their own, but roots composed in one process
+// share it.
+func SetTraceDepth(depth int) {
+	traceDepth.Store(int32(min(max(depth, 0), MaxTraceDepth)))
+}
+
+// TraceDepth returns the current capture depth (0 = disabled).
+func TraceDepth() int {
+	return int(traceDepth.Load())
+}
+
+// StackTrace is a compactly stored call stack: only program counters are
+// kept at capture time; symbolization happens lazily in Frames/String.
+//
+// The zero value is an empty trace.
+type StackTrace struct {
+	pcs []uintptr
+}
+
+// IsEmpty reports whether no frames were captured.
+func (s StackTrace) IsEmpty() bool {
+	return len(s.pcs) == 0
+}
+
+// Frames symbolizes the captured program counters, innermost call first.
+func (s StackTrace) Frames() []runtime.Frame {
+	if len(s.pcs) == 0 {
+		return nil
+	}
+	frames := runtime.CallersFrames(s.pcs)
+	out := make([]runtime.Frame, 0, len(s.pcs))
+	for {
+		frame, more := frames.Next()
+		out = append(out, frame)
+		if !more {
+			break
+		}
+	}
+	return out
+}
+
+// String renders the trace in runtime/debug.Stack layout (a header line,
+// then "function()" and "\tfile:line" per frame), so tools that parse Go
+// panic stacks can parse it too. Empty for an empty trace.
+func (s StackTrace) String() string {
+	if s.IsEmpty() {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("error trace:\n")
+	for _, f := range s.Frames() {
+		b.WriteString(f.Function)
+		b.WriteString("()\n\t")
+		b.WriteString(f.File)
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(f.Line))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// captureTrace records up to TraceDepth frames, starting skip frames above
+// the function that called captureTrace. Returns nil when capture is off.
+func captureTrace(skip int) *StackTrace {
+	depth := TraceDepth()
+	if depth == 0 {
+		return nil
+	}
+	pcs := make([]uintptr, depth)
+	n := runtime.Callers(skip+2, pcs)
+	if n == 0 {
+		return nil
+	}
+	return &StackTrace{pcs: pcs[:n]}
+}
+
+// NewInfrastructureErrorTrace creates an infrastructure error and, when
+// capture is enabled with SetTraceDepth, records the caller's stack.
+//
+// Use it in adapters at the point an external failure is first turned into
+// an ErrorType; the trace then travels with the error through Results.
+func NewInfrastructureErrorTrace(message string) ErrorType {
+	return ErrorType{
+		Kind:    InfrastructureError,
+		Message: message,
+		trace:   captureTrace(1),
+	}
+}
+
+// StackTrace returns the trace captured at construction, or an empty trace.
+func (e ErrorType) StackTrace() StackTrace {
+	if e.trace == nil {
+		return StackTrace{}
+	}
+	return *e.trace
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// failingAdapterCall stands in for an adapter creating a traced error.
+func failingAdapterCall() domerr.ErrorType {
+	return domerr.NewInfrastructureErrorTrace("disk full")
+}
+
+// TestDomainErrorTrace tests optional stack trace capture.
+func TestDomainErrorTrace(t *testing.T) {
+	tf := test.New("Domain.Error.Trace")
+	defer domerr.SetTraceDepth(0)
+
+	// ========================================================================
+	// Test: Disabled by default
+	// ========================================================================
+
+	domerr.SetTraceDepth(0)
+	e1 := failingAdapterCall()
+	tf.RunTest("Disabled - kind and message", e1.Kind == domerr.InfrastructureError && e1.Message == "disk full")
+	tf.RunTest("Disabled - empty trace", e1.StackTrace().IsEmpty())
+	tf.RunTest("Disabled - equal to untraced error", e1 == domerr.NewInfrastructureError("disk full"))
+
+	// ========================================================================
+	// Test: Enabled captures the caller
+	// ========================================================================
+
+	domerr.SetTraceDepth(16)
+	e2 := failingAdapterCall()
+	frames := e2.StackTrace().Frames()
+	tf.RunTest("Enabled - trace captured", !e2.StackTrace().IsEmpty())
+	tf.RunTest("Enabled - innermost frame is the caller", len(frames) > 0 &&
+		strings.HasSuffix(frames[0].Function, "failingAdapterCall"))
+	tf.RunTest("Enabled - constructor not in trace",
+		!strings.Contains(e2.StackTrace().String(), "NewInfrastructureErrorTrace"))
+	tf.RunTest("Enabled - debug.Stack layout", strings.HasPrefix(e2.StackTrace().String(), "error trace:\n") &&
+		strings.Contains(e2.StackTrace().String(), "failingAdapterCall()\n\t"))
+
+	// ========================================================================
+	// Test: Depth limit and clamping
+	// ========================================================================
+
+	domerr.SetTraceDepth(1)
+	tf.RunTest("Depth 1 - single frame", len(failingAdapterCall().StackTrace().Frames()) == 1)
+	domerr.SetTraceDepth(-5)
+	tf.RunTest("Negative depth - disabled", domerr.TraceDepth() == 0)
+	domerr.SetTraceDepth(math.MaxInt)
+	tf.RunTest("Huge depth - clamped", domerr.TraceDepth() == domerr.MaxTraceDepth)
+	tf.RunTest("Huge depth - capture works", !failingAdapterCall().StackTrace().IsEmpty())
+
+	// ========================================================================
+	// Test: Trace travels through Result
+	// ========================================================================
+
+	domerr.SetTraceDepth(8)
+	r := domerr.Err[int](failingAdapterCall()).MapError(func(e domerr.ErrorType) domerr.ErrorType { return e })
+	tf.RunTest("Through Result - trace kept", !r.ErrorInfo().StackTrace().IsEmpty())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: assert
+// Description: Debug-build invariant assertions for the domain layer
+
+// Package assert checks domain invariants at construction time in debug
+// builds. Build or test with -tags assert to enable it; without the tag
+// Enabled is false and guarded checks are removed by the compiler, so
+// release builds pay nothing.
+//
+// Assertions state what the code already guarantees (a Person's name is
+// never empty), not what callers must supply: a failed assertion is a bug
+// in the domain, so it panics rather than returning a Result.
+//
+// Architecture Notes:
+//   - Part of the DOMAIN layer, internal to it
+//   - Pure Go - ZERO external module dependencies
+//
+// Usage:
+//
+//	if assert.Enabled {
+//	    assert.That(p.IsValid(), "person name is empty")
+//	}
+package assert
+
+import "fmt"
+
+// failure formats the panic value of a failed assertion.
+func failure(format string, args ...any) string {
+	return "assertion failed: " + fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// recovered runs f and returns what it panicked with, or nil.
+func recovered(f func()) (value any) {
+	defer func() { value = recover() }()
+	f()
+	return nil
+}
+
+// TestDomainAssertThat tests assertions in both build modes.
+func TestDomainAssertThat(t *testing.T) {
+	tf := test.New("Domain.Assert.That")
+
+	// ========================================================================
+	// Test: Holding conditions never panic
+	// ========================================================================
+
+	tf.RunTest("True condition - no panic", recovered(func() { That(true, "unused") }) == nil)
+
+	// ========================================================================
+	// Test: Failed conditions panic only when enabled
+	// ========================================================================
+
+	got := recovered(func() { That(false, "name %q is empty", "") })
+	if Enabled {
+		tf.RunTest("Enabled - panics with message", got == `assertion failed: name "" is empty`)
+	} else {
+		tf.RunTest("Disabled - no panic", got == nil)
+	}
+
+	tf.Summary(t)
+}
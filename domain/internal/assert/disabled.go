@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: assert
+// Description: No-op assertions for release builds
+
+//go:build !assert
+
+package assert
+
+// Enabled reports whether assertions are compiled in.
+const Enabled = false
+
+// That does nothing in release builds. Guard calls with Enabled so the
+// condition is not evaluated either.
+func That(bool, string, ...any) {}
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: assert
+// Description: Assertions for debug builds (-tags assert)
+
+//go:build assert
+
+package assert
+
+// Enabled reports whether assertions are compiled in.
+const Enabled = true
+
+// That panics with the formatted message unless cond holds.
+func That(cond bool, format string, args ...any) {
+	if !cond {
+		panic(failure(format, args...))
+	}
+}
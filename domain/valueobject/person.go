@@ -28,6 +28,7 @@ import (
 	"fmt"
 
 	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/internal/assert"
 )
 
 const (
@@ -83,7 +84,14 @@ func CreatePerson(name string) domerr.Result[Person] {
 	}
 
 	// All validations passed - create the value object
-	return domerr.Ok(Person{name: name})
+	p := Person{name: name}
+	// NFC is not asserted: names are kept exactly as given (see Post above),
+	// and the stdlib-only domain has no normalization tables. Callers that
+	// need NFC apply it before CreatePerson (normalize.Step).
+	if assert.Enabled {
+		assert.That(p.IsValid() && len(p.name) <= MaxNameLength, "invalid person %q", p.name)
+	}
+	return domerr.Ok(p)
 }
 
 // GetName returns the string representation of the person's name.
@@ -103,7 +111,17 @@ func (p Person) GetName() string {
 //   - Post: Result always starts with "Hello, " and ends with "!"
 //   - Post: Result length is always > 9 (len("Hello, !") == 8)
 func (p Person) GreetingMessage() string {
-	return fmt.Sprintf("Hello, %s!", p.name)
+	return p.GreetingWith("Hello")
+}
+
+// GreetingWith generates a greeting using salutation instead of "Hello".
+//
+// Pure domain logic - no side effects.
+//
+// Contract:
+//   - Post: Result is salutation + ", " + name + "!"
+func (p Person) GreetingWith(salutation string) string {
+	return salutation + ", " + p.name + "!"
 }
 
 // IsValid checks if the person satisfies the type invariant.
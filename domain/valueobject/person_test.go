@@ -78,6 +78,8 @@ func TestDomainValueObjectPerson(t *testing.T) {
 			strings.HasSuffix(greeting, "!"))
 		tf.RunTest("GreetingMessage - exact format",
 			greeting == "Hello, Bob!")
+		tf.RunTest("GreetingWith - custom salutation",
+			person.GreetingWith("Good morning") == "Good morning, Bob!")
 	}
 
 	// ========================================================================
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Versioned wrapper for optimistic concurrency control
+
+package valueobject
+
+import (
+	"fmt"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Versioned pairs an entity with the version it was read at, the token for
+// optimistic concurrency: an update succeeds only if the stored version is
+// still the one the caller read.
+//
+// Versions:
+//   - 0 means never stored; the first save stores version 1
+//   - Every successful update stores Next's version (read version + 1)
+//
+// Update operations take a Versioned[T] rather than a bare T, so a caller
+// cannot write without stating which version its change is based on.
+//
+// Usage:
+//
+//	// in a store's Update(ctx, v Versioned[T]):
+//	check := v.Matches(storedVersion)
+//	if check.IsError() {
+//	    return check // Err(StaleVersionError): re-read and retry
+//	}
+//	store(v.Next())
+type Versioned[T any] struct {
+	value   T
+	version uint64
+}
+
+// NewVersioned wraps value as read at version.
+func NewVersioned[T any](value T, version uint64) Versioned[T] {
+	return Versioned[T]{value: value, version: version}
+}
+
+// Value returns the wrapped entity.
+func (v Versioned[T]) Value() T {
+	return v.value
+}
+
+// Version returns the version the entity was read at.
+func (v Versioned[T]) Version() uint64 {
+	return v.version
+}
+
+// IsNew reports whether the entity has never been stored (version 0).
+func (v Versioned[T]) IsNew() bool {
+	return v.version == 0
+}
+
+// WithValue returns the changed entity, still based on the same version.
+func (v Versioned[T]) WithValue(value T) Versioned[T] {
+	return Versioned[T]{value: value, version: v.version}
+}
+
+// Next returns the entity as it is stored by a successful update: same
+// value, version incremented.
+func (v Versioned[T]) Next() Versioned[T] {
+	return Versioned[T]{value: v.value, version: v.version + 1}
+}
+
+// Matches checks v against the currently stored version.
+//
+// Contract:
+//   - Returns Ok(v) when stored equals v's version
+//   - Returns Err(StaleVersionError) otherwise; the entity changed (or was
+//     created) since v was read
+func (v Versioned[T]) Matches(stored uint64) domerr.Result[Versioned[T]] {
+	if stored != v.version {
+		return domerr.Err[Versioned[T]](domerr.NewStaleVersionError(
+			fmt.Sprintf("stale version: read %d, stored %d", v.version, stored)))
+	}
+	return domerr.Ok(v)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// TestDomainValueObjectVersioned tests the Versioned wrapper.
+func TestDomainValueObjectVersioned(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Versioned")
+
+	// ========================================================================
+	// Test: Construction and successors
+	// ========================================================================
+
+	fresh := valueobject.NewVersioned("Alice", 0)
+	tf.RunTest("New - IsNew at version 0", fresh.IsNew())
+	tf.RunTest("New - Value", fresh.Value() == "Alice")
+
+	stored := fresh.Next()
+	tf.RunTest("Next - version incremented", stored.Version() == 1 && !stored.IsNew())
+	tf.RunTest("Next - value kept", stored.Value() == "Alice")
+	tf.RunTest("Next - original unchanged", fresh.Version() == 0)
+
+	changed := stored.WithValue("Bob")
+	tf.RunTest("WithValue - value replaced", changed.Value() == "Bob")
+	tf.RunTest("WithValue - version kept", changed.Version() == 1)
+
+	// ========================================================================
+	// Test: Version checks
+	// ========================================================================
+
+	r1 := changed.Matches(1)
+	tf.RunTest("Matches - same version IsOk", r1.IsOk() && r1.Value().Value() == "Bob")
+
+	r2 := changed.Matches(2)
+	tf.RunTest("Matches - newer stored version is stale",
+		r2.IsError() && r2.ErrorInfo().Kind == domerr.StaleVersionError)
+	tf.RunTest("Matches - message names both versions",
+		r2.IsError() && strings.Contains(r2.ErrorInfo().Message, "read 1, stored 2"))
+
+	r3 := fresh.Matches(1)
+	tf.RunTest("Matches - create over existing entity is stale", r3.IsError())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: AES-GCM CryptoPort adapter with a rotating local keyring
+
+package adapter
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// AESGCMCrypto is a CryptoPort adapter using AES-GCM with keys held in
+// process memory.
+//
+// Ciphertext: Data is a random 96-bit nonce followed by the sealed
+// plaintext and 128-bit tag; KeyID names the key in the keyring.
+//
+// Key rotation: Rotate adds a key and makes it current. New data is
+// encrypted under the current key only; every key in the keyring keeps
+// decrypting, so retire an old key only once nothing encrypted under it
+// remains (re-encrypt by Decrypt + Encrypt). Restarting with the same
+// keyring and current ID is equivalent to the rotations that built it.
+//
+// Limits: random nonces bound one key to about 2^32 encryptions; rotate
+// well before that.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.CryptoPort
+type AESGCMCrypto struct {
+	mu      sync.RWMutex
+	keys    map[string]cipher.AEAD
+	current string
+	cfgErr  error
+}
+
+// NewAESGCMCrypto creates an adapter encrypting under keys[current]; every
+// key decrypts. Keys are 16, 24 or 32 bytes (AES-128/192/256) and are
+// copied, so the caller may zero its slices (e.g. Release the Secrets they
+// came from) afterwards.
+//
+// An invalid keyring is not fatal at construction; every operation then
+// returns Err(InfrastructureError) "... misconfigured: ...".
+//
+// Usage:
+//
+//	crypto := adapter.NewAESGCMCrypto("2025-06", map[string][]byte{
+//	    "2025-01": oldKey, // still decrypts
+//	    "2025-06": newKey, // encrypts
+//	})
+func NewAESGCMCrypto(current string, keys map[string][]byte) *AESGCMCrypto {
+	c := &AESGCMCrypto{keys: make(map[string]cipher.AEAD, len(keys)), current: current}
+	for id, key := range keys {
+		aead, err := newAESGCM(id, key)
+		if err != nil {
+			c.cfgErr = err
+			return c
+		}
+		c.keys[id] = aead
+	}
+	if _, ok := c.keys[current]; !ok {
+		c.cfgErr = fmt.Errorf("current key %q is not in the keyring", current)
+	}
+	return c
+}
+
+func newAESGCM(id string, key []byte) (cipher.AEAD, error) {
+	if id == "" {
+		return nil, errors.New("key ID is empty")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", id, err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// CurrentKeyID returns the ID of the key new data is encrypted under.
+func (c *AESGCMCrypto) CurrentKeyID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.current
+}
+
+// Rotate adds key under id and makes it the current key. IDs are never
+// reused: rebinding one would stop its existing ciphertexts decrypting.
+//
+// Contract:
+//   - Returns Ok(Unit) once new encryptions use id
+//   - Returns Err(ValidationError) for an empty ID, an invalid key size or
+//     an ID already in the keyring
+func (c *AESGCMCrypto) Rotate(id string, key []byte) domerr.Result[model.Unit] {
+	aead, err := newAESGCM(id, key)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewValidationError(
+			fmt.Sprintf("key rotation rejected: %v", err)))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cfgErr != nil {
+		return domerr.Err[model.Unit](apperr.NewValidationError(
+			fmt.Sprintf("key rotation rejected: keyring misconfigured: %v", c.cfgErr)))
+	}
+	if _, exists := c.keys[id]; exists {
+		return domerr.Err[model.Unit](apperr.NewValidationError(
+			fmt.Sprintf("key rotation rejected: key ID %q is already in use", id)))
+	}
+	c.keys[id] = aead
+	c.current = id
+	return domerr.Ok(model.UnitValue)
+}
+
+// Encrypt seals plaintext under the current key.
+func (c *AESGCMCrypto) Encrypt(ctx context.Context, plaintext, associatedData []byte) (result domerr.Result[model.Ciphertext]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Ciphertext](apperr.NewInfrastructureError(
+				fmt.Sprintf("encrypt panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Ciphertext](apperr.NewInfrastructureError(
+			fmt.Sprintf("encrypt cancelled: %v", context.Cause(ctx))))
+	}
+
+	c.mu.RLock()
+	id, aead, cfgErr := c.current, c.keys[c.current], c.cfgErr
+	c.mu.RUnlock()
+	if cfgErr != nil {
+		return domerr.Err[model.Ciphertext](apperr.NewInfrastructureError(
+			fmt.Sprintf("encrypt failed: keyring misconfigured: %v", cfgErr)))
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return domerr.Err[model.Ciphertext](apperr.NewInfrastructureError(
+			fmt.Sprintf("encrypt failed: %v", err)))
+	}
+	return domerr.Ok(model.Ciphertext{KeyID: id, Data: aead.Seal(nonce, nonce, plaintext, associatedData)})
+}
+
+// Decrypt opens ciphertext with the key it names.
+func (c *AESGCMCrypto) Decrypt(ctx context.Context, ciphertext model.Ciphertext, associatedData []byte) (result domerr.Result[[]byte]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[[]byte](apperr.NewInfrastructureError(
+				fmt.Sprintf("decrypt panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[[]byte](apperr.NewInfrastructureError(
+			fmt.Sprintf("decrypt cancelled: %v", context.Cause(ctx))))
+	}
+
+	c.mu.RLock()
+	aead, known := c.keys[ciphertext.KeyID]
+	cfgErr := c.cfgErr
+	c.mu.RUnlock()
+	switch {
+	case cfgErr != nil:
+		return domerr.Err[[]byte](apperr.NewInfrastructureError(
+			fmt.Sprintf("decrypt failed: keyring misconfigured: %v", cfgErr)))
+	case !known:
+		return domerr.Err[[]byte](apperr.NewInfrastructureError(
+			fmt.Sprintf("decrypt failed: unknown key %q", ciphertext.KeyID)))
+	case len(ciphertext.Data) < aead.NonceSize()+aead.Overhead():
+		return domerr.Err[[]byte](apperr.NewInfrastructureError(
+			"decrypt failed: ciphertext too short"))
+	}
+
+	nonce, sealed := ciphertext.Data[:aead.NonceSize()], ciphertext.Data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return domerr.Err[[]byte](apperr.NewInfrastructureError(
+			fmt.Sprintf("decrypt failed: %v (key %q)", err, ciphertext.KeyID)))
+	}
+	return domerr.Ok(plaintext)
+}
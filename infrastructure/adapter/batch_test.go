@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// Compile-time check for the optional batch extension.
+var _ outbound.BatchWriterPort = (*ConsoleWriter)(nil)
+
+// callCountingWriter counts Write calls on the underlying io.Writer.
+type callCountingWriter struct {
+	buf   bytes.Buffer
+	calls int
+	fail  bool
+}
+
+func (w *callCountingWriter) Write(p []byte) (int, error) {
+	w.calls++
+	if w.fail {
+		return 0, errors.New("disk full")
+	}
+	return w.buf.Write(p)
+}
+
+// TestInfrastructureAdapterBatchWrites tests ConsoleWriter.WriteAll.
+func TestInfrastructureAdapterBatchWrites(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.BatchWrites")
+	ctx := context.Background()
+
+	out := &callCountingWriter{}
+	cw := NewWriter(out, WithPrefix("> "))
+	r := cw.WriteAll(ctx, []string{"Hello, Alice!", "Hello, Bob!"})
+	tf.RunTest("WriteAll - IsOk", r.IsOk())
+	tf.RunTest("WriteAll - one underlying write", out.calls == 1)
+	tf.RunTest("WriteAll - lines rendered like Write", out.buf.String() == "> Hello, Alice!\n> Hello, Bob!\n")
+	tf.RunTest("WriteAll - receipt", r.IsOk() && r.Value().Messages == 2 && r.Value().Bytes == out.buf.Len())
+
+	empty := cw.WriteAll(ctx, nil)
+	tf.RunTest("Empty batch - nothing written", empty.IsOk() && empty.Value().Messages == 0 && out.calls == 1)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled - IsError", cw.WriteAll(cancelled, []string{"x"}).IsError())
+
+	tf.RunTest("Write failure - IsError",
+		NewWriter(&callCountingWriter{fail: true}).WriteAll(ctx, []string{"x"}).IsError())
+
+	tf.Summary(t)
+}
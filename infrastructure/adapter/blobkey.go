@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Blob key validation shared by blob storage adapters
+
+package adapter
+
+import (
+	"errors"
+	"strings"
+)
+
+// validateBlobKey enforces the BlobPort key rules: non-empty, '/'-separated,
+// no leading '/', and no empty, "." or ".." segments. The last rule keeps
+// filesystem adapters inside their root.
+func validateBlobKey(key string) error {
+	if key == "" {
+		return errors.New("key is empty")
+	}
+	if strings.ContainsRune(key, '\\') || strings.ContainsRune(key, 0) {
+		return errors.New("key contains a backslash or NUL")
+	}
+	for _, segment := range strings.Split(key, "/") {
+		switch segment {
+		case "":
+			return errors.New("key has an empty segment")
+		case ".", "..":
+			return errors.New("key has a relative segment")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Record/replay of HTTP interactions for hermetic tests
+
+package adapter
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// CassetteMode selects whether a cassette records or replays.
+type CassetteMode int
+
+const (
+	// CassetteReplay answers every request from the cassette file and
+	// never touches the network; a request with no recorded interaction
+	// fails.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends requests to the network and (re)writes the
+	// cassette file with every interaction.
+	CassetteRecord
+)
+
+// cassetteRedacted replaces secrets in recorded interactions.
+const cassetteRedacted = "REDACTED"
+
+// cassetteSecretHeaders are never recorded.
+var cassetteSecretHeaders = []string{
+	"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie",
+	"X-Vault-Token", "X-Sentry-Auth", "X-Amz-Security-Token",
+}
+
+// WithCassette records the adapter's HTTP interactions to the JSON file at
+// path, or replays them from it, so tests of use cases calling external
+// APIs (Slack, SMS, Sentry, Vault, S3) are fast and hermetic. Record once
+// against the real service with CassetteRecord, commit the file (e.g.
+// under testdata/), and run tests with CassetteReplay.
+//
+// Sanitizing: credential headers (Authorization, X-Vault-Token, ...) are
+// never written, and every string in redact is replaced by "REDACTED"
+// wherever it appears, including URLs and bodies. Replay applies the same
+// replacement to requests before matching them. Adapters add their own
+// secrets: SlackWriter its webhook URL, SMSWriter its account SID, and
+// VaultSecrets its token and every value under the response's data, so a
+// replayed VaultSecrets returns "REDACTED" for each field.
+//
+// Matching: by method and URL; repeated requests get the recorded
+// responses in recorded order. Bodies are not compared, since they often
+// carry timestamps or random IDs.
+//
+// Combines with WithHTTPClient (the client's transport is wrapped, the
+// client itself is not modified) and WithTimeout. In replay mode a
+// missing or unreadable cassette makes the adapter misconfigured.
+func WithCassette(path string, mode CassetteMode, redact ...string) HTTPOption {
+	return func(c *httpConfig) {
+		c.cassette = &cassetteConfig{path: path, mode: mode, redact: redact}
+	}
+}
+
+type cassetteConfig struct {
+	path   string
+	mode   CassetteMode
+	redact []string
+}
+
+// cassetteSecrets holds what an adapter registers about its own secrets.
+type cassetteSecrets struct {
+	redact []string
+	scrub  func([]byte) []byte
+}
+
+// redactInCassette registers secrets an adapter sends in URLs or bodies so
+// a cassette never records them. No effect without WithCassette.
+func redactInCassette(secrets ...string) HTTPOption {
+	return func(c *httpConfig) {
+		c.cassetteSecrets.redact = append(c.cassetteSecrets.redact, secrets...)
+	}
+}
+
+// scrubInCassette registers a function that removes secrets from response
+// bodies before they are recorded. No effect without WithCassette.
+func scrubInCassette(scrub func([]byte) []byte) HTTPOption {
+	return func(c *httpConfig) { c.cassetteSecrets.scrub = scrub }
+}
+
+// wrap returns a copy of client whose transport records or replays.
+func (cc *cassetteConfig) wrap(client *http.Client, own cassetteSecrets) (*http.Client, error) {
+	if cc.path == "" {
+		return nil, errors.New("cassette path is empty")
+	}
+	t := &cassetteTransport{path: cc.path, mode: cc.mode, next: client.Transport, scrub: own.scrub}
+	for _, s := range append(slices.Clone(cc.redact), own.redact...) {
+		if s != "" {
+			t.redact = append(t.redact, s)
+		}
+	}
+	if t.next == nil {
+		t.next = http.DefaultTransport
+	}
+	switch cc.mode {
+	case CassetteReplay:
+		raw, err := os.ReadFile(cc.path)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: %w", err)
+		}
+		if err := json.Unmarshal(raw, &t.tape); err != nil {
+			return nil, fmt.Errorf("cassette %s: %w", cc.path, err)
+		}
+		t.used = make([]bool, len(t.tape.Interactions))
+	case CassetteRecord:
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %d", cc.mode)
+	}
+	wrapped := *client
+	wrapped.Transport = t
+	return &wrapped, nil
+}
+
+// cassetteTape is the cassette file format.
+type cassetteTape struct {
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	cassetteBody
+}
+
+type cassetteResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	cassetteBody
+}
+
+// cassetteBody holds a body as text, or base64 when it is not UTF-8.
+type cassetteBody struct {
+	Body       string `json:"body,omitempty"`
+	BodyBase64 string `json:"body_base64,omitempty"`
+}
+
+func newCassetteBody(b []byte, redact func(string) string) cassetteBody {
+	if utf8.Valid(b) {
+		return cassetteBody{Body: redact(string(b))}
+	}
+	return cassetteBody{BodyBase64: base64.StdEncoding.EncodeToString(b)}
+}
+
+func (b cassetteBody) bytes() ([]byte, error) {
+	if b.BodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(b.BodyBase64)
+	}
+	return []byte(b.Body), nil
+}
+
+// cassetteTransport is the RoundTripper behind WithCassette.
+type cassetteTransport struct {
+	path   string
+	mode   CassetteMode
+	redact []string
+	scrub  func([]byte) []byte
+	next   http.RoundTripper
+
+	mu   sync.Mutex
+	tape cassetteTape
+	used []bool
+}
+
+// RoundTrip records or replays one interaction.
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == CassetteReplay {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+func (t *cassetteTransport) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	url := t.redacted(req.URL.String())
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, in := range t.tape.Interactions {
+		if t.used[i] || in.Request.Method != req.Method || in.Request.URL != url {
+			continue
+		}
+		t.used[i] = true
+		body, err := in.Response.bytes()
+		if err != nil {
+			return nil, fmt.Errorf("cassette %s: interaction %d: %w", t.path, i, err)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Headers.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("cassette %s: no recorded interaction for %s %s", t.path, req.Method, url)
+}
+
+func (t *cassetteTransport) record(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	in := cassetteInteraction{
+		Request: cassetteRequest{
+			Method:       req.Method,
+			URL:          t.redacted(req.URL.String()),
+			Headers:      t.headers(req.Header),
+			cassetteBody: newCassetteBody(reqBody, t.redacted),
+		},
+		Response: cassetteResponse{
+			Status:       resp.StatusCode,
+			Headers:      t.headers(resp.Header),
+			cassetteBody: newCassetteBody(t.scrubbed(respBody), t.redacted),
+		},
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tape.Interactions = append(t.tape.Interactions, in)
+	if err := t.save(); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", t.path, err)
+	}
+	return resp, nil
+}
+
+// save rewrites the cassette file atomically.
+func (t *cassetteTransport) save() error {
+	data, err := json.MarshalIndent(t.tape, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(t.path)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(t.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), t.path)
+}
+
+// headers returns h without credential headers and with secrets redacted.
+func (t *cassetteTransport) headers(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range cassetteSecretHeaders {
+		out.Del(name)
+	}
+	for name, values := range out {
+		for i, v := range values {
+			values[i] = t.redacted(v)
+		}
+		out[name] = values
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// scrubbed applies the adapter's scrub function to a response body.
+func (t *cassetteTransport) scrubbed(b []byte) []byte {
+	if t.scrub == nil {
+		return b
+	}
+	return t.scrub(b)
+}
+
+// redacted replaces every redact string in s.
+func (t *cassetteTransport) redacted(s string) string {
+	for _, secret := range t.redact {
+		s = strings.ReplaceAll(s, secret, cassetteRedacted)
+	}
+	return s
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterCassette tests HTTP record/replay.
+func TestInfrastructureAdapterCassette(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Cassette")
+	ctx := context.Background()
+	dir := t.TempDir()
+	vaultTape, slackTape, binTape := filepath.Join(dir, "vault.json"), filepath.Join(dir, "slack.json"), filepath.Join(dir, "bin.json")
+	binary := []byte{0x1f, 0x8b, 0xff, 0x00, 0xfe}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/v1/"):
+			w.Header().Set("Set-Cookie", "session=abc")
+			fmt.Fprint(w, `{"data":{"data":{"password":"vault-pw"}}}`)
+		case r.URL.Path == "/blob":
+			w.Write(binary)
+		default:
+			fmt.Fprint(w, "ok")
+		}
+	}))
+	webhook := srv.URL + "/services/T0/B0/secret-token"
+
+	// ========================================================================
+	// Test: Record against the real service
+	// ========================================================================
+
+	vault := NewVaultSecrets(srv.URL, "vault-token", "secret", WithCassette(vaultTape, CassetteRecord))
+	recorded := vault.Get(ctx, "greeter/smtp/password")
+	tf.RunTest("Record - adapter works", recorded.IsOk() && string(recorded.Value().Bytes()) == "vault-pw")
+	slack := NewSlackWriter(model.NewSecret([]byte(webhook)), WithCassette(slackTape, CassetteRecord, "secret-token"))
+	tf.RunTest("Record - Slack write IsOk", slack.Write(ctx, "Hello, Alice!").IsOk())
+
+	vaultFile, _ := os.ReadFile(vaultTape)
+	slackFile, _ := os.ReadFile(slackTape)
+	tf.RunTest("Record - interaction saved", bytes.Contains(vaultFile, []byte(`"status": 200`)))
+	tf.RunTest("Record - Vault secret values scrubbed", !bytes.Contains(vaultFile, []byte("vault-pw")) &&
+		bytes.Contains(vaultFile, []byte(`\"password\":\"REDACTED\"`)))
+	tf.RunTest("Record - credential header dropped", !bytes.Contains(vaultFile, []byte("vault-token")) &&
+		!bytes.Contains(vaultFile, []byte("X-Vault-Token")))
+	tf.RunTest("Record - Set-Cookie dropped", !bytes.Contains(vaultFile, []byte("session=abc")))
+	tf.RunTest("Record - secret redacted from URL", !bytes.Contains(slackFile, []byte("secret-token")) &&
+		bytes.Contains(slackFile, []byte("/services/T0/B0/REDACTED")))
+	tf.RunTest("Record - request body kept", bytes.Contains(slackFile, []byte("Hello, Alice!")))
+
+	bareTape := filepath.Join(dir, "bare.json")
+	bare := NewSlackWriter(model.NewSecret([]byte(webhook)), WithCassette(bareTape, CassetteRecord))
+	tf.RunTest("Record - Slack without redact IsOk", bare.Write(ctx, "Hello, Alice!").IsOk())
+	bareFile, _ := os.ReadFile(bareTape)
+	tf.RunTest("Record - webhook URL redacted by the adapter", !bytes.Contains(bareFile, []byte("secret-token")) &&
+		bytes.Contains(bareFile, []byte(`"url": "REDACTED"`)))
+
+	smsTape := filepath.Join(dir, "sms.json")
+	sms := NewSMSWriter(srv.URL, "AC-sid-123", model.NewSecret([]byte("tok")), "+15550000001", "+15550000002",
+		WithCassette(smsTape, CassetteRecord))
+	tf.RunTest("Record - SMS write IsOk", sms.Write(ctx, "Hello, Alice!").IsOk())
+	smsFile, _ := os.ReadFile(smsTape)
+	tf.RunTest("Record - account SID redacted by the adapter", !bytes.Contains(smsFile, []byte("AC-sid-123")) &&
+		bytes.Contains(smsFile, []byte("/Accounts/REDACTED/")))
+
+	recorder, _ := newHTTPConfig([]HTTPOption{WithCassette(binTape, CassetteRecord)})
+	resp, err := recorder.client.Get(srv.URL + "/blob")
+	if err == nil {
+		resp.Body.Close()
+	}
+	binFile, _ := os.ReadFile(binTape)
+	tf.RunTest("Record - binary body as base64", err == nil && bytes.Contains(binFile, []byte(`"body_base64"`)))
+	srv.Close()
+
+	// ========================================================================
+	// Test: Replay without the network
+	// ========================================================================
+
+	replayed := NewVaultSecrets(srv.URL, "vault-token", "secret", WithCassette(vaultTape, CassetteReplay)).
+		Get(ctx, "greeter/smtp/password")
+	tf.RunTest("Replay - recorded answer, scrubbed", replayed.IsOk() && string(replayed.Value().Bytes()) == "REDACTED")
+
+	replaySlack := NewSlackWriter(model.NewSecret([]byte(webhook)), WithCassette(slackTape, CassetteReplay, "secret-token"))
+	tf.RunTest("Replay - redacted URL matches", replaySlack.Write(ctx, "Hello, Bob!").IsOk())
+	tf.RunTest("Replay - each interaction used once", replaySlack.Write(ctx, "again").IsError())
+
+	unrecorded := NewVaultSecrets(srv.URL, "vault-token", "secret", WithCassette(vaultTape, CassetteReplay)).
+		Get(ctx, "greeter/kafka/password")
+	tf.RunTest("Replay - unrecorded request fails", unrecorded.IsError() &&
+		strings.Contains(unrecorded.ErrorInfo().Message, "no recorded interaction"))
+
+	player, _ := newHTTPConfig([]HTTPOption{WithCassette(binTape, CassetteReplay)})
+	var body []byte
+	if resp, err := player.client.Get(srv.URL + "/blob"); err == nil {
+		body, _ = io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+	tf.RunTest("Replay - binary body restored", bytes.Equal(body, binary))
+
+	// ========================================================================
+	// Test: Configuration
+	// ========================================================================
+
+	missing := NewVaultSecrets(srv.URL, "t", "secret", WithCassette(filepath.Join(dir, "none.json"), CassetteReplay)).
+		Get(ctx, "a/b")
+	tf.RunTest("Missing cassette - misconfigured", missing.IsError() &&
+		strings.Contains(missing.ErrorInfo().Message, "misconfigured"))
+	_, errPath := newHTTPConfig([]HTTPOption{WithCassette("", CassetteRecord)})
+	tf.RunTest("Empty path - rejected", errPath != nil)
+	_, errMode := newHTTPConfig([]HTTPOption{WithCassette(binTape, CassetteMode(9))})
+	tf.RunTest("Unknown mode - rejected", errMode != nil)
+
+	own := &http.Client{}
+	wrapped, errOwn := newHTTPConfig([]HTTPOption{WithHTTPClient(own), WithCassette(binTape, CassetteReplay)})
+	tf.RunTest("With own client - copy wrapped, original untouched",
+		errOwn == nil && wrapped.client != own && own.Transport == nil)
+
+	tf.Summary(t)
+}
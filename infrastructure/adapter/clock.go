@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: System and frozen clock adapters
+
+package adapter
+
+import (
+	"sync"
+	"time"
+)
+
+// SystemClock is the ClockPort adapter for the real time.
+//
+// Implements: outbound.ClockPort
+type SystemClock struct{}
+
+// NewSystemClock creates a clock reading time.Now.
+func NewSystemClock() SystemClock {
+	return SystemClock{}
+}
+
+// Now returns the current local time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FrozenClock is a ClockPort adapter that always returns the same instant
+// until it is moved with Set or Advance. Use it for deterministic output
+// in tests and golden files.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.ClockPort
+type FrozenClock struct {
+	mu sync.Mutex
+	at time.Time
+}
+
+// NewFrozenClock creates a clock frozen at at.
+//
+// Usage:
+//
+//	clock := adapter.NewFrozenClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+//	writer := adapter.NewWriter(&buf, adapter.WithTimestamp(), adapter.WithConsoleClock(clock))
+func NewFrozenClock(at time.Time) *FrozenClock {
+	return &FrozenClock{at: at}
+}
+
+// Now returns the frozen instant.
+func (c *FrozenClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.at
+}
+
+// Set moves the clock to at.
+func (c *FrozenClock) Set(at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.at = at
+}
+
+// Advance moves the clock forward by d.
+func (c *FrozenClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.at = c.at.Add(d)
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+var (
+	_ outbound.ClockPort = SystemClock{}
+	_ outbound.ClockPort = (*FrozenClock)(nil)
+)
+
+// TestInfrastructureAdapterClock tests the clock adapters and WithClock.
+func TestInfrastructureAdapterClock(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Clock")
+	ctx := context.Background()
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ========================================================================
+	// Test: Clocks
+	// ========================================================================
+
+	before := time.Now()
+	tf.RunTest("SystemClock - current time", !NewSystemClock().Now().Before(before))
+
+	clock := NewFrozenClock(at)
+	tf.RunTest("FrozenClock - frozen", clock.Now().Equal(at) && clock.Now().Equal(at))
+	clock.Advance(time.Minute)
+	tf.RunTest("FrozenClock - Advance", clock.Now().Equal(at.Add(time.Minute)))
+	clock.Set(at)
+	tf.RunTest("FrozenClock - Set", clock.Now().Equal(at))
+
+	// ========================================================================
+	// Test: Adapters stamp output from the clock
+	// ========================================================================
+
+	var buf bytes.Buffer
+	cw := NewWriter(&buf, WithTimestamp(), WithConsoleClock(clock))
+	receipt := cw.WriteWithReceipt(ctx, "Hello!")
+	tf.RunTest("ConsoleWriter - timestamp from clock", buf.String() == "2025-01-01T00:00:00Z Hello!\n")
+	tf.RunTest("ConsoleWriter - receipt from clock", receipt.IsOk() && receipt.Value().WrittenAt.Equal(at))
+
+	tf.Summary(t)
+}
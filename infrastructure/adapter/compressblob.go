@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Transparent compression decorator for blob stores
+
+package adapter
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Codec is a streaming compression format for CompressedBlobStore.
+//
+// Gzip is built in (GzipCodec). Formats outside the standard library, such
+// as zstd, are added by implementing Codec in the composition root around
+// the library of choice (zstd's magic is 28 b5 2f fd).
+type Codec interface {
+	// Name identifies the codec in error messages ("gzip").
+	Name() string
+	// Magic is the byte sequence every compressed stream starts with; Get
+	// uses it to tell compressed objects from uncompressed ones.
+	Magic() []byte
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+// GzipCodec is the gzip (RFC 1952) Codec from compress/gzip.
+type GzipCodec struct {
+	Level int
+}
+
+// Name returns "gzip".
+func (GzipCodec) Name() string { return "gzip" }
+
+// Magic returns the gzip header bytes 1f 8b.
+func (GzipCodec) Magic() []byte { return []byte{0x1f, 0x8b} }
+
+// NewWriter returns a gzip writer at c.Level.
+func (c GzipCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriterLevel(w, c.Level)
+}
+
+// NewReader returns a gzip reader.
+func (GzipCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+// CompressedBlobStore wraps any BlobPort adapter and compresses object
+// bodies on Put and decompresses them on Get, so callers keep reading and
+// writing plain bytes.
+//
+// Streaming: Put compresses through an io.Pipe while the wrapped store
+// consumes it, and Get decompresses as the caller reads; neither holds a
+// whole object in memory. (S3BlobStore spools bodies of unknown length to a
+// temporary file, as for any other stream.)
+//
+// Detection on read: Get decompresses only objects starting with the
+// codec's magic bytes and returns others as stored, so objects written
+// before compression was enabled stay readable. An uncompressed object that
+// happens to start with the magic bytes cannot be told apart; do not mix
+// raw .gz files into a compressed store.
+//
+// List reports stored (compressed) sizes.
+//
+// Implements: outbound.BlobPort
+type CompressedBlobStore[B outbound.BlobPort] struct {
+	inner  B
+	codec  Codec
+	cfgErr error
+}
+
+// NewCompressedBlobStore wraps inner with compression.
+//
+// Options: WithGzipLevel, WithCodec (default: gzip, default level).
+//
+// Usage:
+//
+//	blobs := adapter.NewCompressedBlobStore(adapter.NewFileBlobStore(dir), adapter.WithGzipLevel(gzip.BestSpeed))
+func NewCompressedBlobStore[B outbound.BlobPort](inner B, opts ...CompressionOption) *CompressedBlobStore[B] {
+	cfg, err := newCompressionConfig(opts)
+	return &CompressedBlobStore[B]{inner: inner, codec: cfg.codec, cfgErr: err}
+}
+
+// Put compresses body and stores it under key.
+func (s *CompressedBlobStore[B]) Put(ctx context.Context, key string, body io.Reader) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("compressed blob put panicked: %v", r)))
+		}
+	}()
+
+	if s.cfgErr != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob put %q failed: compression misconfigured: %v", key, s.cfgErr)))
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		zw, err := s.codec.NewWriter(pw)
+		if err == nil {
+			_, err = io.Copy(zw, body)
+			if closeErr := zw.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		pw.CloseWithError(err) // nil: the reader sees EOF
+	}()
+
+	put := s.inner.Put(ctx, key, pr)
+	pr.Close() // unblocks the compressor if Put stopped reading early
+	<-done
+	return put
+}
+
+// Get returns key's body, decompressed if it was stored compressed. The
+// caller must Close the returned body.
+func (s *CompressedBlobStore[B]) Get(ctx context.Context, key string) (result domerr.Result[io.ReadCloser]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+				fmt.Sprintf("compressed blob get panicked: %v", r)))
+		}
+	}()
+
+	if s.cfgErr != nil {
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob get %q failed: compression misconfigured: %v", key, s.cfgErr)))
+	}
+
+	stored := s.inner.Get(ctx, key)
+	if stored.IsError() {
+		return stored
+	}
+	raw := stored.Value()
+
+	magic := s.codec.Magic()
+	buffered := bufio.NewReader(raw)
+	head, err := buffered.Peek(len(magic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		raw.Close()
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob get %q failed: %v", key, err)))
+	}
+	if !bytes.Equal(head, magic) {
+		return domerr.Ok[io.ReadCloser](readCloser{Reader: buffered, closers: []io.Closer{raw}})
+	}
+
+	zr, err := s.codec.NewReader(buffered)
+	if err != nil {
+		raw.Close()
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob get %q failed: %s: %v", key, s.codec.Name(), err)))
+	}
+	return domerr.Ok[io.ReadCloser](readCloser{Reader: zr, closers: []io.Closer{zr, raw}})
+}
+
+// List returns the wrapped store's listing; sizes are compressed sizes.
+func (s *CompressedBlobStore[B]) List(ctx context.Context, prefix string) domerr.Result[[]model.BlobInfo] {
+	return s.inner.List(ctx, prefix)
+}
+
+// Delete removes key from the wrapped store.
+func (s *CompressedBlobStore[B]) Delete(ctx context.Context, key string) domerr.Result[model.Unit] {
+	return s.inner.Delete(ctx, key)
+}
+
+// readCloser reads from Reader and closes every closer, in order.
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r readCloser) Close() error {
+	var errs []error
+	for _, c := range r.closers {
+		errs = append(errs, c.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterCompressedBlob tests transparent blob compression.
+func TestInfrastructureAdapterCompressedBlob(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.CompressedBlob")
+	ctx := context.Background()
+
+	root := t.TempDir()
+	files := NewFileBlobStore(root)
+	blobs := NewCompressedBlobStore(files)
+	read := func(key string) string {
+		r := blobs.Get(ctx, key)
+		if r.IsError() {
+			return "ERR " + r.ErrorInfo().Message
+		}
+		defer r.Value().Close()
+		b, _ := io.ReadAll(r.Value())
+		return string(b)
+	}
+
+	// ========================================================================
+	// Test: Round trip, compressed at rest
+	// ========================================================================
+
+	report := strings.Repeat("Hello, Alice!\n", 10000)
+	tf.RunTest("Put - IsOk", blobs.Put(ctx, "reports/a.txt", streamOnly{strings.NewReader(report)}).IsOk())
+	tf.RunTest("Get - original bytes", read("reports/a.txt") == report)
+
+	stored, err := os.ReadFile(filepath.Join(root, "reports", "a.txt"))
+	tf.RunTest("At rest - gzip magic", err == nil && bytes.HasPrefix(stored, []byte{0x1f, 0x8b}))
+	tf.RunTest("At rest - smaller", len(stored) < len(report)/10)
+
+	infos := blobs.List(ctx, "reports/")
+	tf.RunTest("List - stored size", infos.IsOk() && len(infos.Value()) == 1 && infos.Value()[0].Size == int64(len(stored)))
+
+	// ========================================================================
+	// Test: Uncompressed objects are detected and returned as stored
+	// ========================================================================
+
+	files.Put(ctx, "legacy.txt", strings.NewReader("plain text"))
+	tf.RunTest("Legacy object - read as stored", read("legacy.txt") == "plain text")
+	files.Put(ctx, "tiny", strings.NewReader("x"))
+	tf.RunTest("Object shorter than magic - read as stored", read("tiny") == "x")
+	blobs.Put(ctx, "empty", strings.NewReader(""))
+	tf.RunTest("Empty body - round trip", read("empty") == "")
+
+	// ========================================================================
+	// Test: Failures
+	// ========================================================================
+
+	tf.RunTest("Missing key - not found", strings.Contains(read("nope"), "not found"))
+
+	files.Put(ctx, "corrupt", bytes.NewReader([]byte{0x1f, 0x8b, 0, 0}))
+	tf.RunTest("Corrupt stream - IsError", blobs.Get(ctx, "corrupt").IsError())
+
+	failing := blobs.Put(ctx, "broken", &failAfterReader{data: []byte("partial")})
+	tf.RunTest("Body read error - IsError", failing.IsError())
+	tf.RunTest("Body read error - nothing stored", files.Get(ctx, "broken").IsError())
+
+	tf.RunTest("Invalid gzip level - misconfigured",
+		strings.Contains(NewCompressedBlobStore(files, WithGzipLevel(42)).Put(ctx, "k", strings.NewReader("x")).ErrorInfo().Message, "misconfigured"))
+	tf.RunTest("Nil codec - misconfigured", NewCompressedBlobStore(files, WithCodec(nil)).Get(ctx, "legacy.txt").IsError())
+
+	tf.RunTest("Delete - passes through", blobs.Delete(ctx, "reports/a.txt").IsOk() &&
+		files.Get(ctx, "reports/a.txt").IsError())
+
+	tf.Summary(t)
+}
+
+// failAfterReader returns data, then an error instead of EOF.
+type failAfterReader struct {
+	data []byte
+	done bool
+}
+
+func (r *failAfterReader) Read(p []byte) (int, error) {
+	if r.done {
+		return 0, errors.New("connection reset")
+	}
+	r.done = true
+	return copy(p, r.data), nil
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Console color, terminal detection and line wrapping
+
+package adapter
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ColorMode selects when ConsoleWriter emits ANSI color codes.
+type ColorMode int
+
+const (
+	// ColorNever writes plain text (the default).
+	ColorNever ColorMode = iota
+	// ColorAuto colors only when the output is a terminal, honoring the
+	// NO_COLOR and FORCE_COLOR environment variables.
+	ColorAuto
+	// ColorAlways colors regardless of terminal or environment.
+	ColorAlways
+)
+
+// defaultWrapWidth is used by WithWrap(0) when COLUMNS is unset or invalid.
+const defaultWrapWidth = 80
+
+// ANSI SGR sequences.
+const (
+	ansiGreen = "\x1b[32m"
+	ansiRed   = "\x1b[31m"
+	ansiReset = "\x1b[0m"
+)
+
+// resolveColor decides whether to color output for mode.
+//
+// ColorAuto precedence (https://no-color.org, https://force-color.org):
+//  1. NO_COLOR set and non-empty: off
+//  2. FORCE_COLOR set, non-empty and not "0": on
+//  3. TERM=dumb: off
+//  4. Otherwise on only if out is a character device (a terminal)
+func resolveColor(mode ColorMode, out io.Writer, getenv func(string) string) bool {
+	switch mode {
+	case ColorAlways:
+		return true
+	case ColorAuto:
+		if getenv("NO_COLOR") != "" {
+			return false
+		}
+		if force := getenv("FORCE_COLOR"); force != "" && force != "0" {
+			return true
+		}
+		if getenv("TERM") == "dumb" {
+			return false
+		}
+		return isTerminal(out)
+	}
+	return false
+}
+
+// isTerminal reports whether w is a file attached to a character device.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// resolveWrapWidth returns the wrap width: 0 (off) unless WithWrap was
+// given; WithWrap(0) reads COLUMNS, falling back to defaultWrapWidth.
+func resolveWrapWidth(set bool, width int, getenv func(string) string) int {
+	if !set {
+		return 0
+	}
+	if width > 0 {
+		return width
+	}
+	if cols, err := strconv.Atoi(getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	return defaultWrapWidth
+}
+
+// colorize wraps each line of text in color, so every line resets on its
+// own and a pager or log viewer never sees a dangling color.
+func colorize(color, text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = color + line + ansiReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapText breaks text into lines of at most width columns (DisplayWidth)
+// at spaces. Existing newlines are kept, runs of spaces collapse to one,
+// and a word wider than width is split between characters.
+func wrapText(text string, width int) string {
+	var b strings.Builder
+	for i, para := range strings.Split(text, "\n") {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		col := 0
+		for j, word := range strings.Fields(para) {
+			n := DisplayWidth(word)
+			if j > 0 {
+				if col+1+n <= width {
+					b.WriteByte(' ')
+					col++
+				} else {
+					b.WriteByte('\n')
+					col = 0
+				}
+			}
+			for col == 0 && n > width {
+				// Hard-split a word that cannot fit on any line.
+				cut := widthPrefix(word, width)
+				if cut == len(word) {
+					break // one character wider than the line
+				}
+				b.WriteString(word[:cut])
+				b.WriteByte('\n')
+				word, n = word[cut:], DisplayWidth(word[cut:])
+			}
+			b.WriteString(word)
+			col += n
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterConsoleStyle tests color, terminal detection and wrapping.
+func TestInfrastructureAdapterConsoleStyle(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.ConsoleStyle")
+	ctx := context.Background()
+
+	env := func(vars map[string]string) func(string) string {
+		return func(k string) string { return vars[k] }
+	}
+	var buf bytes.Buffer
+
+	// ========================================================================
+	// Test: Color resolution
+	// ========================================================================
+
+	tf.RunTest("Never - off", !resolveColor(ColorNever, &buf, env(map[string]string{"FORCE_COLOR": "1"})))
+	tf.RunTest("Always - on", resolveColor(ColorAlways, &buf, env(map[string]string{"NO_COLOR": "1"})))
+	tf.RunTest("Auto buffer - off", !resolveColor(ColorAuto, &buf, env(nil)))
+	tf.RunTest("Auto FORCE_COLOR - on", resolveColor(ColorAuto, &buf, env(map[string]string{"FORCE_COLOR": "1"})))
+	tf.RunTest("Auto FORCE_COLOR=0 - off", !resolveColor(ColorAuto, &buf, env(map[string]string{"FORCE_COLOR": "0"})))
+	tf.RunTest("Auto NO_COLOR wins", !resolveColor(ColorAuto, &buf,
+		env(map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "1"})))
+	tf.RunTest("Auto TERM=dumb - off", !resolveColor(ColorAuto, os.Stdout, env(map[string]string{"TERM": "dumb"})))
+
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	tf.RunTest("Regular file - not a terminal", err == nil && !isTerminal(f))
+
+	// ========================================================================
+	// Test: Colored writes
+	// ========================================================================
+
+	var colored bytes.Buffer
+	cw := NewWriter(&colored, WithColor(ColorAlways))
+	cw.Write(ctx, "Hello, Alice!")
+	cw.WriteError(ctx, domerr.NewValidationError("name is empty"))
+	tf.RunTest("Write - green", colored.String() == "\x1b[32mHello, Alice!\x1b[0m\n"+
+		"\x1b[31mValidationError: name is empty\x1b[0m\n")
+
+	var plain bytes.Buffer
+	NewWriter(&plain).WriteError(ctx, domerr.NewInfrastructureError("down"))
+	tf.RunTest("WriteError - plain by default", plain.String() == "InfrastructureError: down\n")
+
+	tf.RunTest("Unknown color mode rejected",
+		NewWriter(&plain, WithColor(ColorMode(9))).Write(ctx, "x").IsError())
+
+	// ========================================================================
+	// Test: Wrapping
+	// ========================================================================
+
+	tf.RunTest("wrapText - breaks at spaces", wrapText("the quick brown fox", 10) == "the quick\nbrown fox")
+	tf.RunTest("wrapText - splits long word", wrapText("abcdefghij xy", 4) == "abcd\nefgh\nij\nxy")
+	tf.RunTest("wrapText - counts runes", wrapText("héllo wörld", 5) == "héllo\nwörld")
+	tf.RunTest("wrapText - keeps newlines", wrapText("a b\nc d", 80) == "a b\nc d")
+
+	tf.RunTest("Wrap width - unset is off", resolveWrapWidth(false, 0, env(nil)) == 0)
+	tf.RunTest("Wrap width - COLUMNS", resolveWrapWidth(true, 0, env(map[string]string{"COLUMNS": "42"})) == 42)
+	tf.RunTest("Wrap width - default 80", resolveWrapWidth(true, 0, env(nil)) == 80)
+
+	var wrapped bytes.Buffer
+	NewWriter(&wrapped, WithWrap(12), WithColor(ColorAlways)).Write(ctx, "Hello, Alice and Bob!")
+	tf.RunTest("Write - wrapped and colored per line", wrapped.String() ==
+		"\x1b[32mHello, Alice\x1b[0m\n\x1b[32mand Bob!\x1b[0m\n")
+	tf.RunTest("Negative wrap rejected", NewWriter(&plain, WithWrap(-1)).Write(ctx, "x").IsError())
+
+	tf.Summary(t)
+}
@@ -55,9 +55,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
 	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
 	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
 	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
 )
 
@@ -76,9 +80,21 @@ import (
 //   - Converts I/O errors and panics to Result types
 //   - Handles context cancellation
 //
+// Concurrency:
+//   - Safe for concurrent use; each message is written whole, so lines from
+//     concurrent Writes never interleave
+//
 // Implements: outbound.WriterPort
 type ConsoleWriter struct {
-	w io.Writer
+	mu sync.Mutex
+	w  io.Writer
+
+	layout    string // timestamp layout; "" means no timestamp
+	prefix    string
+	color     bool // resolved from ColorMode at construction
+	wrap      int  // wrap width in display columns; 0 means no wrapping
+	clock     outbound.ClockPort
+	configErr error
 }
 
 // NewWriter creates a ConsoleWriter that writes to the provided io.Writer.
@@ -117,8 +133,25 @@ type ConsoleWriter struct {
 //	defer file.Close()
 //	writer := NewWriter(file)
 //	result := writer.Write(ctx, "Hello!")
-func NewWriter(w io.Writer) *ConsoleWriter {
-	return &ConsoleWriter{w: w}
+//
+// Example - Options (see ConsoleOption):
+//
+//	writer := NewWriter(os.Stdout, WithTimestamp(), WithPrefix("greeter: "))
+//	// 2025-06-01T12:00:00Z greeter: Hello!
+func NewWriter(w io.Writer, opts ...ConsoleOption) *ConsoleWriter {
+	cfg := consoleConfig{out: w, layout: time.RFC3339, clock: NewSystemClock()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	cw := &ConsoleWriter{w: cfg.out, prefix: cfg.prefix, clock: cfg.clock, configErr: cfg.validate()}
+	if cfg.timestamp {
+		cw.layout = cfg.layout
+	}
+	if cw.configErr == nil {
+		cw.color = resolveColor(cfg.color, cfg.out, os.Getenv)
+		cw.wrap = resolveWrapWidth(cfg.wrapSet, cfg.wrap, os.Getenv)
+	}
+	return cw
 }
 
 // Write writes the message to the underlying io.Writer.
@@ -148,41 +181,150 @@ func NewWriter(w io.Writer) *ConsoleWriter {
 //   - Returns Ok(Unit) on success
 //   - Returns Err(InfrastructureError) on I/O failure, panic, or cancellation
 //   - Never panics (panics are caught and converted to Err)
-func (cw *ConsoleWriter) Write(ctx context.Context, message string) (result domerr.Result[model.Unit]) {
-	// Recover from any panics and convert to InfrastructureError
+//   - Rendered in green when color is enabled (see WithColor)
+func (cw *ConsoleWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if _, err := cw.emit(ctx, ansiGreen, message); err != nil {
+		return writeFailed[model.Unit](err)
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// WriteWithReceipt writes message like Write and returns the bytes written
+// and the destination ("stdout", "stderr", "file:<name>", or "" for other
+// io.Writers).
+//
+// Implements: outbound.ReceiptWriterPort
+func (cw *ConsoleWriter) WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt] {
+	n, err := cw.emit(ctx, ansiGreen, message)
+	if err != nil {
+		return writeFailed[model.WriteReceipt](err)
+	}
+	return domerr.Ok(model.WriteReceipt{Bytes: n, Destination: describeDestination(cw.w), WrittenAt: cw.clock.Now()})
+}
+
+// WriteError writes err (kind and message), rendered in red when color is
+// enabled. Same contract as Write.
+func (cw *ConsoleWriter) WriteError(ctx context.Context, err domerr.ErrorType) domerr.Result[model.Unit] {
+	if _, failed := cw.emit(ctx, ansiRed, err.Error()); failed != nil {
+		return writeFailed[model.Unit](failed)
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// writeFailed converts an emit failure to an InfrastructureError.
+func writeFailed[T any](err error) domerr.Result[T] {
+	return domerr.Err[T](apperr.NewInfrastructureError(err.Error()))
+}
+
+// emit formats and writes one message, returning the bytes written; color
+// is used only if enabled. Failures are returned as plain errors carrying
+// the final message (see writeFailed).
+func (cw *ConsoleWriter) emit(ctx context.Context, color, message string) (n int, err error) {
+	// Recover from any panics and convert to an error
 	// This ensures NO panics escape across the infrastructure boundary
 	// Pattern: Infrastructure adapters are the "exception boundary" where
 	// all panics/exceptions must be caught and converted to Result errors
 	defer func() {
 		if r := recover(); r != nil {
-			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
-				fmt.Sprintf("write panicked: %v", r)))
+			err = fmt.Errorf("write panicked: %v", r)
 		}
 	}()
 
 	// Check for context cancellation before I/O
 	// This is important for long-running operations or network writers
-	select {
-	case <-ctx.Done():
-		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
-			fmt.Sprintf("write cancelled: %v", ctx.Err())))
-	default:
-		// Context is still active, proceed with I/O
+	if ctx.Err() != nil {
+		return 0, fmt.Errorf("write cancelled: %v", context.Cause(ctx))
+	}
+
+	if cw.configErr != nil {
+		return 0, fmt.Errorf("write failed: console writer misconfigured: %v", cw.configErr)
 	}
 
 	// Perform the I/O operation using the injected writer
-	// fmt.Fprintln handles the newline and returns any write errors
-	_, err := fmt.Fprintln(cw.w, message)
+	// The mutex keeps concurrent messages whole on the shared io.Writer
+	line := cw.render(color, message)
+	cw.mu.Lock()
+	n, err = io.WriteString(cw.w, line+"\n")
+	cw.mu.Unlock()
 	if err != nil {
-		// Map the I/O error to a domain InfrastructureError
-		// This keeps infrastructure concerns (specific error types)
-		// from leaking into application/domain layers
-		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
-			fmt.Sprintf("write failed: %v", err)))
+		// Map the I/O error to a message only; writeFailed makes it an
+		// InfrastructureError, keeping specific error types from leaking
+		// into application/domain layers
+		return 0, fmt.Errorf("write failed: %v", err)
 	}
+	return n, nil
+}
 
-	// Success case - return Unit to indicate completion
-	return domerr.Ok(model.UnitValue)
+// WriteAll writes every message like Write, in order, with a single write
+// to the underlying io.Writer (one syscall for files and terminals).
+// Concurrent writes never interleave with the batch.
+//
+// Implements: outbound.BatchWriterPort
+func (cw *ConsoleWriter) WriteAll(ctx context.Context, messages []string) (result domerr.Result[model.BatchReceipt]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.BatchReceipt](apperr.NewInfrastructureError(
+				fmt.Sprintf("write panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.BatchReceipt](apperr.NewInfrastructureError(
+			fmt.Sprintf("write cancelled: %v", context.Cause(ctx))))
+	}
+	if cw.configErr != nil {
+		return domerr.Err[model.BatchReceipt](apperr.NewInfrastructureError(
+			fmt.Sprintf("write failed: console writer misconfigured: %v", cw.configErr)))
+	}
+
+	var batch strings.Builder
+	for _, message := range messages {
+		batch.WriteString(cw.render(ansiGreen, message))
+		batch.WriteByte('\n')
+	}
+	n := 0
+	if batch.Len() > 0 {
+		var err error
+		cw.mu.Lock()
+		n, err = io.WriteString(cw.w, batch.String())
+		cw.mu.Unlock()
+		if err != nil {
+			return domerr.Err[model.BatchReceipt](apperr.NewInfrastructureError(
+				fmt.Sprintf("write failed: %v", err)))
+		}
+	}
+	return domerr.Ok(model.BatchReceipt{
+		Messages: len(messages), Bytes: n, Destination: describeDestination(cw.w), WrittenAt: cw.clock.Now(),
+	})
+}
+
+// render applies the timestamp, prefix, wrapping and color to one message.
+func (cw *ConsoleWriter) render(color, message string) string {
+	line := cw.prefix + message
+	if cw.layout != "" {
+		line = cw.clock.Now().Format(cw.layout) + " " + line
+	}
+	if cw.wrap > 0 {
+		line = wrapText(line, cw.wrap)
+	}
+	if cw.color {
+		line = colorize(color, line)
+	}
+	return line
+}
+
+// describeDestination names w for receipts.
+func describeDestination(w io.Writer) string {
+	switch w {
+	case os.Stdout:
+		return "stdout"
+	case os.Stderr:
+		return "stderr"
+	}
+	if f, ok := w.(*os.File); ok {
+		return "file:" + f.Name()
+	}
+	return ""
 }
 
 // NewConsoleWriter creates a ConsoleWriter that writes to standard output.
@@ -200,8 +342,8 @@ func (cw *ConsoleWriter) Write(ctx context.Context, message string) (result dome
 //
 //	writer := adapter.NewConsoleWriter()
 //	result := writer.Write(ctx, "Hello, World!")
-func NewConsoleWriter() *ConsoleWriter {
-	return NewWriter(os.Stdout)
+func NewConsoleWriter(opts ...ConsoleOption) *ConsoleWriter {
+	return NewWriter(os.Stdout, opts...)
 }
 
 // NewStderrWriter creates a ConsoleWriter that writes to standard error.
@@ -212,6 +354,6 @@ func NewConsoleWriter() *ConsoleWriter {
 //
 //	errWriter := adapter.NewStderrWriter()
 //	result := errWriter.Write(ctx, "Error: something went wrong")
-func NewStderrWriter() *ConsoleWriter {
-	return NewWriter(os.Stderr)
+func NewStderrWriter(opts ...ConsoleOption) *ConsoleWriter {
+	return NewWriter(os.Stderr, opts...)
 }
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/porttest"
+)
+
+// TestInfrastructureAdapterConsoleWriterContract runs the WriterPort
+// conformance suite against ConsoleWriter.
+func TestInfrastructureAdapterConsoleWriterContract(t *testing.T) {
+	porttest.TestWriterPortContract(t, func(*testing.T) outbound.WriterPort {
+		return NewWriter(io.Discard)
+	})
+}
+
+// TestInfrastructureAdapterNotificationWriterContract runs the WriterPort
+// conformance suite against NotificationWriter, using the console fallback
+// (no notification tool) so the test is hermetic.
+func TestInfrastructureAdapterNotificationWriterContract(t *testing.T) {
+	porttest.TestWriterPortContract(t, func(*testing.T) outbound.WriterPort {
+		nw, _ := newTestNotificationWriter("linux", false, nil, &bytes.Buffer{})
+		return nw
+	})
+}
+
+// TestInfrastructureAdapterMemoryContracts runs the CachePort, LockPort and
+// ChangeDetectorPort conformance suites against the in-memory adapters.
+func TestInfrastructureAdapterMemoryContracts(t *testing.T) {
+	porttest.TestCachePortContract(t, func(*testing.T) outbound.CachePort {
+		return NewMemoryCache()
+	})
+	porttest.TestLockPortContract(t, func(*testing.T) outbound.LockPort {
+		return NewMemoryLock()
+	})
+	porttest.TestChangeDetectorPortContract(t, func(*testing.T) outbound.ChangeDetectorPort {
+		return NewMemoryChangeDetector()
+	})
+}
+
+// TestInfrastructureAdapterIDGeneratorContracts runs the IDGeneratorPort
+// conformance suite against every generator.
+func TestInfrastructureAdapterIDGeneratorContracts(t *testing.T) {
+	porttest.TestIDGeneratorPortContract(t, func(*testing.T) outbound.IDGeneratorPort {
+		return NewUUIDv7Generator()
+	})
+	porttest.TestIDGeneratorPortContract(t, func(*testing.T) outbound.IDGeneratorPort {
+		return NewULIDGenerator()
+	})
+	porttest.TestIDGeneratorPortContract(t, func(*testing.T) outbound.IDGeneratorPort {
+		return NewSequentialIDGenerator("id-")
+	})
+}
+
+// TestInfrastructureAdapterSecretContracts runs the SecretPort conformance
+// suite against the environment and Vault adapters.
+func TestInfrastructureAdapterSecretContracts(t *testing.T) {
+	porttest.TestSecretPortContract(t, func(_ *testing.T, secrets map[string]string) outbound.SecretPort {
+		env := NewEnvSecrets("CONTRACT_")
+		vars := map[string]string{}
+		for k, v := range secrets {
+			vars[env.VariableName(k)] = v
+		}
+		env.lookup = func(name string) (string, bool) {
+			v, ok := vars[name]
+			return v, ok
+		}
+		return env
+	})
+
+	porttest.TestSecretPortContract(t, func(t *testing.T, secrets map[string]string) outbound.SecretPort {
+		// Group "<path>/<field>" keys into KV v2 documents by path.
+		docs := map[string]map[string]string{}
+		for k, v := range secrets {
+			slash := strings.LastIndex(k, "/")
+			path, field := k[:slash], k[slash+1:]
+			if docs[path] == nil {
+				docs[path] = map[string]string{}
+			}
+			docs[path][field] = v
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			doc, ok := docs[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": doc}})
+		}))
+		t.Cleanup(srv.Close)
+		return NewVaultSecrets(srv.URL, "tok", "secret")
+	})
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Static deny-list content policy adapter
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// DenyListPolicy is a content policy adapter backed by a fixed word list.
+//
+// Matching:
+//   - Case-insensitive, on whole words (runs of letters and digits), so a
+//     denied "ass" does not match "Cassandra"
+//   - In mask mode each denied word is replaced by '*' per rune, keeping
+//     the first rune ("Bad Name" -> "B** Name")
+//   - In reject mode any denied word rejects the whole text
+//
+// Concurrency: safe for concurrent use (immutable after construction).
+//
+// Implements: outbound.ContentPolicyPort
+type DenyListPolicy struct {
+	denied map[string]struct{}
+	mask   bool
+}
+
+// NewDenyListPolicy creates a deny-list policy. When mask is true denied
+// words are masked; otherwise the text is rejected.
+//
+// Usage:
+//
+//	policy := adapter.NewDenyListPolicy([]string{"badword"}, false)
+//	result := policy.Check(ctx, "Alice")
+func NewDenyListPolicy(words []string, mask bool) *DenyListPolicy {
+	denied := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			denied[w] = struct{}{}
+		}
+	}
+	return &DenyListPolicy{denied: denied, mask: mask}
+}
+
+// Check evaluates text against the deny-list.
+//
+// Contract:
+//   - Returns Ok(PolicyAllow) if no word is denied
+//   - Returns Ok(PolicyMask) or Ok(PolicyReject) depending on mode otherwise
+//   - Returns Err(InfrastructureError) only if ctx is cancelled
+func (p *DenyListPolicy) Check(ctx context.Context, text string) (result domerr.Result[model.PolicyDecision]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.PolicyDecision](apperr.NewInfrastructureError(
+				fmt.Sprintf("deny-list check panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.PolicyDecision](apperr.NewInfrastructureError(
+			fmt.Sprintf("deny-list check cancelled: %v", context.Cause(ctx))))
+	}
+
+	runes := []rune(text)
+	found := false
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		if _, denied := p.denied[strings.ToLower(string(runes[start:end]))]; denied {
+			found = true
+			if !p.mask {
+				break
+			}
+			for i := start + 1; i < end; i++ {
+				runes[i] = '*'
+			}
+		}
+		start = end
+	}
+
+	switch {
+	case !found:
+		return domerr.Ok(model.PolicyDecision{Action: model.PolicyAllow, Text: text})
+	case p.mask:
+		return domerr.Ok(model.PolicyDecision{
+			Action: model.PolicyMask, Text: string(runes), Reason: "disallowed words masked"})
+	default:
+		return domerr.Ok(model.PolicyDecision{
+			Action: model.PolicyReject, Text: text, Reason: "name contains disallowed words"})
+	}
+}
+
+// isWordRune reports whether r is part of a word for matching purposes.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterDenyListPolicy tests the static deny-list policy.
+func TestInfrastructureAdapterDenyListPolicy(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.DenyListPolicy")
+	ctx := context.Background()
+	words := []string{"Bad", " worse ", ""}
+
+	reject := NewDenyListPolicy(words, false)
+	r1 := reject.Check(ctx, "Alice")
+	tf.RunTest("Clean text - Allow", r1.IsOk() && r1.Value().Action == model.PolicyAllow)
+	tf.RunTest("Clean text - Text unchanged", r1.Value().Text == "Alice")
+
+	r2 := reject.Check(ctx, "Alice BAD")
+	tf.RunTest("Denied word - Reject", r2.IsOk() && r2.Value().Action == model.PolicyReject)
+	tf.RunTest("Denied word - has reason", r2.Value().Reason != "")
+
+	r3 := reject.Check(ctx, "Badminton")
+	tf.RunTest("Substring only - Allow", r3.Value().Action == model.PolicyAllow)
+
+	mask := NewDenyListPolicy(words, true)
+	r4 := mask.Check(ctx, "bad-Worse Zoë")
+	tf.RunTest("Mask mode - Mask", r4.IsOk() && r4.Value().Action == model.PolicyMask)
+	tf.RunTest("Mask mode - words masked", r4.Value().Text == "b**-W**** Zoë")
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled - IsError", mask.Check(cancelled, "x").IsError())
+
+	tf.Summary(t)
+}
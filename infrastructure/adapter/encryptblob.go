@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Envelope-encryption decorator for blob stores
+
+package adapter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// Encrypted object layout constants.
+const (
+	encryptedBlobMagic     = "HLE1"
+	encryptedBlobChunk     = 64 << 10 // plaintext bytes per sealed chunk
+	encryptedBlobMaxHeader = 4 << 10
+)
+
+// encryptedBlobHeader is the envelope metadata stored in front of the body.
+type encryptedBlobHeader struct {
+	Alg string           `json:"alg"`
+	DEK model.Ciphertext `json:"dek"` // data key, wrapped by CryptoPort
+}
+
+// encryptedBlobAlg names the body encryption in the header.
+const encryptedBlobAlg = "AES-256-GCM-CHUNKED-64K"
+
+// EncryptedBlobStore wraps any BlobPort adapter and encrypts object bodies
+// at rest with envelope encryption: each object gets a fresh 256-bit data
+// key, the body is encrypted with it, and the data key is itself encrypted
+// ("wrapped") through CryptoPort and stored alongside.
+//
+// Stored layout:
+//
+//	"HLE1" | uint16 header length | header JSON | sealed chunks
+//	header: {"alg": "AES-256-GCM-CHUNKED-64K", "dek": {"kid": ..., "data": ...}}
+//
+// The body is sealed in 64 KiB AES-GCM chunks whose nonce holds the chunk
+// number and a final-chunk flag, so chunks cannot be reordered, dropped or
+// truncated unnoticed. The wrapped data key is bound to the blob key as
+// associated data: an object copied or renamed to another key no longer
+// decrypts (re-encrypt it through the store instead).
+//
+// Key rotation: only the small wrapped data key depends on the CryptoPort
+// key (its ID is in the header), so old objects decrypt for as long as the
+// CryptoPort holds their key; re-Put an object to move it to the current key.
+//
+// Streaming: Put encrypts through an io.Pipe and Get decrypts as the caller
+// reads; memory use is one chunk, not one object. Get returns an error from
+// Read, not from Get, when a later chunk fails authentication: consume the
+// whole body before trusting it.
+//
+// Objects without the "HLE1" header are rejected, not returned as stored:
+// accepting plaintext would let anyone with write access to the store
+// replace encrypted objects.
+//
+// List reports stored (encrypted) sizes.
+//
+// Implements: outbound.BlobPort
+type EncryptedBlobStore[B outbound.BlobPort, C outbound.CryptoPort] struct {
+	inner  B
+	crypto C
+}
+
+// NewEncryptedBlobStore wraps inner with envelope encryption under crypto.
+//
+// Usage:
+//
+//	keys := adapter.NewAESGCMCrypto("2025-06", keyring)
+//	blobs := adapter.NewEncryptedBlobStore(adapter.NewFileBlobStore(dir), keys)
+func NewEncryptedBlobStore[B outbound.BlobPort, C outbound.CryptoPort](inner B, crypto C) *EncryptedBlobStore[B, C] {
+	return &EncryptedBlobStore[B, C]{inner: inner, crypto: crypto}
+}
+
+// Put encrypts body and stores it under key.
+func (s *EncryptedBlobStore[B, C]) Put(ctx context.Context, key string, body io.Reader) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("encrypted blob put panicked: %v", r)))
+		}
+	}()
+
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob put %q failed: %v", key, err)))
+	}
+	defer clear(dek)
+
+	wrapped := s.crypto.Encrypt(ctx, dek, encryptedBlobAAD(key))
+	if wrapped.IsError() {
+		return domerr.Err[model.Unit](wrapped.ErrorInfo())
+	}
+	header, err := encodeEncryptedBlobHeader(encryptedBlobHeader{Alg: encryptedBlobAlg, DEK: wrapped.Value()})
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob put %q failed: %v", key, err)))
+	}
+	aead, err := newChunkAEAD(dek)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob put %q failed: %v", key, err)))
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := pw.Write(header)
+		if err == nil {
+			err = sealChunks(pw, body, aead)
+		}
+		pw.CloseWithError(err) // nil: the reader sees EOF
+	}()
+
+	put := s.inner.Put(ctx, key, pr)
+	pr.Close() // unblocks the encryptor if Put stopped reading early
+	<-done
+	return put
+}
+
+// Get returns key's decrypted body. The caller must Close it.
+func (s *EncryptedBlobStore[B, C]) Get(ctx context.Context, key string) (result domerr.Result[io.ReadCloser]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+				fmt.Sprintf("encrypted blob get panicked: %v", r)))
+		}
+	}()
+
+	stored := s.inner.Get(ctx, key)
+	if stored.IsError() {
+		return stored
+	}
+	raw := stored.Value()
+	fail := func(err error) domerr.Result[io.ReadCloser] {
+		raw.Close()
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob get %q failed: %v", key, err)))
+	}
+
+	buffered := bufio.NewReaderSize(raw, encryptedBlobChunk+64)
+	header, err := decodeEncryptedBlobHeader(buffered)
+	if err != nil {
+		return fail(err)
+	}
+	dek := s.crypto.Decrypt(ctx, header.DEK, encryptedBlobAAD(key))
+	if dek.IsError() {
+		raw.Close()
+		return domerr.Err[io.ReadCloser](dek.ErrorInfo())
+	}
+	aead, err := newChunkAEAD(dek.Value())
+	clear(dek.Value())
+	if err != nil {
+		return fail(err)
+	}
+	return domerr.Ok[io.ReadCloser](readCloser{
+		Reader:  &chunkOpener{src: buffered, aead: aead, sealed: make([]byte, encryptedBlobChunk+aead.Overhead())},
+		closers: []io.Closer{raw},
+	})
+}
+
+// List returns the wrapped store's listing; sizes are encrypted sizes.
+func (s *EncryptedBlobStore[B, C]) List(ctx context.Context, prefix string) domerr.Result[[]model.BlobInfo] {
+	return s.inner.List(ctx, prefix)
+}
+
+// Delete removes key from the wrapped store.
+func (s *EncryptedBlobStore[B, C]) Delete(ctx context.Context, key string) domerr.Result[model.Unit] {
+	return s.inner.Delete(ctx, key)
+}
+
+// encryptedBlobAAD binds a wrapped data key to its blob key.
+func encryptedBlobAAD(key string) []byte {
+	return []byte("hybrid_lib_go/blob:" + key)
+}
+
+func encodeEncryptedBlobHeader(h encryptedBlobHeader) ([]byte, error) {
+	encoded, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	if len(encoded) > encryptedBlobMaxHeader {
+		return nil, fmt.Errorf("encryption header too large (%d bytes)", len(encoded))
+	}
+	out := make([]byte, 0, len(encryptedBlobMagic)+2+len(encoded))
+	out = append(out, encryptedBlobMagic...)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(encoded)))
+	return append(out, encoded...), nil
+}
+
+func decodeEncryptedBlobHeader(r io.Reader) (encryptedBlobHeader, error) {
+	var h encryptedBlobHeader
+	prefix := make([]byte, len(encryptedBlobMagic)+2)
+	if _, err := io.ReadFull(r, prefix); err != nil || !bytes.HasPrefix(prefix, []byte(encryptedBlobMagic)) {
+		return h, errors.New("object is not encrypted")
+	}
+	size := int(binary.BigEndian.Uint16(prefix[len(encryptedBlobMagic):]))
+	if size > encryptedBlobMaxHeader {
+		return h, fmt.Errorf("encryption header too large (%d bytes)", size)
+	}
+	encoded := make([]byte, size)
+	if _, err := io.ReadFull(r, encoded); err != nil {
+		return h, fmt.Errorf("encryption header truncated: %w", err)
+	}
+	if err := json.Unmarshal(encoded, &h); err != nil {
+		return h, fmt.Errorf("encryption header malformed: %w", err)
+	}
+	if h.Alg != encryptedBlobAlg {
+		return h, fmt.Errorf("unsupported encryption %q", h.Alg)
+	}
+	return h, nil
+}
+
+func newChunkAEAD(dek []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dek)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce is the nonce of chunk n: the big-endian chunk number, then a
+// byte that is 1 for the last chunk. Data keys are never reused, so a
+// counter nonce is unique.
+func chunkNonce(n uint64, final bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[3:11], n)
+	if final {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// sealChunks encrypts body into w. The last chunk is always written, even
+// when empty, so a reader can tell a complete body from a truncated one.
+func sealChunks(w io.Writer, body io.Reader, aead cipher.AEAD) error {
+	src := bufio.NewReaderSize(body, encryptedBlobChunk)
+	plain := make([]byte, encryptedBlobChunk)
+	sealed := make([]byte, 0, encryptedBlobChunk+aead.Overhead())
+	for n := uint64(0); ; n++ {
+		read, err := io.ReadFull(src, plain)
+		final := false
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			final = true
+		case err != nil:
+			return err
+		default:
+			if _, peekErr := src.Peek(1); errors.Is(peekErr, io.EOF) {
+				final = true
+			} else if peekErr != nil {
+				return peekErr
+			}
+		}
+		sealed = aead.Seal(sealed[:0], chunkNonce(n, final), plain[:read], nil)
+		if _, err := w.Write(sealed); err != nil {
+			return err
+		}
+		if final {
+			return nil
+		}
+	}
+}
+
+// chunkOpener decrypts sealed chunks as they are read.
+type chunkOpener struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	sealed  []byte
+	plain   []byte
+	pending []byte
+	next    uint64
+	done    bool
+	err     error
+}
+
+func (c *chunkOpener) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		if c.done {
+			return 0, io.EOF
+		}
+		c.err = c.openNext()
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+// openNext decrypts the next chunk into pending.
+func (c *chunkOpener) openNext() error {
+	read, err := io.ReadFull(c.src, c.sealed)
+	final := false
+	switch {
+	case errors.Is(err, io.EOF):
+		return errors.New("encrypted object truncated")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		final = true
+	case err != nil:
+		return err
+	default:
+		if _, peekErr := c.src.Peek(1); errors.Is(peekErr, io.EOF) {
+			final = true
+		} else if peekErr != nil {
+			return peekErr
+		}
+	}
+	plain, err := c.aead.Open(c.plain[:0], chunkNonce(c.next, final), c.sealed[:read], nil)
+	if err != nil {
+		return fmt.Errorf("encrypted object chunk %d: %w", c.next, err)
+	}
+	c.plain, c.pending = plain, plain
+	c.next++
+	c.done = final
+	return nil
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterAESGCMCrypto tests the AES-GCM keyring adapter.
+func TestInfrastructureAdapterAESGCMCrypto(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.AESGCMCrypto")
+	ctx := context.Background()
+	key1, key2 := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 16)
+	aad := []byte("record:42")
+
+	// ========================================================================
+	// Test: Round trip and authentication
+	// ========================================================================
+
+	crypto := NewAESGCMCrypto("k1", map[string][]byte{"k1": key1})
+	sealed := crypto.Encrypt(ctx, []byte("Alice"), aad)
+	tf.RunTest("Encrypt - IsOk, tagged with current key", sealed.IsOk() && sealed.Value().KeyID == "k1")
+	tf.RunTest("Encrypt - plaintext not visible",
+		sealed.IsOk() && !bytes.Contains(sealed.Value().Data, []byte("Alice")))
+	again := crypto.Encrypt(ctx, []byte("Alice"), aad)
+	tf.RunTest("Encrypt - random nonce, distinct ciphertexts",
+		again.IsOk() && !bytes.Equal(again.Value().Data, sealed.Value().Data))
+
+	opened := crypto.Decrypt(ctx, sealed.Value(), aad)
+	tf.RunTest("Decrypt - original plaintext", opened.IsOk() && string(opened.Value()) == "Alice")
+	tf.RunTest("Decrypt - wrong associated data fails",
+		crypto.Decrypt(ctx, sealed.Value(), []byte("record:43")).IsError())
+
+	tampered := model.Ciphertext{KeyID: "k1", Data: bytes.Clone(sealed.Value().Data)}
+	tampered.Data[len(tampered.Data)-1] ^= 1
+	tf.RunTest("Decrypt - tampered data fails", crypto.Decrypt(ctx, tampered, aad).IsError())
+	tf.RunTest("Decrypt - short data fails",
+		crypto.Decrypt(ctx, model.Ciphertext{KeyID: "k1", Data: []byte{1, 2}}, aad).IsError())
+
+	// ========================================================================
+	// Test: Key rotation
+	// ========================================================================
+
+	tf.RunTest("Rotate - IsOk", crypto.Rotate("k2", key2).IsOk())
+	tf.RunTest("Rotate - new current key", crypto.CurrentKeyID() == "k2")
+	rotated := crypto.Encrypt(ctx, []byte("Bob"), aad)
+	tf.RunTest("Rotate - new data under new key", rotated.IsOk() && rotated.Value().KeyID == "k2")
+	tf.RunTest("Rotate - old data still decrypts", crypto.Decrypt(ctx, sealed.Value(), aad).IsOk())
+
+	reused := crypto.Rotate("k1", key2)
+	tf.RunTest("Rotate - reused ID rejected",
+		reused.IsError() && reused.ErrorInfo().Kind == domerr.ValidationError)
+	tf.RunTest("Rotate - bad key size rejected", crypto.Rotate("k3", []byte("short")).IsError())
+
+	restarted := NewAESGCMCrypto("k2", map[string][]byte{"k1": key1, "k2": key2})
+	tf.RunTest("Restart with keyring - decrypts both generations",
+		restarted.Decrypt(ctx, sealed.Value(), aad).IsOk() && restarted.Decrypt(ctx, rotated.Value(), aad).IsOk())
+
+	unknown := NewAESGCMCrypto("k2", map[string][]byte{"k2": key2}).Decrypt(ctx, sealed.Value(), aad)
+	tf.RunTest("Retired key - unknown key error",
+		unknown.IsError() && strings.Contains(unknown.ErrorInfo().Message, `unknown key "k1"`))
+
+	// ========================================================================
+	// Test: Misconfiguration and cancellation
+	// ========================================================================
+
+	missing := NewAESGCMCrypto("k9", map[string][]byte{"k1": key1}).Encrypt(ctx, nil, nil)
+	tf.RunTest("Current key missing - misconfigured",
+		missing.IsError() && strings.Contains(missing.ErrorInfo().Message, "misconfigured"))
+	tf.RunTest("Invalid key size - misconfigured",
+		NewAESGCMCrypto("k1", map[string][]byte{"k1": []byte("short")}).Decrypt(ctx, sealed.Value(), aad).IsError())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled ctx - IsError", crypto.Encrypt(cancelled, []byte("x"), nil).IsError())
+
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterEncryptedBlob tests envelope-encrypted blobs.
+func TestInfrastructureAdapterEncryptedBlob(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.EncryptedBlob")
+	ctx := context.Background()
+
+	root := t.TempDir()
+	files := NewFileBlobStore(root)
+	crypto := NewAESGCMCrypto("k1", map[string][]byte{"k1": bytes.Repeat([]byte{7}, 32)})
+	blobs := NewEncryptedBlobStore(files, crypto)
+	read := func(key string) string {
+		r := blobs.Get(ctx, key)
+		if r.IsError() {
+			return "ERR " + r.ErrorInfo().Message
+		}
+		defer r.Value().Close()
+		b, err := io.ReadAll(r.Value())
+		if err != nil {
+			return "ERR " + err.Error()
+		}
+		return string(b)
+	}
+	stored := func(key string) []byte {
+		b, _ := os.ReadFile(filepath.Join(root, filepath.FromSlash(key)))
+		return b
+	}
+
+	// ========================================================================
+	// Test: Round trip, encrypted at rest
+	// ========================================================================
+
+	report := strings.Repeat("Hello, Alice!\n", 20000) // several chunks
+	tf.RunTest("Put - IsOk", blobs.Put(ctx, "reports/a.txt", streamOnly{strings.NewReader(report)}).IsOk())
+	tf.RunTest("Get - original bytes", read("reports/a.txt") == report)
+	tf.RunTest("At rest - header magic", bytes.HasPrefix(stored("reports/a.txt"), []byte("HLE1")))
+	tf.RunTest("At rest - no plaintext", !bytes.Contains(stored("reports/a.txt"), []byte("Alice")))
+	tf.RunTest("At rest - wrapped key ID in header", bytes.Contains(stored("reports/a.txt"), []byte(`"kid":"k1"`)))
+
+	exact := strings.Repeat("x", 2*encryptedBlobChunk)
+	blobs.Put(ctx, "exact", strings.NewReader(exact))
+	tf.RunTest("Exact chunk multiple - round trip", read("exact") == exact)
+	blobs.Put(ctx, "empty", strings.NewReader(""))
+	tf.RunTest("Empty body - round trip", read("empty") == "")
+
+	// ========================================================================
+	// Test: Key rotation
+	// ========================================================================
+
+	crypto.Rotate("k2", bytes.Repeat([]byte{8}, 32))
+	blobs.Put(ctx, "new.txt", strings.NewReader("after rotation"))
+	tf.RunTest("Rotation - new object under new key", bytes.Contains(stored("new.txt"), []byte(`"kid":"k2"`)))
+	tf.RunTest("Rotation - old object still readable", read("reports/a.txt") == report)
+
+	// ========================================================================
+	// Test: Tampering is detected
+	// ========================================================================
+
+	files.Put(ctx, "plain.txt", strings.NewReader("not encrypted"))
+	tf.RunTest("Plaintext object - rejected", strings.Contains(read("plain.txt"), "not encrypted"))
+
+	files.Put(ctx, "moved.txt", bytes.NewReader(stored("new.txt")))
+	tf.RunTest("Object moved to another key - rejected", strings.HasPrefix(read("moved.txt"), "ERR"))
+
+	whole := stored("reports/a.txt")
+	files.Put(ctx, "truncated", bytes.NewReader(whole[:len(whole)-100]))
+	tf.RunTest("Truncated in last chunk - read fails", strings.HasPrefix(read("truncated"), "ERR"))
+
+	headerEnd := len(encryptedBlobMagic) + 2 + int(binary.BigEndian.Uint16(whole[4:6]))
+	files.Put(ctx, "cut", bytes.NewReader(whole[:headerEnd+encryptedBlobChunk+16]))
+	tf.RunTest("Truncated at chunk boundary - read fails", strings.HasPrefix(read("cut"), "ERR"))
+
+	flipped := bytes.Clone(whole)
+	flipped[len(flipped)/2] ^= 1
+	files.Put(ctx, "flipped", bytes.NewReader(flipped))
+	tf.RunTest("Flipped bit - read fails", strings.HasPrefix(read("flipped"), "ERR"))
+
+	// ========================================================================
+	// Test: Failures pass through
+	// ========================================================================
+
+	tf.RunTest("Missing key - not found", strings.Contains(read("nope"), "not found"))
+	failing := blobs.Put(ctx, "broken", &failAfterReader{data: []byte("partial")})
+	tf.RunTest("Body read error - nothing stored", failing.IsError() && files.Get(ctx, "broken").IsError())
+
+	broken := NewEncryptedBlobStore(files, NewAESGCMCrypto("none", nil))
+	tf.RunTest("Crypto misconfigured - Put fails", broken.Put(ctx, "k", strings.NewReader("x")).IsError())
+
+	infos := blobs.List(ctx, "reports/")
+	tf.RunTest("List - stored size", infos.IsOk() && len(infos.Value()) == 1 &&
+		infos.Value()[0].Size == int64(len(whole)))
+	tf.RunTest("Delete - passes through", blobs.Delete(ctx, "reports/a.txt").IsOk() &&
+		files.Get(ctx, "reports/a.txt").IsError())
+
+	tf.Summary(t)
+}
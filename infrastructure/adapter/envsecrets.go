@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Environment-variable secret adapter
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// EnvSecrets is a SecretPort adapter that reads secrets from environment
+// variables.
+//
+// Key Mapping:
+//   - The key is upper-cased and every non-alphanumeric rune becomes '_'
+//   - The configured prefix is prepended
+//   - Example: prefix "GREETER_", key "smtp/password" -> GREETER_SMTP_PASSWORD
+//
+// Implements: outbound.SecretPort
+type EnvSecrets struct {
+	prefix string
+	lookup func(key string) (string, bool)
+}
+
+// NewEnvSecrets creates an environment-variable secret adapter.
+//
+// Usage:
+//
+//	secrets := adapter.NewEnvSecrets("GREETER_")
+//	result := secrets.Get(ctx, "smtp/password") // reads GREETER_SMTP_PASSWORD
+func NewEnvSecrets(prefix string) *EnvSecrets {
+	return &EnvSecrets{prefix: prefix, lookup: os.LookupEnv}
+}
+
+// Get returns the secret stored in the environment variable for key.
+//
+// Contract:
+//   - Returns Ok(Secret) if the variable is set (even if empty)
+//   - Returns Err(InfrastructureError) if the variable is unset or ctx is cancelled
+func (e *EnvSecrets) Get(ctx context.Context, key string) (result domerr.Result[model.Secret]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Secret](apperr.NewInfrastructureError(
+				fmt.Sprintf("env secret panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("env secret cancelled: %v", context.Cause(ctx))))
+	}
+
+	name := e.VariableName(key)
+	value, ok := e.lookup(name)
+	if !ok {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("secret %q not found: environment variable %s is not set", key, name)))
+	}
+	return domerr.Ok(model.NewSecret([]byte(value)))
+}
+
+// VariableName returns the environment variable consulted for key.
+func (e *EnvSecrets) VariableName(key string) string {
+	var b strings.Builder
+	b.WriteString(e.prefix)
+	for _, r := range strings.ToUpper(key) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
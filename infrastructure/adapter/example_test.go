@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+func ExampleNewWriter() {
+	clock := adapter.NewFrozenClock(time.Date(2025, 1, 1, 9, 30, 0, 0, time.UTC))
+	w := adapter.NewWriter(os.Stdout,
+		adapter.WithTimestamp(),
+		adapter.WithPrefix("[greeter] "),
+		adapter.WithConsoleClock(clock),
+	)
+
+	w.Write(context.Background(), "Hello, Alice!")
+	clock.Advance(time.Minute)
+	w.Write(context.Background(), "Hello, Bob!")
+	// Output:
+	// 2025-01-01T09:30:00Z [greeter] Hello, Alice!
+	// 2025-01-01T09:31:00Z [greeter] Hello, Bob!
+}
+
+func ExampleNewWriter_misconfigured() {
+	w := adapter.NewWriter(os.Stdout, adapter.WithWrap(-1))
+
+	fmt.Println(w.Write(context.Background(), "Hello!").ErrorInfo())
+	// Output: InfrastructureError: write failed: console writer misconfigured: wrap width must not be negative, got -1
+}
+
+func ExampleNewFileBlobStore() {
+	root, err := os.MkdirTemp("", "blobs")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(root)
+
+	ctx := context.Background()
+	store := adapter.NewFileBlobStore(root)
+	store.Put(ctx, "reports/2025/jan.txt", strings.NewReader("Hello, Alice!"))
+
+	body := store.Get(ctx, "reports/2025/jan.txt")
+	if body.IsOk() {
+		defer body.Value().Close()
+		data, _ := io.ReadAll(body.Value())
+		fmt.Println(string(data))
+	}
+	for _, info := range store.List(ctx, "reports/").Value() {
+		fmt.Println(info.Key, info.Size)
+	}
+	fmt.Println(store.Get(ctx, "missing.txt").ErrorInfo())
+	// Output:
+	// Hello, Alice!
+	// reports/2025/jan.txt 13
+	// InfrastructureError: blob "missing.txt" not found
+}
+
+func ExampleNewULIDGenerator() {
+	ids := adapter.NewULIDGenerator(
+		adapter.WithClock(adapter.NewFrozenClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))),
+		adapter.WithEntropy(bytes.NewReader(make([]byte, 64))),
+	)
+
+	fmt.Println(ids.NewID().Value())
+	// Output: 01JGFJJZ000000000000000000
+}
+
+func ExampleNewSequentialIDGenerator() {
+	ids := adapter.NewSequentialIDGenerator("order-")
+
+	fmt.Println(ids.NewID().Value())
+	fmt.Println(ids.NewID().Value())
+	// Output:
+	// order-1
+	// order-2
+}
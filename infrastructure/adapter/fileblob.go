@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Local filesystem blob storage adapter
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// fileBlobTempPrefix marks in-progress uploads; List skips them.
+const fileBlobTempPrefix = ".blob-tmp-"
+
+// FileBlobStore is a BlobPort adapter that stores each blob as a file under
+// a root directory; key "reports/a.csv" becomes <root>/reports/a.csv.
+//
+// Atomicity: Put writes to a temporary file in the target directory and
+// renames it into place, so readers see the old or the new object, never
+// a partial one.
+//
+// Concurrency: safe for concurrent use; concurrent Puts of one key are
+// last-rename-wins.
+//
+// Implements: outbound.BlobPort
+type FileBlobStore struct {
+	root string
+}
+
+// NewFileBlobStore creates a filesystem blob store rooted at root. The
+// directory is created on first Put if it does not exist.
+//
+// Usage:
+//
+//	blobs := adapter.NewFileBlobStore("/var/lib/greeter/blobs")
+//	result := blobs.Put(ctx, "reports/today.csv", file)
+func NewFileBlobStore(root string) *FileBlobStore {
+	return &FileBlobStore{root: root}
+}
+
+// Put streams body into the file for key.
+func (s *FileBlobStore) Put(ctx context.Context, key string, body io.Reader) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("blob put panicked: %v", r)))
+		}
+	}()
+
+	path, err := s.path(ctx, key)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob put %q failed: %v", key, err)))
+	}
+	if err := s.writeAtomic(ctx, path, body); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("blob put %q failed: %v", key, err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// writeAtomic copies body into a temp file beside path, then renames it.
+func (s *FileBlobStore) writeAtomic(ctx context.Context, path string, body io.Reader) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, fileBlobTempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+
+	_, err = io.Copy(tmp, contextReader{ctx: ctx, r: body})
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get opens the file for key. The caller must Close the returned body.
+func (s *FileBlobStore) Get(ctx context.Context, key string) (result domerr.Result[io.ReadCloser]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+				fmt.Sprintf("blob get panicked: %v", r)))
+		}
+	}()
+
+	path, err := s.path(ctx, key)
+	if err != nil {
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob get %q failed: %v", key, err)))
+	}
+	f, err := os.Open(path) // #nosec G304 -- path is validated to stay under root
+	if errors.Is(err, fs.ErrNotExist) {
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob %q not found", key)))
+	}
+	if err != nil {
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("blob get %q failed: %v", key, err)))
+	}
+	return domerr.Ok[io.ReadCloser](f)
+}
+
+// List walks the root and returns the blobs whose key starts with prefix.
+func (s *FileBlobStore) List(ctx context.Context, prefix string) (result domerr.Result[[]model.BlobInfo]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[[]model.BlobInfo](apperr.NewInfrastructureError(
+				fmt.Sprintf("blob list panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[[]model.BlobInfo](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob list cancelled: %v", context.Cause(ctx))))
+	}
+
+	infos := []model.BlobInfo{}
+	err := filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return context.Cause(ctx)
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), fileBlobTempPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		infos = append(infos, model.BlobInfo{Key: key, Size: info.Size(), ModifiedAt: info.ModTime().UTC()})
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return domerr.Ok(infos) // nothing stored yet
+	}
+	if err != nil {
+		return domerr.Err[[]model.BlobInfo](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("blob list failed: %v", err)))
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Key < infos[j].Key })
+	return domerr.Ok(infos)
+}
+
+// Delete removes the file for key; a missing file is not an error.
+func (s *FileBlobStore) Delete(ctx context.Context, key string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("blob delete panicked: %v", r)))
+		}
+	}()
+
+	path, err := s.path(ctx, key)
+	if err == nil {
+		err = os.Remove(path)
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("blob delete %q failed: %v", key, err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// path checks ctx and key and maps key to its file under root.
+func (s *FileBlobStore) path(ctx context.Context, key string) (string, error) {
+	if ctx.Err() != nil {
+		return "", fmt.Errorf("cancelled: %w", context.Cause(ctx))
+	}
+	if err := validateBlobKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// contextReader stops a streaming copy once ctx is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c contextReader) Read(p []byte) (int, error) {
+	if c.ctx.Err() != nil {
+		return 0, context.Cause(c.ctx)
+	}
+	return c.r.Read(p)
+}
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: JSON Lines file spool adapter for offline mode
+
+package adapter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// FileSpool is a SpoolPort adapter keeping spooled commands in a local
+// JSON Lines file, one SpoolEntry per line, oldest first.
+//
+// Durability: Append writes one line and syncs the file before returning.
+// Remove rewrites the file to a temporary file and renames it into place,
+// so a crash leaves either the old or the new spool. A line cut short by
+// a crash during Append (or otherwise unreadable) is skipped.
+//
+// Cost: Remove rewrites the whole file; the spool is meant for the
+// backlog of one outage, not as a general queue.
+//
+// Concurrency: safe for concurrent use within one process. Do not share
+// the file between processes.
+//
+// Implements: outbound.SpoolPort
+type FileSpool struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileSpool creates a spool stored at path. The file and its directory
+// are created on first Append; a missing file is an empty spool.
+//
+// Usage:
+//
+//	spool := adapter.NewFileSpool(filepath.Join(dataDir, "spool.jsonl"))
+func NewFileSpool(path string) *FileSpool {
+	return &FileSpool{path: path}
+}
+
+// Append adds entry at the end of the spool, unless its Key is spooled.
+func (s *FileSpool) Append(ctx context.Context, entry model.SpoolEntry) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("spool append panicked: %v", r)))
+		}
+	}()
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool append cancelled: %v", context.Cause(ctx))))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, raw, err := s.load()
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool append failed: %v", err)))
+	}
+	for _, e := range entries {
+		if e.Key == entry.Key {
+			return domerr.Ok(model.UnitValue)
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool append failed: %v", err)))
+	}
+	if len(raw) > 0 && raw[len(raw)-1] != '\n' {
+		line = append([]byte{'\n'}, line...) // terminate a torn last line
+	}
+	if err := s.appendLine(append(line, '\n')); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool append failed: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Scan visits entries oldest first until visit returns false. visit sees
+// a snapshot and may call back into the spool.
+func (s *FileSpool) Scan(ctx context.Context, visit func(model.SpoolEntry) bool) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("spool scan panicked: %v", r)))
+		}
+	}()
+
+	s.mu.Lock()
+	entries, _, err := s.load()
+	s.mu.Unlock()
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool scan failed: %v", err)))
+	}
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("spool scan cancelled: %v", context.Cause(ctx))))
+		}
+		if !visit(entry) {
+			break
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Remove deletes the entry with key, if present.
+func (s *FileSpool) Remove(ctx context.Context, key string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("spool remove panicked: %v", r)))
+		}
+	}()
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool remove cancelled: %v", context.Cause(ctx))))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, _, err := s.load()
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool remove %q failed: %v", key, err)))
+	}
+	var kept bytes.Buffer
+	found := false
+	for _, e := range entries {
+		if e.Key == key {
+			found = true
+			continue
+		}
+		line, err := json.Marshal(e)
+		if err != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("spool remove %q failed: %v", key, err)))
+		}
+		kept.Write(line)
+		kept.WriteByte('\n')
+	}
+	if !found {
+		return domerr.Ok(model.UnitValue)
+	}
+	if err := s.replace(kept.Bytes()); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("spool remove %q failed: %v", key, err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// load reads and parses the spool file; a missing file is empty.
+func (s *FileSpool) load() ([]model.SpoolEntry, []byte, error) {
+	raw, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []model.SpoolEntry
+	lines := bufio.NewScanner(bytes.NewReader(raw))
+	lines.Buffer(nil, len(raw)+1)
+	for lines.Scan() {
+		var e model.SpoolEntry
+		if json.Unmarshal(lines.Bytes(), &e) == nil && e.Key != "" {
+			entries = append(entries, e)
+		}
+	}
+	return entries, raw, lines.Err()
+}
+
+// appendLine appends line to the spool file and syncs it.
+func (s *FileSpool) appendLine(line []byte) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o750); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// replace atomically swaps the spool file's contents for data.
+func (s *FileSpool) replace(data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterFileSpool tests the JSON Lines spool.
+func TestInfrastructureAdapterFileSpool(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.FileSpool")
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "offline", "spool.jsonl")
+	spool := NewFileSpool(path)
+	entry := func(key string) model.SpoolEntry {
+		return model.SpoolEntry{Key: key, Command: []byte(`{"Name":"` + key + `"}`), SpooledAt: time.Unix(1700000000, 0).UTC()}
+	}
+	keys := func(s *FileSpool) string {
+		var got []string
+		s.Scan(ctx, func(e model.SpoolEntry) bool {
+			got = append(got, e.Key)
+			return true
+		})
+		return strings.Join(got, ",")
+	}
+
+	// ========================================================================
+	// Test: Append and Scan
+	// ========================================================================
+
+	tf.RunTest("Missing file - empty spool", spool.Scan(ctx, func(model.SpoolEntry) bool { return true }).IsOk() &&
+		keys(spool) == "")
+	tf.RunTest("Append - IsOk, creates directory", spool.Append(ctx, entry("a")).IsOk())
+	spool.Append(ctx, entry("b"))
+	spool.Append(ctx, entry("c"))
+	tf.RunTest("Scan - oldest first", keys(spool) == "a,b,c")
+	tf.RunTest("Append - duplicate key ignored", spool.Append(ctx, entry("b")).IsOk() && keys(spool) == "a,b,c")
+
+	raw, _ := os.ReadFile(path)
+	tf.RunTest("File - one JSON line per entry", strings.Count(string(raw), "\n") == 3 &&
+		strings.HasPrefix(string(raw), `{"key":"a","command":{"Name":"a"},"spooled_at":"2023-11-14T22:13:20Z"}`))
+
+	var first []string
+	spool.Scan(ctx, func(e model.SpoolEntry) bool {
+		first = append(first, e.Key)
+		return false
+	})
+	tf.RunTest("Scan - stops when visit returns false", len(first) == 1)
+
+	// ========================================================================
+	// Test: Remove and restart
+	// ========================================================================
+
+	tf.RunTest("Remove - IsOk", spool.Remove(ctx, "b").IsOk())
+	tf.RunTest("Remove - entry gone, order kept", keys(spool) == "a,c")
+	tf.RunTest("Remove unknown key - IsOk", spool.Remove(ctx, "zzz").IsOk())
+	tf.RunTest("Restart - entries survive", keys(NewFileSpool(path)) == "a,c")
+
+	// ========================================================================
+	// Test: Torn last line
+	// ========================================================================
+
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	f.WriteString(`{"key":"torn","comm`)
+	f.Close()
+	tf.RunTest("Torn line - skipped", keys(spool) == "a,c")
+	spool.Append(ctx, entry("d"))
+	tf.RunTest("Torn line - next append readable", keys(spool) == "a,c,d")
+
+	// ========================================================================
+	// Test: Failures
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled ctx - Append IsError", spool.Append(cancelled, entry("e")).IsError())
+	tf.RunTest("Cancelled ctx - Scan IsError",
+		spool.Scan(cancelled, func(model.SpoolEntry) bool { return true }).IsError())
+	tf.RunTest("Cancelled ctx - Remove IsError", spool.Remove(cancelled, "a").IsError())
+
+	dir := NewFileSpool(t.TempDir())
+	tf.RunTest("Path is a directory - IsError", dir.Append(ctx, entry("x")).IsError())
+
+	panicky := spool.Scan(ctx, func(model.SpoolEntry) bool { panic("boom") })
+	tf.RunTest("Panicking visit - converted to Err", panicky.IsError() &&
+		strings.Contains(panicky.ErrorInfo().Message, "spool scan panicked"))
+
+	tf.Summary(t)
+}
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: ID generator adapters (UUIDv7, ULID, sequential)
+
+package adapter
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// ============================================================================
+// UUIDv7
+// ============================================================================
+
+// UUIDv7Generator generates RFC 9562 version 7 UUIDs: a 48-bit Unix
+// millisecond timestamp followed by 74 random bits.
+//
+// Output format: canonical 36-character lowercase hex with hyphens.
+//
+// Monotonicity: IDs generated within the same millisecond are ordered by
+// treating the 12-bit rand_a field as a counter (RFC 9562, method 1).
+//
+// Implements: outbound.IDGeneratorPort
+type UUIDv7Generator struct {
+	mu      sync.Mutex
+	lastMs  int64
+	counter uint16
+
+	clock   outbound.ClockPort
+	entropy io.Reader
+	cfgErr  error
+}
+
+// NewUUIDv7Generator creates a UUIDv7 generator using crypto/rand.
+//
+// Options: WithClock, WithEntropy.
+func NewUUIDv7Generator(opts ...IDOption) *UUIDv7Generator {
+	cfg := newIDConfig(opts)
+	return &UUIDv7Generator{clock: cfg.clock, entropy: cfg.entropy, cfgErr: cfg.validate()}
+}
+
+// NewID returns a new UUIDv7.
+//
+// Contract:
+//   - Returns Ok(36-char UUID string)
+//   - Returns Err(InfrastructureError) if the entropy source fails
+func (g *UUIDv7Generator) NewID() (result domerr.Result[string]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[string](apperr.NewInfrastructureError(
+				fmt.Sprintf("uuidv7 generation panicked: %v", r)))
+		}
+	}()
+
+	if g.cfgErr != nil {
+		return domerr.Err[string](apperr.NewInfrastructureError(
+			fmt.Sprintf("uuidv7 generation failed: generator misconfigured: %v", g.cfgErr)))
+	}
+
+	var u [16]byte
+	if _, err := io.ReadFull(g.entropy, u[6:]); err != nil {
+		return domerr.Err[string](apperr.NewInfrastructureError(
+			fmt.Sprintf("uuidv7 generation failed: %v", err)))
+	}
+
+	g.mu.Lock()
+	ms := g.clock.Now().UnixMilli()
+	if ms <= g.lastMs {
+		// Same (or earlier, after a clock step back) millisecond: keep the
+		// previous timestamp and bump the counter to stay monotonic.
+		ms = g.lastMs
+		g.counter++
+		if g.counter > 0x0fff {
+			// Counter exhausted - borrow the next millisecond.
+			ms++
+			g.counter = 0
+		}
+	} else {
+		// New millisecond: seed the counter randomly in its lower half so
+		// there is headroom for increments.
+		g.counter = binary.BigEndian.Uint16(u[6:8]) & 0x07ff
+	}
+	g.lastMs = ms
+	counter := g.counter
+	g.mu.Unlock()
+
+	// 48-bit big-endian timestamp
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+	// version 7 + 12-bit counter (rand_a)
+	u[6] = 0x70 | byte(counter>>8)
+	u[7] = byte(counter)
+	// variant 10xx
+	u[8] = (u[8] & 0x3f) | 0x80
+
+	return domerr.Ok(formatUUID(u))
+}
+
+// formatUUID renders 16 bytes as 8-4-4-4-12 lowercase hex.
+func formatUUID(u [16]byte) string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// ============================================================================
+// ULID
+// ============================================================================
+
+// crockford is the Crockford base32 alphabet used by ULID.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ULIDGenerator generates ULIDs: a 48-bit Unix millisecond timestamp and
+// 80 random bits, encoded as 26 Crockford base32 characters.
+//
+// Monotonicity: within the same millisecond the random part of the previous
+// ULID is incremented, per the ULID specification.
+//
+// Implements: outbound.IDGeneratorPort
+type ULIDGenerator struct {
+	mu     sync.Mutex
+	lastMs int64
+	last   [10]byte
+
+	clock   outbound.ClockPort
+	entropy io.Reader
+	cfgErr  error
+}
+
+// NewULIDGenerator creates a monotonic ULID generator using crypto/rand.
+//
+// Options: WithClock, WithEntropy.
+func NewULIDGenerator(opts ...IDOption) *ULIDGenerator {
+	cfg := newIDConfig(opts)
+	return &ULIDGenerator{clock: cfg.clock, entropy: cfg.entropy, cfgErr: cfg.validate()}
+}
+
+// NewID returns a new ULID.
+//
+// Contract:
+//   - Returns Ok(26-char ULID string)
+//   - Returns Err(InfrastructureError) if the entropy source fails or the
+//     random part overflows within one millisecond (2^80 IDs)
+func (g *ULIDGenerator) NewID() (result domerr.Result[string]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[string](apperr.NewInfrastructureError(
+				fmt.Sprintf("ulid generation panicked: %v", r)))
+		}
+	}()
+
+	if g.cfgErr != nil {
+		return domerr.Err[string](apperr.NewInfrastructureError(
+			fmt.Sprintf("ulid generation failed: generator misconfigured: %v", g.cfgErr)))
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := g.clock.Now().UnixMilli()
+	if ms <= g.lastMs {
+		ms = g.lastMs
+		if !incrementBytes(g.last[:]) {
+			return domerr.Err[string](apperr.NewInfrastructureError(
+				"ulid generation failed: monotonic random overflow"))
+		}
+	} else {
+		if _, err := io.ReadFull(g.entropy, g.last[:]); err != nil {
+			return domerr.Err[string](apperr.NewInfrastructureError(
+				fmt.Sprintf("ulid generation failed: %v", err)))
+		}
+		g.lastMs = ms
+	}
+
+	var id [16]byte
+	id[0] = byte(ms >> 40)
+	id[1] = byte(ms >> 32)
+	id[2] = byte(ms >> 24)
+	id[3] = byte(ms >> 16)
+	id[4] = byte(ms >> 8)
+	id[5] = byte(ms)
+	copy(id[6:], g.last[:])
+
+	return domerr.Ok(encodeULID(id))
+}
+
+// incrementBytes adds one to a big-endian number; false on overflow.
+func incrementBytes(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeULID encodes 128 bits as 26 Crockford base32 characters
+// (the leading character carries only 3 bits).
+func encodeULID(id [16]byte) string {
+	var out [26]byte
+	// Process as a 130-bit big-endian number, 5 bits per character,
+	// from the least significant end.
+	var acc uint64
+	bits := 0
+	pos := 25
+	for i := 15; i >= 0; i-- {
+		acc |= uint64(id[i]) << bits
+		bits += 8
+		for bits >= 5 && pos >= 0 {
+			out[pos] = crockford[acc&0x1f]
+			acc >>= 5
+			bits -= 5
+			pos--
+		}
+	}
+	if pos >= 0 {
+		out[pos] = crockford[acc&0x1f]
+	}
+	return string(out[:])
+}
+
+// ============================================================================
+// Sequential (deterministic, for tests)
+// ============================================================================
+
+// SequentialIDGenerator produces predictable IDs "<prefix>1", "<prefix>2",
+// ... so tests and golden files can assert on exact values.
+//
+// Not for production use: IDs are neither globally unique nor unguessable.
+//
+// Implements: outbound.IDGeneratorPort
+type SequentialIDGenerator struct {
+	mu     sync.Mutex
+	prefix string
+	next   uint64
+}
+
+// NewSequentialIDGenerator creates a deterministic generator starting at 1.
+func NewSequentialIDGenerator(prefix string) *SequentialIDGenerator {
+	return &SequentialIDGenerator{prefix: prefix, next: 1}
+}
+
+// NewID returns the next sequential ID. Never fails.
+func (g *SequentialIDGenerator) NewID() domerr.Result[string] {
+	g.mu.Lock()
+	n := g.next
+	g.next++
+	g.mu.Unlock()
+	return domerr.Ok(fmt.Sprintf("%s%d", g.prefix, n))
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// failingReader is an entropy source that always errors.
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("no entropy") }
+
+// TestInfrastructureAdapterIDGenerator tests the IDGeneratorPort adapters.
+func TestInfrastructureAdapterIDGenerator(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.IDGenerator")
+	fixed := time.UnixMilli(0x0190_1234_5678)
+
+	// ========================================================================
+	// Test: UUIDv7 format, version, variant, timestamp
+	// ========================================================================
+
+	uuidRe := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	u := NewUUIDv7Generator()
+	r1 := u.NewID()
+	tf.RunTest("UUIDv7 - IsOk", r1.IsOk())
+	tf.RunTest("UUIDv7 - canonical format", uuidRe.MatchString(r1.Value()))
+
+	u = NewUUIDv7Generator()
+	u.clock = NewFrozenClock(fixed)
+	r2 := u.NewID()
+	tf.RunTest("UUIDv7 - timestamp prefix", r2.IsOk() && r2.Value()[:13] == "01901234-5678")
+
+	// ========================================================================
+	// Test: UUIDv7 monotonic within one millisecond
+	// ========================================================================
+
+	sorted := true
+	prev := r2.Value()
+	for i := 0; i < 5000; i++ {
+		id := u.NewID().Value()
+		if id <= prev {
+			sorted = false
+		}
+		prev = id
+	}
+	tf.RunTest("UUIDv7 - monotonic in same millisecond", sorted)
+
+	u.entropy = failingReader{}
+	tf.RunTest("UUIDv7 - entropy failure IsError", u.NewID().IsError())
+
+	// ========================================================================
+	// Test: ULID format, timestamp and monotonicity
+	// ========================================================================
+
+	ulidRe := regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Z]{25}$`)
+	g := NewULIDGenerator()
+	r3 := g.NewID()
+	tf.RunTest("ULID - IsOk", r3.IsOk())
+	tf.RunTest("ULID - Crockford format", ulidRe.MatchString(r3.Value()))
+
+	g = NewULIDGenerator()
+	g.clock = NewFrozenClock(fixed)
+	g.entropy = bytes.NewReader(make([]byte, 10))
+	r4 := g.NewID()
+	tf.RunTest("ULID - zero entropy encodes timestamp only",
+		r4.IsOk() && r4.Value() == "01J0938NKR0000000000000000")
+	r5 := g.NewID()
+	tf.RunTest("ULID - same millisecond increments",
+		r5.IsOk() && r5.Value() == "01J0938NKR0000000000000001")
+
+	g.clock = NewFrozenClock(fixed.Add(time.Millisecond))
+	tf.RunTest("ULID - entropy failure IsError", g.NewID().IsError())
+
+	g.lastMs = 0
+	g.clock = NewFrozenClock(time.Unix(0, 0))
+	g.last = [10]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	tf.RunTest("ULID - random overflow IsError", g.NewID().IsError())
+
+	// ========================================================================
+	// Test: Sequential generator is deterministic
+	// ========================================================================
+
+	s := NewSequentialIDGenerator("req-")
+	tf.RunTest("Sequential - first", s.NewID().Value() == "req-1")
+	tf.RunTest("Sequential - second", s.NewID().Value() == "req-2")
+
+	tf.Summary(t)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: JavaScript output adapter for GOOS=js GOARCH=wasm builds
+
+//go:build js && wasm
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"syscall/js"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// JSWriter is an infrastructure adapter that hands each message to
+// JavaScript: either a caller-supplied callback (e.g. one that appends to
+// the DOM) or, when none is given, console.log.
+//
+// Compiled only for GOOS=js GOARCH=wasm. WASI builds (GOOS=wasip1) use
+// ConsoleWriter, since stdout is the host's output channel there.
+//
+// Implements: outbound.WriterPort
+type JSWriter struct {
+	callback js.Value
+}
+
+// NewJSWriter creates a JSWriter that calls callback(message). If callback
+// is not a JS function, messages go to console.log.
+//
+// Usage:
+//
+//	writer := adapter.NewJSWriter(js.Global().Get("appendGreeting"))
+//	uc := usecase.NewGreetUseCase[*adapter.JSWriter](writer)
+func NewJSWriter(callback js.Value) *JSWriter {
+	if callback.Type() != js.TypeFunction {
+		console := js.Global().Get("console")
+		callback = console.Get("log").Call("bind", console)
+	}
+	return &JSWriter{callback: callback}
+}
+
+// Write passes message to the JavaScript callback.
+//
+// Contract:
+//   - Returns Ok(Unit) if the callback returned normally
+//   - Returns Err(InfrastructureError) if ctx is cancelled or the callback
+//     throws (a thrown JS error surfaces as a Go panic, which is recovered)
+func (jw *JSWriter) Write(ctx context.Context, message string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("js write failed: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("js write cancelled: %v", context.Cause(ctx))))
+	}
+
+	jw.callback.Invoke(message)
+	return domerr.Ok(model.UnitValue)
+}
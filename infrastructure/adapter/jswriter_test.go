@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+//go:build js && wasm
+
+package adapter
+
+import (
+	"context"
+	"syscall/js"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/porttest"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterJSWriter tests the JavaScript output adapter.
+// Run with: GOOS=js GOARCH=wasm go test -exec "$(go env GOROOT)/lib/wasm/go_js_wasm_exec"
+func TestInfrastructureAdapterJSWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.JSWriter")
+	ctx := context.Background()
+
+	var got []string
+	cb := js.FuncOf(func(_ js.Value, args []js.Value) any {
+		got = append(got, args[0].String())
+		return nil
+	})
+	defer cb.Release()
+
+	writer := NewJSWriter(cb.Value)
+	tf.RunTest("Callback - IsOk", writer.Write(ctx, "Hello, Alice!").IsOk())
+	tf.RunTest("Callback - received message", len(got) == 1 && got[0] == "Hello, Alice!")
+
+	tf.RunTest("Console fallback - IsOk", NewJSWriter(js.Undefined()).Write(ctx, "to console").IsOk())
+
+	throws := js.Global().Get("Function").New("throw new Error('boom')")
+	r := NewJSWriter(throws).Write(ctx, "x")
+	tf.RunTest("Throwing callback - IsError", r.IsError())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled - IsError", writer.Write(cancelled, "x").IsError())
+	tf.RunTest("Cancelled - callback not called", len(got) == 1)
+
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterJSWriterContract runs the WriterPort
+// conformance suite against JSWriter (console.log fallback).
+func TestInfrastructureAdapterJSWriterContract(t *testing.T) {
+	porttest.TestWriterPortContract(t, func(*testing.T) outbound.WriterPort {
+		return NewJSWriter(js.Undefined())
+	})
+}
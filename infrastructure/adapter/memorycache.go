@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: In-memory cache adapter
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// memoryEntry is a single cached value with its optional expiry.
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time // zero means no expiry
+}
+
+// MemoryCache is a process-local cache adapter backed by a map.
+//
+// Suitable for single-instance deployments and tests. Expired entries are
+// evicted lazily on access; there is no background janitor goroutine.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.CachePort
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]memoryEntry
+}
+
+// NewMemoryCache creates an empty in-memory cache.
+//
+// Usage:
+//
+//	cache := adapter.NewMemoryCache()
+//	result := cache.Set(ctx, "greeting:alice", []byte("Hello, Alice!"), time.Minute)
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryEntry)}
+}
+
+// Get returns the cached value for key.
+//
+// Contract:
+//   - Returns Ok(Some(copy of value)) on hit
+//   - Returns Ok(None) on miss or when the entry has expired
+//   - Returns Err(InfrastructureError) if ctx is cancelled
+func (c *MemoryCache) Get(ctx context.Context, key string) (result domerr.Result[valueobject.Option[[]byte]]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[valueobject.Option[[]byte]](apperr.NewInfrastructureError(
+				fmt.Sprintf("cache get panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[valueobject.Option[[]byte]](apperr.NewInfrastructureError(
+			fmt.Sprintf("cache get cancelled: %v", context.Cause(ctx))))
+	}
+
+	c.mu.RLock()
+	entry, found := c.entries[key]
+	c.mu.RUnlock()
+
+	if !found {
+		return domerr.Ok(valueobject.None[[]byte]())
+	}
+
+	if !entry.expiresAt.IsZero() && !time.Now().Before(entry.expiresAt) {
+		// Lazy eviction - re-check under the write lock so a concurrent
+		// Set with a fresh entry is not discarded.
+		c.mu.Lock()
+		if current, ok := c.entries[key]; ok && current.expiresAt.Equal(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+		return domerr.Ok(valueobject.None[[]byte]())
+	}
+
+	return domerr.Ok(valueobject.Some(cloneBytes(entry.value)))
+}
+
+// Set stores a copy of value under key.
+//
+// Contract:
+//   - ttl <= 0 stores the value without expiry
+//   - Overwrites any existing entry for key
+//   - Returns Err(InfrastructureError) if ctx is cancelled
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("cache set panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("cache set cancelled: %v", context.Cause(ctx))))
+	}
+
+	entry := memoryEntry{value: cloneBytes(value)}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	return domerr.Ok(model.UnitValue)
+}
+
+// Delete removes key from the cache. Deleting a missing key succeeds.
+//
+// Contract:
+//   - Returns Ok(Unit) whether or not the key existed
+//   - Returns Err(InfrastructureError) if ctx is cancelled
+func (c *MemoryCache) Delete(ctx context.Context, key string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("cache delete panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("cache delete cancelled: %v", context.Cause(ctx))))
+	}
+
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+
+	return domerr.Ok(model.UnitValue)
+}
+
+// cloneBytes returns a copy of b so cached data never aliases caller memory.
+// A nil input yields an empty, non-nil slice so hits are distinguishable
+// from "no value" in caller code that checks for nil.
+func cloneBytes(b []byte) []byte {
+	out := make([]byte, len(b))
+	copy(out, b)
+	return out
+}
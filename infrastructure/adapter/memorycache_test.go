@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/ctxreason"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterMemoryCache tests the in-memory CachePort adapter.
+func TestInfrastructureAdapterMemoryCache(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.MemoryCache")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Miss returns Ok(None)
+	// ========================================================================
+
+	cache := NewMemoryCache()
+	r1 := cache.Get(ctx, "missing")
+	tf.RunTest("Get missing - IsOk", r1.IsOk())
+	tf.RunTest("Get missing - IsNone", r1.IsOk() && r1.Value().IsNone())
+
+	// ========================================================================
+	// Test: Set then Get returns value
+	// ========================================================================
+
+	tf.RunTest("Set - IsOk", cache.Set(ctx, "k", []byte("v"), 0).IsOk())
+	r2 := cache.Get(ctx, "k")
+	tf.RunTest("Get after Set - IsSome", r2.IsOk() && r2.Value().IsSome())
+	tf.RunTest("Get after Set - correct value",
+		r2.IsOk() && string(r2.Value().UnwrapOr(nil)) == "v")
+
+	// ========================================================================
+	// Test: Stored value does not alias caller memory
+	// ========================================================================
+
+	buf := []byte("abc")
+	cache.Set(ctx, "alias", buf, 0)
+	buf[0] = 'X'
+	r3 := cache.Get(ctx, "alias")
+	tf.RunTest("Set copies input", string(r3.Value().Value()) == "abc")
+	r3.Value().Value()[0] = 'Y'
+	tf.RunTest("Get returns copy", string(cache.Get(ctx, "alias").Value().Value()) == "abc")
+
+	// ========================================================================
+	// Test: Expiry
+	// ========================================================================
+
+	cache.Set(ctx, "ttl", []byte("short"), time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	r4 := cache.Get(ctx, "ttl")
+	tf.RunTest("Expired entry - IsNone", r4.IsOk() && r4.Value().IsNone())
+
+	// ========================================================================
+	// Test: Delete is idempotent
+	// ========================================================================
+
+	tf.RunTest("Delete existing - IsOk", cache.Delete(ctx, "k").IsOk())
+	tf.RunTest("Delete existing - removed", cache.Get(ctx, "k").Value().IsNone())
+	tf.RunTest("Delete missing - IsOk", cache.Delete(ctx, "k").IsOk())
+
+	// ========================================================================
+	// Test: Cancelled context returns InfrastructureError
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	r5 := cache.Get(cancelled, "alias")
+	tf.RunTest("Get cancelled - IsError", r5.IsError())
+	tf.RunTest("Set cancelled - IsError", cache.Set(cancelled, "k", nil, 0).IsError())
+	tf.RunTest("Delete cancelled - IsError", cache.Delete(cancelled, "k").IsError())
+
+	shutdown, stop := ctxreason.WithCancel(ctx)
+	stop(ctxreason.Shutdown)
+	r6 := cache.Get(shutdown, "k")
+	tf.RunTest("Get shutdown - reason in error", r6.IsError() &&
+		ctxreason.FromError(r6.ErrorInfo()) == ctxreason.Shutdown)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: In-memory change detector adapter
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MemoryChangeDetector is a process-local ChangeDetectorPort adapter
+// backed by a map. Digests are lost on restart, so the first write of
+// each key after a restart is never skipped.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.ChangeDetectorPort
+type MemoryChangeDetector struct {
+	mu      sync.RWMutex
+	digests map[string]string
+}
+
+// NewMemoryChangeDetector creates a detector with no recorded digests.
+func NewMemoryChangeDetector() *MemoryChangeDetector {
+	return &MemoryChangeDetector{digests: make(map[string]string)}
+}
+
+// Changed reports whether digest differs from the one recorded for key.
+//
+// Contract:
+//   - Returns Ok(true) if nothing is recorded for key or the digests differ
+//   - Returns Err(InfrastructureError) if ctx is cancelled
+func (d *MemoryChangeDetector) Changed(ctx context.Context, key, digest string) (result domerr.Result[bool]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[bool](apperr.NewInfrastructureError(
+				fmt.Sprintf("change detector panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[bool](apperr.NewInfrastructureError(
+			fmt.Sprintf("change detector cancelled: %v", context.Cause(ctx))))
+	}
+
+	d.mu.RLock()
+	prev, found := d.digests[key]
+	d.mu.RUnlock()
+	return domerr.Ok(!found || prev != digest)
+}
+
+// Record stores digest as the last output for key.
+//
+// Contract:
+//   - Returns Ok(Unit) on success
+//   - Returns Err(InfrastructureError) if ctx is cancelled
+func (d *MemoryChangeDetector) Record(ctx context.Context, key, digest string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("change detector panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("change detector cancelled: %v", context.Cause(ctx))))
+	}
+
+	d.mu.Lock()
+	d.digests[key] = digest
+	d.mu.Unlock()
+	return domerr.Ok(model.UnitValue)
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: In-memory greeting history adapter
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MemoryHistory is a process-local greeting history, kept in append order.
+//
+// Concurrency: safe for concurrent use. Scan visits a snapshot taken when
+// it starts, so visit may call back into the history.
+//
+// Implements: outbound.HistoryPort, outbound.HistoryReaderPort
+type MemoryHistory struct {
+	mu      sync.RWMutex
+	records []model.GreetingRecord
+}
+
+// NewMemoryHistory creates an empty in-memory history.
+func NewMemoryHistory() *MemoryHistory {
+	return &MemoryHistory{}
+}
+
+// Append stores record; a duplicate CorrelationID is rejected.
+func (h *MemoryHistory) Append(ctx context.Context, record model.GreetingRecord) domerr.Result[model.Unit] {
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("history append cancelled: %v", context.Cause(ctx))))
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, existing := range h.records {
+		if existing.CorrelationID == record.CorrelationID {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("history append failed: record %q already exists", record.CorrelationID)))
+		}
+	}
+	h.records = append(h.records, record)
+	return domerr.Ok(model.UnitValue)
+}
+
+// Remove deletes the record with correlationID, if present.
+func (h *MemoryHistory) Remove(ctx context.Context, correlationID string) domerr.Result[model.Unit] {
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("history remove cancelled: %v", context.Cause(ctx))))
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i, existing := range h.records {
+		if existing.CorrelationID == correlationID {
+			h.records = append(h.records[:i], h.records[i+1:]...)
+			break
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Scan visits records oldest first until visit returns false.
+func (h *MemoryHistory) Scan(ctx context.Context, visit func(model.GreetingRecord) bool) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("history scan panicked: %v", r)))
+		}
+	}()
+
+	h.mu.RLock()
+	snapshot := append([]model.GreetingRecord(nil), h.records...)
+	h.mu.RUnlock()
+
+	for _, record := range snapshot {
+		if err := ctx.Err(); err != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("history scan cancelled: %v", context.Cause(ctx))))
+		}
+		if !visit(record) {
+			break
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
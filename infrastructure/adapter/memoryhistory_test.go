@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterMemoryHistory tests the in-memory history.
+func TestInfrastructureAdapterMemoryHistory(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.MemoryHistory")
+	ctx := context.Background()
+	h := NewMemoryHistory()
+
+	ids := func() string {
+		var got string
+		h.Scan(ctx, func(r model.GreetingRecord) bool { got += r.CorrelationID; return true })
+		return got
+	}
+
+	tf.RunTest("Append - IsOk", h.Append(ctx, model.GreetingRecord{CorrelationID: "a"}).IsOk())
+	h.Append(ctx, model.GreetingRecord{CorrelationID: "b"})
+	h.Append(ctx, model.GreetingRecord{CorrelationID: "c"})
+	tf.RunTest("Append duplicate - IsError", h.Append(ctx, model.GreetingRecord{CorrelationID: "a"}).IsError())
+	tf.RunTest("Scan - append order", ids() == "abc")
+
+	first := ""
+	h.Scan(ctx, func(r model.GreetingRecord) bool { first += r.CorrelationID; return false })
+	tf.RunTest("Scan - stops when visit returns false", first == "a")
+
+	tf.RunTest("Remove - IsOk", h.Remove(ctx, "b").IsOk())
+	tf.RunTest("Remove - record gone", ids() == "ac")
+	tf.RunTest("Remove missing - IsOk", h.Remove(ctx, "zz").IsOk())
+
+	reentrant := h.Scan(ctx, func(r model.GreetingRecord) bool {
+		return h.Append(ctx, model.GreetingRecord{CorrelationID: r.CorrelationID + "2"}).IsOk()
+	})
+	tf.RunTest("Scan - visit may append", reentrant.IsOk() && ids() == "aca2c2")
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Scan cancelled - IsError", h.Scan(cancelled, func(model.GreetingRecord) bool { return true }).IsError())
+	tf.RunTest("Append cancelled - IsError", h.Append(cancelled, model.GreetingRecord{CorrelationID: "x"}).IsError())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: In-memory lock adapter
+
+package adapter
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// MemoryLock is a process-local LockPort adapter.
+//
+// It provides mutual exclusion between goroutines of a single process only.
+// Use RedisLock when several replicas must coordinate.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.LockPort
+type MemoryLock struct {
+	mu     sync.Mutex
+	leases map[string]model.Lease
+}
+
+// NewMemoryLock creates an in-memory lock table.
+func NewMemoryLock() *MemoryLock {
+	return &MemoryLock{leases: make(map[string]model.Lease)}
+}
+
+// Acquire grants the lock on key for ttl if it is free or expired.
+//
+// Contract:
+//   - Returns Ok(Lease) with a fresh random token on success
+//   - Returns Err(InfrastructureError) if key is held, ttl <= 0,
+//     or ctx is cancelled
+func (l *MemoryLock) Acquire(ctx context.Context, key string, ttl time.Duration) (result domerr.Result[model.Lease]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Lease](apperr.NewInfrastructureError(
+				fmt.Sprintf("lock acquire panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureError(
+			fmt.Sprintf("lock acquire cancelled: %v", context.Cause(ctx))))
+	}
+	if ttl <= 0 {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureError(
+			fmt.Sprintf("lock acquire failed: ttl must be positive, got %v", ttl)))
+	}
+
+	token, err := newRandomHex128(rand.Reader)
+	if err != nil {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureError(
+			fmt.Sprintf("lock acquire failed: %v", err)))
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if held, ok := l.leases[key]; ok && now.Before(held.ExpiresAt) {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureError(
+			fmt.Sprintf("lock %q is held", key)))
+	}
+
+	lease := model.Lease{Key: key, Token: token, ExpiresAt: now.Add(ttl)}
+	l.leases[key] = lease
+	return domerr.Ok(lease)
+}
+
+// Release frees the lock if lease is still the current holder.
+//
+// Contract:
+//   - Returns Ok(Unit) whether or not the lease was still current
+//   - Never releases a lock re-acquired under a different token
+func (l *MemoryLock) Release(ctx context.Context, lease model.Lease) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("lock release panicked: %v", r)))
+		}
+	}()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if held, ok := l.leases[lease.Key]; ok && held.Token == lease.Token {
+		delete(l.leases, lease.Key)
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// newRandomHex128 returns a random 128-bit value from entropy as 32 hex
+// characters (lock lease tokens, Sentry event IDs).
+func newRandomHex128(entropy io.Reader) (string, error) {
+	var b [16]byte
+	if _, err := io.ReadFull(entropy, b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
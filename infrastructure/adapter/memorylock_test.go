@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterMemoryLock tests the in-memory LockPort adapter.
+func TestInfrastructureAdapterMemoryLock(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.MemoryLock")
+	ctx := context.Background()
+	locks := NewMemoryLock()
+
+	r1 := locks.Acquire(ctx, "job", time.Minute)
+	tf.RunTest("Acquire free key - IsOk", r1.IsOk())
+	tf.RunTest("Acquire free key - token set", r1.IsOk() && r1.Value().Token != "")
+	tf.RunTest("Acquire free key - key set", r1.IsOk() && r1.Value().Key == "job")
+
+	r2 := locks.Acquire(ctx, "job", time.Minute)
+	tf.RunTest("Acquire held key - IsError", r2.IsError())
+
+	tf.RunTest("Acquire other key - IsOk", locks.Acquire(ctx, "other", time.Minute).IsOk())
+
+	stale := model.Lease{Key: "job", Token: "not-the-holder"}
+	tf.RunTest("Release foreign token - IsOk", locks.Release(ctx, stale).IsOk())
+	tf.RunTest("Release foreign token - lock kept",
+		locks.Acquire(ctx, "job", time.Minute).IsError())
+
+	tf.RunTest("Release holder - IsOk", locks.Release(ctx, r1.Value()).IsOk())
+	tf.RunTest("Acquire after Release - IsOk", locks.Acquire(ctx, "job", time.Minute).IsOk())
+
+	tf.RunTest("Acquire short ttl - IsOk", locks.Acquire(ctx, "ttl", time.Millisecond).IsOk())
+	time.Sleep(5 * time.Millisecond)
+	tf.RunTest("Acquire after expiry - IsOk", locks.Acquire(ctx, "ttl", time.Minute).IsOk())
+
+	tf.RunTest("Acquire zero ttl - IsError", locks.Acquire(ctx, "zero", 0).IsError())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Acquire cancelled - IsError", locks.Acquire(cancelled, "c", time.Minute).IsError())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Desktop notification output adapter
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// NotificationWriter is an infrastructure adapter that shows each message as
+// a desktop notification using the platform's native tool:
+//
+//   - Linux/BSD: notify-send
+//   - macOS:     osascript (display notification)
+//   - Windows:   powershell (Windows.UI.Notifications toast)
+//
+// Graceful Fallback:
+//   - If the platform has no supported tool, or the tool fails, the message
+//     is written to the fallback ConsoleWriter instead, so output is never lost
+//   - Only a failing fallback produces an Err
+//
+// Implements: outbound.WriterPort
+type NotificationWriter struct {
+	title    string
+	goos     string
+	fallback *ConsoleWriter
+
+	// Seams for tests; default to exec.LookPath and exec.CommandContext.Run.
+	lookPath func(file string) (string, error)
+	run      func(ctx context.Context, name string, args ...string) error
+}
+
+// NewNotificationWriter creates a NotificationWriter for the current platform.
+// Notifications carry the given title; the fallback writes to stdout.
+//
+// Usage:
+//
+//	writer := adapter.NewNotificationWriter("Greeter")
+//	uc := usecase.NewGreetUseCase[*adapter.NotificationWriter](writer)
+func NewNotificationWriter(title string) *NotificationWriter {
+	return &NotificationWriter{
+		title:    title,
+		goos:     runtime.GOOS,
+		fallback: NewConsoleWriter(),
+		lookPath: exec.LookPath,
+		run: func(ctx context.Context, name string, args ...string) error {
+			// #nosec G204 -- name is a fixed tool; message travels as an argument
+			return exec.CommandContext(ctx, name, args...).Run()
+		},
+	}
+}
+
+// Supported reports whether the current platform has a notification tool.
+// When false, every Write goes to the fallback writer.
+func (nw *NotificationWriter) Supported() bool {
+	name, _ := notifyCommand(nw.goos, nw.title, "")
+	if name == "" {
+		return false
+	}
+	_, err := nw.lookPath(name)
+	return err == nil
+}
+
+// Write shows message as a desktop notification, falling back to console.
+//
+// Contract:
+//   - Returns Ok(Unit) if the notification was shown or the fallback succeeded
+//   - Returns Err(InfrastructureError) if ctx is cancelled or the fallback fails
+//   - Never panics (panics are caught and converted to Err)
+func (nw *NotificationWriter) Write(ctx context.Context, message string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("notification panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("notification cancelled: %v", context.Cause(ctx))))
+	}
+
+	name, args := notifyCommand(nw.goos, nw.title, message)
+	if name != "" {
+		if path, err := nw.lookPath(name); err == nil {
+			if err := nw.run(ctx, path, args...); err == nil {
+				return domerr.Ok(model.UnitValue)
+			}
+		}
+	}
+
+	return nw.fallback.Write(ctx, message)
+}
+
+// notifyCommand returns the tool and arguments that show a notification on
+// goos, or an empty name if the platform is unsupported.
+func notifyCommand(goos, title, message string) (string, []string) {
+	switch goos {
+	case "linux", "freebsd", "openbsd", "netbsd", "dragonfly":
+		return "notify-send", []string{"--", title, message}
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s",
+			appleScriptString(message), appleScriptString(title))
+		return "osascript", []string{"-e", script}
+	case "windows":
+		script := fmt.Sprintf(windowsToastScript,
+			powerShellString(title), powerShellString(message))
+		return "powershell", []string{"-NoProfile", "-NonInteractive", "-Command", script}
+	default:
+		return "", nil
+	}
+}
+
+// windowsToastScript shows a two-line toast via the WinRT notification API.
+const windowsToastScript = `[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null; ` +
+	`$xml = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02); ` +
+	`$text = $xml.GetElementsByTagName('text'); ` +
+	`$text.Item(0).AppendChild($xml.CreateTextNode(%s)) > $null; ` +
+	`$text.Item(1).AppendChild($xml.CreateTextNode(%s)) > $null; ` +
+	`[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('PowerShell').Show([Windows.UI.Notifications.ToastNotification]::new($xml))`
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+// powerShellString quotes s as a single-quoted PowerShell string literal.
+func powerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
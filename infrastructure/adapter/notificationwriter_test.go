@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// newTestNotificationWriter builds a NotificationWriter with fake seams.
+func newTestNotificationWriter(goos string, found bool, runErr error, buf *bytes.Buffer) (*NotificationWriter, *[]string) {
+	var calls []string
+	nw := &NotificationWriter{
+		title:    "Greeter",
+		goos:     goos,
+		fallback: NewWriter(buf),
+		lookPath: func(file string) (string, error) {
+			if !found {
+				return "", errors.New("not found")
+			}
+			return "/usr/bin/" + file, nil
+		},
+		run: func(_ context.Context, name string, args ...string) error {
+			calls = append(calls, name+" "+strings.Join(args, " "))
+			return runErr
+		},
+	}
+	return nw, &calls
+}
+
+// TestInfrastructureAdapterNotificationWriter tests the notification adapter.
+func TestInfrastructureAdapterNotificationWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.NotificationWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Tool available - notification shown, no fallback
+	// ========================================================================
+
+	var buf1 bytes.Buffer
+	nw1, calls1 := newTestNotificationWriter("linux", true, nil, &buf1)
+	r1 := nw1.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Linux notify - IsOk", r1.IsOk())
+	tf.RunTest("Linux notify - notify-send invoked",
+		len(*calls1) == 1 && strings.HasPrefix((*calls1)[0], "/usr/bin/notify-send -- Greeter Hello, Alice!"))
+	tf.RunTest("Linux notify - no fallback output", buf1.Len() == 0)
+	tf.RunTest("Linux notify - Supported", nw1.Supported())
+
+	// ========================================================================
+	// Test: Tool missing - falls back to console
+	// ========================================================================
+
+	var buf2 bytes.Buffer
+	nw2, calls2 := newTestNotificationWriter("linux", false, nil, &buf2)
+	r2 := nw2.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Tool missing - IsOk", r2.IsOk())
+	tf.RunTest("Tool missing - no command run", len(*calls2) == 0)
+	tf.RunTest("Tool missing - fallback output", buf2.String() == "Hello, Bob!\n")
+	tf.RunTest("Tool missing - not Supported", !nw2.Supported())
+
+	// ========================================================================
+	// Test: Tool fails - falls back to console
+	// ========================================================================
+
+	var buf3 bytes.Buffer
+	nw3, _ := newTestNotificationWriter("darwin", true, errors.New("exit 1"), &buf3)
+	r3 := nw3.Write(ctx, "Hello, Carol!")
+	tf.RunTest("Tool fails - IsOk", r3.IsOk())
+	tf.RunTest("Tool fails - fallback output", buf3.String() == "Hello, Carol!\n")
+
+	// ========================================================================
+	// Test: Unsupported platform - falls back to console
+	// ========================================================================
+
+	var buf4 bytes.Buffer
+	nw4, _ := newTestNotificationWriter("plan9", true, nil, &buf4)
+	tf.RunTest("Unsupported platform - IsOk", nw4.Write(ctx, "Hi").IsOk())
+	tf.RunTest("Unsupported platform - fallback output", buf4.String() == "Hi\n")
+	tf.RunTest("Unsupported platform - not Supported", !nw4.Supported())
+
+	// ========================================================================
+	// Test: Quoting of platform scripts
+	// ========================================================================
+
+	_, macArgs := notifyCommand("darwin", "T", `say "hi" \o/`)
+	tf.RunTest("AppleScript quoting escapes quotes and backslashes",
+		len(macArgs) == 2 && strings.Contains(macArgs[1], `"say \"hi\" \\o/"`))
+	_, winArgs := notifyCommand("windows", "T", "it's")
+	tf.RunTest("PowerShell quoting doubles single quotes",
+		len(winArgs) == 4 && strings.Contains(winArgs[3], "'it''s'"))
+
+	// ========================================================================
+	// Test: Cancelled context
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	var buf5 bytes.Buffer
+	nw5, calls5 := newTestNotificationWriter("linux", true, nil, &buf5)
+	tf.RunTest("Cancelled - IsError", nw5.Write(cancelled, "x").IsError())
+	tf.RunTest("Cancelled - nothing shown", len(*calls5) == 0 && buf5.Len() == 0)
+
+	tf.Summary(t)
+}
@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Functional options for adapter constructors
+
+package adapter
+
+import (
+	"compress/gzip"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+)
+
+// Adapter constructors take their required settings positionally (an
+// address, a DSN, a title) and every optional knob as a functional option,
+// so adding a knob never breaks existing callers:
+//
+//	writer := adapter.NewConsoleWriter(adapter.WithTimestamp(), adapter.WithPrefix("greeter: "))
+//	vault := adapter.NewVaultSecrets(addr, token, "secret", adapter.WithTimeout(3*time.Second))
+//
+// Option families (one type per group of adapters sharing the knobs):
+//   - ConsoleOption: ConsoleWriter
+//   - HTTPOption:    SentryReporter, VaultSecrets, S3BlobStore, SlackWriter, SMSWriter
+//   - DialOption:    RedisCache, RedisLock, SyslogWriter
+//   - IDOption:      UUIDv7Generator, ULIDGenerator
+//   - CompressionOption: CompressedBlobStore
+//
+// Validation: options are checked together after all are applied. An
+// invalid value or combination is not fatal at construction (constructors
+// never panic or return errors); every operation of the adapter then
+// returns Err(InfrastructureError) "... misconfigured: ...", the same way
+// SentryReporter treats an invalid DSN.
+
+// ============================================================================
+// Console
+// ============================================================================
+
+// ConsoleOption configures a ConsoleWriter.
+type ConsoleOption func(*consoleConfig)
+
+type consoleConfig struct {
+	out       io.Writer
+	timestamp bool
+	layout    string
+	prefix    string
+	color     ColorMode
+	wrap      int
+	wrapSet   bool
+	clock     outbound.ClockPort
+}
+
+// WithOutput sets the destination writer (default: os.Stdout).
+func WithOutput(w io.Writer) ConsoleOption {
+	return func(c *consoleConfig) { c.out = w }
+}
+
+// WithTimestamp prefixes each line with the current time in RFC 3339 format.
+func WithTimestamp() ConsoleOption {
+	return func(c *consoleConfig) { c.timestamp = true }
+}
+
+// WithTimestampLayout prefixes each line with the current time in layout
+// (a time.Format layout). Implies WithTimestamp.
+func WithTimestampLayout(layout string) ConsoleOption {
+	return func(c *consoleConfig) { c.timestamp, c.layout = true, layout }
+}
+
+// WithPrefix writes prefix verbatim before each message (after any timestamp).
+func WithPrefix(prefix string) ConsoleOption {
+	return func(c *consoleConfig) { c.prefix = prefix }
+}
+
+// WithColor selects ANSI coloring: Write in green, WriteError in red
+// (default: ColorNever).
+func WithColor(mode ColorMode) ConsoleOption {
+	return func(c *consoleConfig) { c.color = mode }
+}
+
+// WithWrap wraps lines at width terminal columns (see DisplayWidth),
+// breaking at spaces. A width of 0 uses the COLUMNS environment variable,
+// falling back to 80.
+func WithWrap(width int) ConsoleOption {
+	return func(c *consoleConfig) { c.wrap, c.wrapSet = width, true }
+}
+
+// WithConsoleClock takes line timestamps and receipt times from clock
+// (default: SystemClock).
+func WithConsoleClock(clock outbound.ClockPort) ConsoleOption {
+	return func(c *consoleConfig) { c.clock = clock }
+}
+
+func (c *consoleConfig) validate() error {
+	if c.out == nil {
+		return errors.New("output writer is nil")
+	}
+	if c.color < ColorNever || c.color > ColorAlways {
+		return fmt.Errorf("unknown color mode %d", c.color)
+	}
+	if c.wrapSet && c.wrap < 0 {
+		return fmt.Errorf("wrap width must not be negative, got %d", c.wrap)
+	}
+	if c.timestamp && c.layout == "" {
+		return errors.New("timestamp layout is empty")
+	}
+	if c.clock == nil {
+		return errors.New("clock is nil")
+	}
+	return nil
+}
+
+// ============================================================================
+// HTTP
+// ============================================================================
+
+// HTTPOption configures an adapter that talks HTTP.
+type HTTPOption func(*httpConfig)
+
+// defaultHTTPTimeout bounds each request when neither WithTimeout nor
+// WithHTTPClient is given.
+const defaultHTTPTimeout = 10 * time.Second
+
+type httpConfig struct {
+	client          *http.Client
+	clientSet       bool
+	timeout         time.Duration
+	timeoutSet      bool
+	cassette        *cassetteConfig
+	cassetteSecrets cassetteSecrets
+	clock           outbound.ClockPort
+	release         string
+	entropy         io.Reader
+}
+
+// WithHTTPClient uses client for every request (proxies, TLS settings,
+// instrumentation). Mutually exclusive with WithTimeout: set the client's
+// own Timeout instead.
+func WithHTTPClient(client *http.Client) HTTPOption {
+	return func(c *httpConfig) { c.client, c.clientSet = client, true }
+}
+
+// WithTimeout sets the per-request timeout of the default client
+// (default: 10s).
+func WithTimeout(d time.Duration) HTTPOption {
+	return func(c *httpConfig) { c.timeout, c.timeoutSet = d, true }
+}
+
+// WithHTTPClock takes the timestamps an adapter puts in its payloads from
+// clock (default: SystemClock). Used by SentryReporter for event and
+// envelope times; request signing and throttling keep the system time.
+func WithHTTPClock(clock outbound.ClockPort) HTTPOption {
+	return func(c *httpConfig) { c.clock = clock }
+}
+
+// WithHTTPEntropy draws the random IDs an adapter generates from r
+// (default: crypto/rand.Reader). Used by SentryReporter for event IDs.
+// Use a seeded reader only for deterministic tests; r must be safe for
+// concurrent use if the adapter is used concurrently.
+func WithHTTPEntropy(r io.Reader) HTTPOption {
+	return func(c *httpConfig) { c.entropy = r }
+}
+
+// newHTTPConfig applies opts, then the adapter's own options (e.g.
+// redactInCassette), and resolves the client to use.
+func newHTTPConfig(opts []HTTPOption, own ...HTTPOption) (httpConfig, error) {
+	c := httpConfig{timeout: defaultHTTPTimeout, clock: NewSystemClock(), entropy: rand.Reader}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	for _, opt := range own {
+		opt(&c)
+	}
+	switch {
+	case c.clientSet && c.client == nil:
+		return c, errors.New("HTTP client is nil")
+	case c.clientSet && c.timeoutSet:
+		return c, errors.New("WithTimeout cannot be combined with WithHTTPClient; set the client's Timeout")
+	case c.timeout <= 0:
+		return c, fmt.Errorf("timeout must be positive, got %v", c.timeout)
+	case c.clock == nil:
+		return c, errors.New("clock is nil")
+	case c.entropy == nil:
+		return c, errors.New("entropy source is nil")
+	}
+	if !c.clientSet {
+		c.client = &http.Client{Timeout: c.timeout}
+	}
+	if c.cassette != nil {
+		client, err := c.cassette.wrap(c.client, c.cassetteSecrets)
+		c.client = client
+		return c, err
+	}
+	return c, nil
+}
+
+// ============================================================================
+// Dial
+// ============================================================================
+
+// DialOption configures an adapter that holds a network connection.
+type DialOption func(*dialConfig)
+
+type dialConfig struct {
+	timeout time.Duration
+	clock   outbound.ClockPort
+}
+
+// WithDialTimeout bounds connection establishment when ctx has no earlier
+// deadline (default: 5s).
+func WithDialTimeout(d time.Duration) DialOption {
+	return func(c *dialConfig) { c.timeout = d }
+}
+
+// WithDialClock takes message timestamps and receipt times from clock
+// (default: SystemClock). Used by SyslogWriter; cache and lock TTLs keep
+// the system time.
+func WithDialClock(clock outbound.ClockPort) DialOption {
+	return func(c *dialConfig) { c.clock = clock }
+}
+
+func newDialConfig(defaultTimeout time.Duration, opts []DialOption) (dialConfig, error) {
+	c := dialConfig{timeout: defaultTimeout, clock: NewSystemClock()}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.timeout <= 0 {
+		return c, fmt.Errorf("dial timeout must be positive, got %v", c.timeout)
+	}
+	if c.clock == nil {
+		return c, errors.New("clock is nil")
+	}
+	return c, nil
+}
+
+// ============================================================================
+// ID generators
+// ============================================================================
+
+// IDOption configures a time-ordered ID generator.
+type IDOption func(*idConfig)
+
+type idConfig struct {
+	clock   outbound.ClockPort
+	entropy io.Reader
+}
+
+// WithClock sets the time source (default: SystemClock).
+func WithClock(clock outbound.ClockPort) IDOption {
+	return func(c *idConfig) { c.clock = clock }
+}
+
+// WithEntropy sets the random source (default: crypto/rand.Reader).
+// Use a seeded reader only for deterministic tests.
+func WithEntropy(r io.Reader) IDOption {
+	return func(c *idConfig) { c.entropy = r }
+}
+
+func newIDConfig(opts []IDOption) idConfig {
+	c := idConfig{clock: NewSystemClock(), entropy: rand.Reader}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+func (c *idConfig) validate() error {
+	if c.clock == nil {
+		return errors.New("clock is nil")
+	}
+	if c.entropy == nil {
+		return errors.New("entropy source is nil")
+	}
+	return nil
+}
+
+// ============================================================================
+// Compression
+// ============================================================================
+
+// CompressionOption configures a CompressedBlobStore.
+type CompressionOption func(*compressionConfig)
+
+type compressionConfig struct {
+	codec Codec
+}
+
+// WithCodec selects the compression codec (default: GzipCodec at
+// gzip.DefaultCompression). Use it to plug in zstd or another format.
+func WithCodec(codec Codec) CompressionOption {
+	return func(c *compressionConfig) { c.codec = codec }
+}
+
+// WithGzipLevel selects gzip at level (gzip.BestSpeed .. gzip.BestCompression,
+// or gzip.HuffmanOnly).
+func WithGzipLevel(level int) CompressionOption {
+	return func(c *compressionConfig) { c.codec = GzipCodec{Level: level} }
+}
+
+func newCompressionConfig(opts []CompressionOption) (compressionConfig, error) {
+	c := compressionConfig{codec: GzipCodec{Level: gzip.DefaultCompression}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.codec == nil {
+		return c, errors.New("codec is nil")
+	}
+	if len(c.codec.Magic()) == 0 {
+		return c, fmt.Errorf("codec %s has no magic bytes", c.codec.Name())
+	}
+	// Reject an invalid level (or codec setting) now rather than per Put.
+	w, err := c.codec.NewWriter(io.Discard)
+	if err != nil {
+		return c, fmt.Errorf("codec %s: %w", c.codec.Name(), err)
+	}
+	return c, w.Close()
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterOptions tests functional options and their validation.
+func TestInfrastructureAdapterOptions(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Options")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	misconfigured := func(r domerr.Result[model.Unit]) bool {
+		return r.IsError() && r.ErrorInfo().Kind == domerr.InfrastructureError &&
+			strings.Contains(r.ErrorInfo().Message, "misconfigured")
+	}
+
+	// ========================================================================
+	// Test: Console options
+	// ========================================================================
+
+	var plain bytes.Buffer
+	NewWriter(&plain).Write(ctx, "Hello!")
+	tf.RunTest("Console - no options unchanged", plain.String() == "Hello!\n")
+
+	var decorated bytes.Buffer
+	cw := NewWriter(&decorated, WithTimestamp(), WithPrefix("greeter: "), WithConsoleClock(NewFrozenClock(at)))
+	cw.Write(ctx, "Hello!")
+	tf.RunTest("Console - timestamp and prefix", decorated.String() == "2025-06-01T12:00:00Z greeter: Hello!\n")
+
+	var custom bytes.Buffer
+	cw2 := NewConsoleWriter(WithOutput(&custom), WithTimestampLayout("15:04"), WithConsoleClock(NewFrozenClock(at)))
+	cw2.Write(ctx, "Hi")
+	tf.RunTest("Console - WithOutput and layout", custom.String() == "12:00 Hi\n")
+
+	tf.RunTest("Console - nil output rejected", misconfigured(NewConsoleWriter(WithOutput(nil)).Write(ctx, "x")))
+	tf.RunTest("Console - empty layout rejected",
+		misconfigured(NewWriter(&plain, WithTimestampLayout("")).Write(ctx, "x")))
+	tf.RunTest("Console - nil clock rejected", misconfigured(NewWriter(&plain, WithConsoleClock(nil)).Write(ctx, "x")))
+
+	// ========================================================================
+	// Test: HTTP options
+	// ========================================================================
+
+	client := &http.Client{Timeout: time.Second}
+	c1, err1 := newHTTPConfig(nil)
+	tf.RunTest("HTTP - default timeout", err1 == nil && c1.client.Timeout == defaultHTTPTimeout)
+	c2, err2 := newHTTPConfig([]HTTPOption{WithTimeout(3 * time.Second)})
+	tf.RunTest("HTTP - WithTimeout", err2 == nil && c2.client.Timeout == 3*time.Second)
+	c3, err3 := newHTTPConfig([]HTTPOption{WithHTTPClient(client)})
+	tf.RunTest("HTTP - WithHTTPClient used as-is", err3 == nil && c3.client == client)
+	_, err4 := newHTTPConfig([]HTTPOption{WithHTTPClient(client), WithTimeout(defaultHTTPTimeout)})
+	tf.RunTest("HTTP - client plus timeout rejected", err4 != nil)
+	_, err5 := newHTTPConfig([]HTTPOption{WithTimeout(0)})
+	tf.RunTest("HTTP - non-positive timeout rejected", err5 != nil)
+	_, err6 := newHTTPConfig([]HTTPOption{WithHTTPClient(nil)})
+	tf.RunTest("HTTP - nil client rejected", err6 != nil)
+	c7, err7 := newHTTPConfig([]HTTPOption{WithHTTPClock(NewFrozenClock(at))})
+	tf.RunTest("HTTP - WithHTTPClock", err7 == nil && c7.clock.Now().Equal(at))
+	tf.RunTest("HTTP - default clock", c1.clock == NewSystemClock())
+	_, err8 := newHTTPConfig([]HTTPOption{WithHTTPClock(nil)})
+	tf.RunTest("HTTP - nil clock rejected", err8 != nil)
+	tf.RunTest("HTTP - default entropy", c1.entropy == rand.Reader)
+	_, err9 := newHTTPConfig([]HTTPOption{WithHTTPEntropy(nil)})
+	tf.RunTest("HTTP - nil entropy rejected", err9 != nil)
+
+	tf.RunTest("Sentry - misconfigured reports error", misconfigured(
+		NewSentryReporter("https://k@example.com/1", WithTimeout(-1)).
+			Report(ctx, model.ErrorReport{Error: domerr.NewInfrastructureError("x")})))
+	vault := NewVaultSecrets("http://127.0.0.1:1", "t", "secret", WithHTTPClient(nil)).Get(ctx, "a/b")
+	tf.RunTest("Vault - misconfigured reports error", vault.IsError() &&
+		strings.Contains(vault.ErrorInfo().Message, "misconfigured"))
+
+	// ========================================================================
+	// Test: Dial and ID options
+	// ========================================================================
+
+	d1, derr1 := newDialConfig(5*time.Second, []DialOption{WithDialTimeout(time.Second)})
+	tf.RunTest("Dial - WithDialTimeout", derr1 == nil && d1.timeout == time.Second)
+	_, derr2 := newDialConfig(5*time.Second, []DialOption{WithDialTimeout(0)})
+	tf.RunTest("Dial - non-positive timeout rejected", derr2 != nil)
+	d3, derr3 := newDialConfig(5*time.Second, []DialOption{WithDialClock(NewFrozenClock(at))})
+	tf.RunTest("Dial - WithDialClock", derr3 == nil && d3.clock.Now().Equal(at))
+	_, derr4 := newDialConfig(5*time.Second, []DialOption{WithDialClock(nil)})
+	tf.RunTest("Dial - nil clock rejected", derr4 != nil)
+	tf.RunTest("Syslog - misconfigured reports error", misconfigured(
+		NewSyslogWriter("udp", "127.0.0.1:1", "app", FacilityUser, WithDialTimeout(-1)).Write(ctx, "x")))
+
+	ulid := NewULIDGenerator(WithClock(NewFrozenClock(at)), WithEntropy(bytes.NewReader(make([]byte, 10))))
+	id := ulid.NewID()
+	tf.RunTest("ID - WithClock and WithEntropy", id.IsOk() && id.Value() == "01JWNNSVG0"+strings.Repeat("0", 16))
+	bad := NewUUIDv7Generator(WithEntropy(nil)).NewID()
+	tf.RunTest("ID - nil entropy rejected", bad.IsError() && strings.Contains(bad.ErrorInfo().Message, "misconfigured"))
+	tf.RunTest("ID - nil clock rejected", NewULIDGenerator(WithClock(nil)).NewID().IsError())
+
+	tf.Summary(t)
+}
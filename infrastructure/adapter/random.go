@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Pseudo-random source adapter (math/rand/v2 PCG)
+
+package adapter
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+// MathRandom is a RandomPort backed by a math/rand/v2 PCG generator.
+//
+// Use NewSeededRandom in tests and simulations for a reproducible
+// sequence. Not cryptographically secure.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.RandomPort
+type MathRandom struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewMathRandom creates a source seeded from the runtime's random source,
+// so every process sees a different sequence.
+func NewMathRandom() *MathRandom {
+	return NewSeededRandom(rand.Uint64())
+}
+
+// NewSeededRandom creates a source whose sequence is fixed by seed.
+func NewSeededRandom(seed uint64) *MathRandom {
+	return &MathRandom{rng: rand.New(rand.NewPCG(seed, seed))}
+}
+
+// IntN returns a pseudo-random int in [0, n). It panics if n <= 0, like
+// math/rand; callers guarantee n > 0 (see outbound.RandomPort).
+func (m *MathRandom) IntN(n int) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rng.IntN(n)
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterRandom tests the RandomPort adapter.
+func TestInfrastructureAdapterRandom(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Random")
+
+	draw := func(m *MathRandom) []int {
+		out := make([]int, 20)
+		for i := range out {
+			out[i] = m.IntN(1000)
+		}
+		return out
+	}
+	equal := func(a, b []int) bool {
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	a, b := draw(NewSeededRandom(42)), draw(NewSeededRandom(42))
+	tf.RunTest("Same seed - same sequence", equal(a, b))
+	tf.RunTest("Different seed - different sequence", !equal(a, draw(NewSeededRandom(43))))
+
+	inRange := true
+	m := NewMathRandom()
+	for i := 0; i < 1000; i++ {
+		if v := m.IntN(3); v < 0 || v >= 3 {
+			inRange = false
+		}
+	}
+	tf.RunTest("IntN - within [0, n)", inRange)
+
+	tf.Summary(t)
+}
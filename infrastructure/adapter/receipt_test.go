@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// Compile-time checks for the optional receipt extension.
+var (
+	_ outbound.ReceiptWriterPort = (*ConsoleWriter)(nil)
+	_ outbound.ReceiptWriterPort = (*SyslogWriter)(nil)
+)
+
+// TestInfrastructureAdapterReceipts tests ConsoleWriter write receipts.
+func TestInfrastructureAdapterReceipts(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Receipts")
+	ctx := context.Background()
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	cw := NewWriter(&buf, WithPrefix("> "), WithConsoleClock(NewFrozenClock(at)))
+	r := cw.WriteWithReceipt(ctx, "Hello!")
+	tf.RunTest("Console receipt - IsOk", r.IsOk())
+	tf.RunTest("Console receipt - bytes include framing", r.IsOk() && r.Value().Bytes == buf.Len() && buf.Len() == 9)
+	tf.RunTest("Console receipt - timestamp", r.IsOk() && r.Value().WrittenAt.Equal(at))
+	tf.RunTest("Console receipt - buffer has no destination", r.IsOk() && r.Value().Destination == "")
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Console receipt - cancelled IsError", cw.WriteWithReceipt(cancelled, "x").IsError())
+
+	f, err := os.CreateTemp(t.TempDir(), "greetings")
+	tf.RunTest("describeDestination - stdout", describeDestination(os.Stdout) == "stdout")
+	tf.RunTest("describeDestination - stderr", describeDestination(os.Stderr) == "stderr")
+	tf.RunTest("describeDestination - file", err == nil && describeDestination(f) == "file:"+f.Name())
+
+	tf.Summary(t)
+}
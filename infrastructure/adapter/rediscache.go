@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Redis cache adapter (RESP over TCP, stdlib only)
+
+//go:build redis
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// RedisCache is a cache adapter that speaks the Redis RESP protocol directly.
+//
+// The adapter is compiled only with the "redis" build tag so deployments
+// that do not need Redis carry no extra code. It uses the standard library
+// only (no client SDK), keeping the infrastructure module dependency-free.
+//
+// Connection handling is shared with the other Redis adapters; see redisConn.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.CachePort
+type RedisCache struct {
+	conn *redisConn
+}
+
+// NewRedisCache creates a Redis cache adapter for the server at addr
+// ("host:port"). No connection is made until the first operation.
+//
+// Options: WithDialTimeout (default 5s).
+//
+// Usage:
+//
+//	cache := adapter.NewRedisCache("localhost:6379")
+//	result := cache.Get(ctx, "greeting:alice")
+func NewRedisCache(addr string, opts ...DialOption) *RedisCache {
+	return &RedisCache{conn: newRedisConn(addr, opts)}
+}
+
+// Get returns the cached value for key.
+//
+// Contract:
+//   - Returns Ok(Some(value)) on hit, Ok(None) on miss
+//   - Returns Err(InfrastructureError) on I/O, protocol, or server error
+func (c *RedisCache) Get(ctx context.Context, key string) (result domerr.Result[valueobject.Option[[]byte]]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[valueobject.Option[[]byte]](apperr.NewInfrastructureError(
+				fmt.Sprintf("redis get panicked: %v", r)))
+		}
+	}()
+
+	reply, err := c.conn.do(ctx, []byte("GET"), []byte(key))
+	if errors.Is(err, errRedisNil) {
+		return domerr.Ok(valueobject.None[[]byte]())
+	}
+	if err != nil {
+		return domerr.Err[valueobject.Option[[]byte]](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("redis get failed: %v", err)))
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return domerr.Err[valueobject.Option[[]byte]](apperr.NewInfrastructureError(
+			fmt.Sprintf("redis get failed: unexpected reply %T", reply)))
+	}
+	return domerr.Ok(valueobject.Some(value))
+}
+
+// Set stores value under key, with PX expiry when ttl > 0.
+//
+// Contract:
+//   - ttl <= 0 stores the value without expiry
+//   - Sub-millisecond ttl values are rounded up to 1ms
+//   - Returns Err(InfrastructureError) on I/O, protocol, or server error
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("redis set panicked: %v", r)))
+		}
+	}()
+
+	args := [][]byte{[]byte("SET"), []byte(key), value}
+	if ttl > 0 {
+		ms := ttl.Milliseconds()
+		if ms == 0 {
+			ms = 1
+		}
+		args = append(args, []byte("PX"), []byte(strconv.FormatInt(ms, 10)))
+	}
+
+	if _, err := c.conn.do(ctx, args...); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("redis set failed: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Delete removes key. Deleting a missing key succeeds.
+//
+// Contract:
+//   - Returns Ok(Unit) whether or not the key existed
+//   - Returns Err(InfrastructureError) on I/O, protocol, or server error
+func (c *RedisCache) Delete(ctx context.Context, key string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("redis delete panicked: %v", r)))
+		}
+	}()
+
+	if _, err := c.conn.do(ctx, []byte("DEL"), []byte(key)); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("redis delete failed: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Close releases the underlying connection, if any.
+func (c *RedisCache) Close() error {
+	return c.conn.close()
+}
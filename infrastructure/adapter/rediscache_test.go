@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+//go:build redis
+
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/porttest"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// fakeRedis is a minimal in-process RESP server supporting GET/SET/DEL
+// (with PX expiry) and the lock release script.
+type fakeRedis struct {
+	ln   net.Listener
+	mu   sync.Mutex
+	data map[string]string
+	px   map[string]string
+	exp  map[string]time.Time
+}
+
+func startFakeRedis(t *testing.T) *fakeRedis {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeRedis{ln: ln, data: map[string]string{}, px: map[string]string{}, exp: map[string]time.Time{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { _ = ln.Close() })
+	return s
+}
+
+func (s *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	rd := bufio.NewReader(conn)
+	for {
+		reply, err := readRESP(rd)
+		if err != nil {
+			return
+		}
+		items, _ := reply.([]any)
+		args := make([]string, len(items))
+		for i, it := range items {
+			b, _ := it.([]byte)
+			args[i] = string(b)
+		}
+		s.mu.Lock()
+		if len(args) > 1 {
+			s.expireLocked(args[1])
+		}
+		var out string
+		switch strings.ToUpper(args[0]) {
+		case "GET":
+			if v, ok := s.data[args[1]]; ok {
+				out = "$" + strconv.Itoa(len(v)) + "\r\n" + v + "\r\n"
+			} else {
+				out = "$-1\r\n"
+			}
+		case "SET":
+			nx := len(args) > 3 && strings.ToUpper(args[3]) == "NX"
+			if _, exists := s.data[args[1]]; nx && exists {
+				out = "$-1\r\n"
+				break
+			}
+			s.data[args[1]] = args[2]
+			delete(s.exp, args[1])
+			if len(args) >= 5 {
+				s.px[args[1]] = args[len(args)-1]
+				ms, _ := strconv.Atoi(args[len(args)-1])
+				s.exp[args[1]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+			}
+			out = "+OK\r\n"
+		case "EVAL":
+			// Only the lock release script is supported: compare-and-delete.
+			key, token := args[3], args[4]
+			s.expireLocked(key)
+			if s.data[key] == token {
+				delete(s.data, key)
+				out = ":1\r\n"
+			} else {
+				out = ":0\r\n"
+			}
+		case "DEL":
+			delete(s.data, args[1])
+			out = ":1\r\n"
+		default:
+			out = "-ERR unknown command\r\n"
+		}
+		s.mu.Unlock()
+		if _, err := conn.Write([]byte(out)); err != nil {
+			return
+		}
+	}
+}
+
+// expireLocked drops key if its PX deadline has passed. Caller holds s.mu.
+func (s *fakeRedis) expireLocked(key string) {
+	if at, ok := s.exp[key]; ok && time.Now().After(at) {
+		delete(s.data, key)
+		delete(s.exp, key)
+	}
+}
+
+// TestInfrastructureAdapterRedisCache tests the RESP-based CachePort adapter
+// against an in-process fake server.
+func TestInfrastructureAdapterRedisCache(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.RedisCache")
+	ctx := context.Background()
+	srv := startFakeRedis(t)
+	cache := NewRedisCache(srv.ln.Addr().String())
+	defer cache.Close()
+
+	r1 := cache.Get(ctx, "missing")
+	tf.RunTest("Get missing - IsOk", r1.IsOk())
+	tf.RunTest("Get missing - IsNone", r1.IsOk() && r1.Value().IsNone())
+
+	tf.RunTest("Set - IsOk", cache.Set(ctx, "k", []byte("hello"), 1500*time.Millisecond).IsOk())
+	srv.mu.Lock()
+	px := srv.px["k"]
+	srv.mu.Unlock()
+	tf.RunTest("Set - sends PX milliseconds", px == "1500")
+
+	r2 := cache.Get(ctx, "k")
+	tf.RunTest("Get hit - correct value", r2.IsOk() && string(r2.Value().Value()) == "hello")
+
+	tf.RunTest("Delete - IsOk", cache.Delete(ctx, "k").IsOk())
+	tf.RunTest("Get after Delete - IsNone", cache.Get(ctx, "k").Value().IsNone())
+
+	// Reconnect after the connection is dropped
+	_ = cache.Close()
+	tf.RunTest("Reconnect after Close - IsOk", cache.Set(ctx, "k2", []byte("x"), 0).IsOk())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled context - IsError", cache.Get(cancelled, "k2").IsError())
+
+	unreachable := NewRedisCache("127.0.0.1:1")
+	tf.RunTest("Unreachable server - IsError", unreachable.Get(ctx, "k").IsError())
+
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterRedisLock tests the Redis LockPort adapter
+// against an in-process fake server.
+func TestInfrastructureAdapterRedisLock(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.RedisLock")
+	ctx := context.Background()
+	srv := startFakeRedis(t)
+	locks := NewRedisLock(srv.ln.Addr().String())
+	defer locks.Close()
+
+	r1 := locks.Acquire(ctx, "job", 2*time.Second)
+	tf.RunTest("Acquire free key - IsOk", r1.IsOk())
+	srv.mu.Lock()
+	px := srv.px["job"]
+	srv.mu.Unlock()
+	tf.RunTest("Acquire - sends PX milliseconds", px == "2000")
+
+	tf.RunTest("Acquire held key - IsError", locks.Acquire(ctx, "job", time.Second).IsError())
+
+	stale := r1.Value()
+	stale.Token = "other"
+	tf.RunTest("Release foreign token - IsOk", locks.Release(ctx, stale).IsOk())
+	tf.RunTest("Release foreign token - lock kept", locks.Acquire(ctx, "job", time.Second).IsError())
+
+	tf.RunTest("Release holder - IsOk", locks.Release(ctx, r1.Value()).IsOk())
+	tf.RunTest("Acquire after Release - IsOk", locks.Acquire(ctx, "job", time.Second).IsOk())
+
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterRedisContracts runs the CachePort and LockPort
+// conformance suites against the Redis adapters and the fake server.
+func TestInfrastructureAdapterRedisContracts(t *testing.T) {
+	porttest.TestCachePortContract(t, func(t *testing.T) outbound.CachePort {
+		c := NewRedisCache(startFakeRedis(t).ln.Addr().String())
+		t.Cleanup(func() { _ = c.Close() })
+		return c
+	})
+	porttest.TestLockPortContract(t, func(t *testing.T) outbound.LockPort {
+		l := NewRedisLock(startFakeRedis(t).ln.Addr().String())
+		t.Cleanup(func() { _ = l.Close() })
+		return l
+	})
+}
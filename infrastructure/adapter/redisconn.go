@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Shared Redis connection handling (RESP over TCP, stdlib only)
+
+//go:build redis
+
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// redisDialTimeout bounds connection establishment when ctx has no deadline.
+const redisDialTimeout = 5 * time.Second
+
+// errRedisNil signals a RESP null bulk string (key not found).
+var errRedisNil = errors.New("redis: nil")
+
+// redisConn is a lazily dialed, self-healing RESP connection shared by the
+// Redis adapters.
+//
+// Connection Handling:
+//   - A single connection is dialed lazily and reused
+//   - Requests are serialized on that connection (mutex)
+//   - Any I/O or protocol error drops the connection; the next call redials
+//   - Server "-ERR" replies keep the connection (the stream is still in sync)
+//   - ctx deadlines are applied to the socket for every request
+type redisConn struct {
+	addr   string
+	dial   dialConfig
+	cfgErr error
+
+	mu   sync.Mutex
+	conn net.Conn
+	rd   *bufio.Reader
+}
+
+// newRedisConn creates an undialed connection to addr ("host:port").
+func newRedisConn(addr string, opts []DialOption) *redisConn {
+	dial, err := newDialConfig(redisDialTimeout, opts)
+	return &redisConn{addr: addr, dial: dial, cfgErr: err}
+}
+
+// close releases the underlying connection, if any.
+func (c *redisConn) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.dropLocked()
+}
+
+// do sends one command and reads one reply, reconnecting as needed.
+func (c *redisConn) do(ctx context.Context, args ...[]byte) (any, error) {
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("cancelled: %w", context.Cause(ctx))
+	}
+	if c.cfgErr != nil {
+		return nil, fmt.Errorf("misconfigured: %w", c.cfgErr)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		dialer := net.Dialer{Timeout: c.dial.timeout}
+		conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+		if err != nil {
+			return nil, err
+		}
+		c.conn = conn
+		c.rd = bufio.NewReader(conn)
+	}
+
+	deadline, _ := ctx.Deadline() // zero value clears any previous deadline
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		_ = c.dropLocked()
+		return nil, err
+	}
+
+	if _, err := c.conn.Write(encodeRESPCommand(args)); err != nil {
+		_ = c.dropLocked()
+		return nil, err
+	}
+
+	reply, err := readRESP(c.rd)
+	var serverErr redisServerError
+	if err != nil && !errors.Is(err, errRedisNil) && !errors.As(err, &serverErr) {
+		// Transport or protocol failure - the stream position is unknown.
+		_ = c.dropLocked()
+	}
+	return reply, err
+}
+
+// dropLocked closes and forgets the connection. Caller holds c.mu.
+func (c *redisConn) dropLocked() error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	c.rd = nil
+	return err
+}
+
+// redisServerError is an "-ERR ..." reply; the connection stays usable.
+type redisServerError string
+
+func (e redisServerError) Error() string { return "server: " + string(e) }
+
+// encodeRESPCommand encodes args as a RESP array of bulk strings.
+func encodeRESPCommand(args [][]byte) []byte {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, '\r', '\n')
+	for _, arg := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(arg)), 10)
+		buf = append(buf, '\r', '\n')
+		buf = append(buf, arg...)
+		buf = append(buf, '\r', '\n')
+	}
+	return buf
+}
+
+// readRESP reads a single RESP2 reply. Simple strings and bulk strings are
+// returned as []byte, integers as int64, arrays as []any.
+func readRESP(rd *bufio.Reader) (any, error) {
+	line, err := readRESPLine(rd)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errors.New("protocol error: empty reply line")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisServerError(line[1:])
+	case ':':
+		return strconv.ParseInt(string(line[1:]), 10, 64)
+	case '$':
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil {
+			return nil, fmt.Errorf("protocol error: bad bulk length: %w", err)
+		}
+		if n < 0 {
+			return nil, errRedisNil
+		}
+		data := make([]byte, n+2)
+		if _, err := io.ReadFull(rd, data); err != nil {
+			return nil, err
+		}
+		return data[:n], nil
+	case '*':
+		n, err := strconv.Atoi(string(line[1:]))
+		if err != nil {
+			return nil, fmt.Errorf("protocol error: bad array length: %w", err)
+		}
+		if n < 0 {
+			return nil, errRedisNil
+		}
+		items := make([]any, 0, n)
+		for i := 0; i < n; i++ {
+			item, err := readRESP(rd)
+			if err != nil && !errors.Is(err, errRedisNil) {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("protocol error: unexpected type byte %q", line[0])
+	}
+}
+
+// readRESPLine reads one CRLF-terminated line without the terminator.
+func readRESPLine(rd *bufio.Reader) ([]byte, error) {
+	line, err := rd.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, errors.New("protocol error: line not CRLF terminated")
+	}
+	return line[:len(line)-2], nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Redis lock adapter (SET NX PX / compare-and-delete)
+
+//go:build redis
+
+package adapter
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// redisReleaseScript deletes the key only if it still holds our token.
+const redisReleaseScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`
+
+// RedisLock is a LockPort adapter for coordinating multiple replicas through
+// a single Redis server.
+//
+// Algorithm (single-instance Redis lock):
+//   - Acquire: SET key token NX PX ttl
+//   - Release: Lua compare-and-delete on the token
+//
+// This is not Redlock: it assumes one Redis primary. Failover can in rare
+// cases grant the same lock twice, so protected work must stay idempotent.
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.LockPort
+type RedisLock struct {
+	conn *redisConn
+}
+
+// NewRedisLock creates a Redis lock adapter for the server at addr.
+// No connection is made until the first operation.
+//
+// Options: WithDialTimeout (default 5s).
+func NewRedisLock(addr string, opts ...DialOption) *RedisLock {
+	return &RedisLock{conn: newRedisConn(addr, opts)}
+}
+
+// Acquire grants the lock on key for ttl if no other holder exists.
+//
+// Contract:
+//   - Returns Ok(Lease) on success
+//   - Returns Err(InfrastructureError) if key is held, ttl <= 0,
+//     or Redis is unreachable
+func (l *RedisLock) Acquire(ctx context.Context, key string, ttl time.Duration) (result domerr.Result[model.Lease]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Lease](apperr.NewInfrastructureError(
+				fmt.Sprintf("redis lock acquire panicked: %v", r)))
+		}
+	}()
+
+	if ttl <= 0 {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureError(
+			fmt.Sprintf("redis lock acquire failed: ttl must be positive, got %v", ttl)))
+	}
+
+	token, err := newRandomHex128(rand.Reader)
+	if err != nil {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureError(
+			fmt.Sprintf("redis lock acquire failed: %v", err)))
+	}
+
+	ms := ttl.Milliseconds()
+	if ms == 0 {
+		ms = 1
+	}
+	now := time.Now()
+
+	_, err = l.conn.do(ctx, []byte("SET"), []byte(key), []byte(token),
+		[]byte("NX"), []byte("PX"), []byte(strconv.FormatInt(ms, 10)))
+	if errors.Is(err, errRedisNil) {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureError(
+			fmt.Sprintf("lock %q is held", key)))
+	}
+	if err != nil {
+		return domerr.Err[model.Lease](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("redis lock acquire failed: %v", err)))
+	}
+
+	return domerr.Ok(model.Lease{Key: key, Token: token, ExpiresAt: now.Add(ttl)})
+}
+
+// Release frees the lock if lease is still the current holder.
+//
+// Contract:
+//   - Returns Ok(Unit) whether or not the lease was still current
+//   - Returns Err(InfrastructureError) if Redis is unreachable
+func (l *RedisLock) Release(ctx context.Context, lease model.Lease) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("redis lock release panicked: %v", r)))
+		}
+	}()
+
+	_, err := l.conn.do(ctx, []byte("EVAL"), []byte(redisReleaseScript), []byte("1"),
+		[]byte(lease.Key), []byte(lease.Token))
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("redis lock release failed: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Close releases the underlying connection, if any.
+func (l *RedisLock) Close() error {
+	return l.conn.close()
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: HTTP Retry-After parsing and client-side throttling
+
+package adapter
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// parseRetryAfter decodes a Retry-After header value (RFC 9110 §10.2.3):
+// either delta-seconds or an HTTP-date relative to now.
+//
+// Returns 0 when the value is empty, malformed or already in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// withRetryAfter attaches the response's Retry-After hint to err when the
+// status says the server is throttling (429) or temporarily down (503).
+func withRetryAfter(err domerr.ErrorType, resp *http.Response) domerr.ErrorType {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return err
+	}
+	if d := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); d > 0 {
+		return err.WithRetryAfter(d)
+	}
+	return err
+}
+
+// throttle remembers the latest Retry-After deadline of an endpoint, so
+// calls made before it fail fast instead of hitting an endpoint that has
+// already asked us to back off.
+//
+// Concurrency: safe for concurrent use.
+type throttle struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// remaining returns how long calls must still wait; 0 means go ahead.
+func (t *throttle) remaining(now time.Time) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if now.Before(t.until) {
+		return t.until.Sub(now)
+	}
+	return 0
+}
+
+// note extends the deadline by err's RetryAfter hint, if any.
+func (t *throttle) note(err domerr.ErrorType, now time.Time) {
+	if err.RetryAfter <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until := now.Add(err.RetryAfter); until.After(t.until) {
+		t.until = until
+	}
+}
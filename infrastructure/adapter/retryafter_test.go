@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterRetryAfter tests Retry-After header decoding.
+func TestInfrastructureAdapterRetryAfter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.RetryAfter")
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tf.RunTest("Delta seconds", parseRetryAfter("120", now) == 2*time.Minute)
+	tf.RunTest("HTTP-date", parseRetryAfter("Sun, 01 Jun 2025 12:00:30 GMT", now) == 30*time.Second)
+	tf.RunTest("Past date - zero", parseRetryAfter("Sun, 01 Jun 2025 11:00:00 GMT", now) == 0)
+	tf.RunTest("Empty - zero", parseRetryAfter("", now) == 0)
+	tf.RunTest("Negative - zero", parseRetryAfter("-5", now) == 0)
+	tf.RunTest("Malformed - zero", parseRetryAfter("soon", now) == 0)
+
+	base := domerr.NewInfrastructureError("x")
+	resp := func(code int) *http.Response {
+		r := &http.Response{StatusCode: code, Header: http.Header{}}
+		r.Header.Set("Retry-After", "3")
+		return r
+	}
+	tf.RunTest("429 - hint attached", withRetryAfter(base, resp(http.StatusTooManyRequests)).RetryAfter == 3*time.Second)
+	tf.RunTest("503 - hint attached", withRetryAfter(base, resp(http.StatusServiceUnavailable)).RetryAfter == 3*time.Second)
+	tf.RunTest("500 - hint ignored", withRetryAfter(base, resp(http.StatusInternalServerError)).RetryAfter == 0)
+
+	tf.Summary(t)
+}
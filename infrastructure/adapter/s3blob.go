@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: S3-compatible blob storage adapter (stdlib HTTP + SigV4)
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// s3MaxErrorBytes caps how much of an S3 error response is read.
+const s3MaxErrorBytes = 64 << 10
+
+// S3BlobStore is a BlobPort adapter for Amazon S3 and S3-compatible
+// services (MinIO, Ceph RGW, R2), using the REST API directly (no SDK).
+//
+// Addressing: path-style, {endpoint}/{bucket}/{key}, which every
+// S3-compatible service accepts.
+//
+// Streaming:
+//   - Get returns the response body unbuffered
+//   - Put streams bodies whose length is known (bytes/strings readers,
+//     files); other readers are spooled to a temporary file first, because
+//     S3 rejects PUTs without Content-Length
+//   - Payloads are sent UNSIGNED-PAYLOAD; use an https endpoint
+//
+// Scope: single-request PUT (objects up to 5 GiB); no multipart upload.
+//
+// Implements: outbound.BlobPort
+type S3BlobStore struct {
+	endpoint *url.URL
+	bucket   string
+	signer   sigV4Signer
+	client   *http.Client
+	cfgErr   error
+	now      func() time.Time
+}
+
+// NewS3BlobStore creates an S3 blob store.
+//
+// Parameters:
+//   - endpoint: service base URL, e.g. "https://s3.eu-west-1.amazonaws.com"
+//     or "http://localhost:9000" for MinIO
+//   - region: signing region, e.g. "eu-west-1" ("us-east-1" for MinIO)
+//   - bucket: bucket name
+//   - accessKey/secretKey: static credentials
+//   - opts: WithHTTPClient, WithTimeout (default 10s; raise it for large objects)
+//
+// An invalid endpoint or option is not fatal at construction; every
+// operation then returns Err(InfrastructureError) describing the problem.
+func NewS3BlobStore(endpoint, region, bucket, accessKey, secretKey string, opts ...HTTPOption) *S3BlobStore {
+	cfg, cfgErr := newHTTPConfig(opts)
+	u, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+		err = fmt.Errorf("endpoint %q must be an http(s) URL", endpoint)
+	}
+	if cfgErr == nil {
+		cfgErr = err
+	}
+	return &S3BlobStore{
+		endpoint: u,
+		bucket:   bucket,
+		signer:   sigV4Signer{accessKey: accessKey, secretKey: secretKey, region: region, service: "s3"},
+		client:   cfg.client,
+		cfgErr:   cfgErr,
+		now:      time.Now,
+	}
+}
+
+// Put uploads body as the object key.
+func (s *S3BlobStore) Put(ctx context.Context, key string, body io.Reader) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("s3 put panicked: %v", r)))
+		}
+	}()
+
+	if err := s.checkKey(ctx, key); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("s3 put %q failed: %v", key, err)))
+	}
+
+	sized, size, cleanup, err := sizeBody(body)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("s3 put %q failed: %v", key, err)))
+	}
+	defer cleanup()
+
+	resp, err := s.do(ctx, http.MethodPut, key, nil, sized, size)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("s3 put %q failed: %v", key, err)))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return domerr.Err[model.Unit](s3StatusError("put", key, resp))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// Get downloads the object key. The caller must Close the returned body.
+func (s *S3BlobStore) Get(ctx context.Context, key string) (result domerr.Result[io.ReadCloser]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+				fmt.Sprintf("s3 get panicked: %v", r)))
+		}
+	}()
+
+	if err := s.checkKey(ctx, key); err != nil {
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("s3 get %q failed: %v", key, err)))
+	}
+	resp, err := s.do(ctx, http.MethodGet, key, nil, nil, 0)
+	if err != nil {
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("s3 get %q failed: %v", key, err)))
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return domerr.Ok(resp.Body)
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return domerr.Err[io.ReadCloser](apperr.NewInfrastructureError(
+			fmt.Sprintf("blob %q not found", key)))
+	}
+	defer resp.Body.Close()
+	return domerr.Err[io.ReadCloser](s3StatusError("get", key, resp))
+}
+
+// s3ListResult is the subset of a ListObjectsV2 response we need.
+type s3ListResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+}
+
+// List returns every object whose key starts with prefix, following
+// ListObjectsV2 pagination.
+func (s *S3BlobStore) List(ctx context.Context, prefix string) (result domerr.Result[[]model.BlobInfo]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[[]model.BlobInfo](apperr.NewInfrastructureError(
+				fmt.Sprintf("s3 list panicked: %v", r)))
+		}
+	}()
+
+	if err := s.ready(ctx); err != nil {
+		return domerr.Err[[]model.BlobInfo](apperr.NewInfrastructureError(
+			fmt.Sprintf("s3 list failed: %v", err)))
+	}
+
+	infos := []model.BlobInfo{}
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		pageResult := s.listPage(ctx, query)
+		if pageResult.IsError() {
+			return domerr.Err[[]model.BlobInfo](pageResult.ErrorInfo())
+		}
+		page := pageResult.Value()
+		for _, c := range page.Contents {
+			infos = append(infos, model.BlobInfo{Key: c.Key, Size: c.Size, ModifiedAt: c.LastModified.UTC()})
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			break
+		}
+		token = page.NextContinuationToken
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Key < infos[j].Key })
+	return domerr.Ok(infos)
+}
+
+// listPage fetches and decodes one ListObjectsV2 page.
+func (s *S3BlobStore) listPage(ctx context.Context, query url.Values) domerr.Result[s3ListResult] {
+	resp, err := s.do(ctx, http.MethodGet, "", query, nil, 0)
+	if err != nil {
+		return domerr.Err[s3ListResult](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("s3 list failed: %v", err)))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return domerr.Err[s3ListResult](s3StatusError("list", s.bucket, resp))
+	}
+	var page s3ListResult
+	if err := xml.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return domerr.Err[s3ListResult](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("s3 list failed: invalid response: %v", err)))
+	}
+	return domerr.Ok(page)
+}
+
+// Delete removes the object key; S3 treats a missing key as success.
+func (s *S3BlobStore) Delete(ctx context.Context, key string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("s3 delete panicked: %v", r)))
+		}
+	}()
+
+	if err := s.checkKey(ctx, key); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("s3 delete %q failed: %v", key, err)))
+	}
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil, 0)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("s3 delete %q failed: %v", key, err)))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusNotFound {
+		return domerr.Err[model.Unit](s3StatusError("delete", key, resp))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// ready reports a configuration error or a done ctx.
+func (s *S3BlobStore) ready(ctx context.Context) error {
+	if s.cfgErr != nil {
+		return fmt.Errorf("store misconfigured: %w", s.cfgErr)
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("cancelled: %w", context.Cause(ctx))
+	}
+	return nil
+}
+
+// checkKey is ready plus key validation.
+func (s *S3BlobStore) checkKey(ctx context.Context, key string) error {
+	if err := s.ready(ctx); err != nil {
+		return err
+	}
+	return validateBlobKey(key)
+}
+
+// do sends one signed request for key (or the bucket when key is "").
+func (s *S3BlobStore) do(ctx context.Context, method, key string, query url.Values, body io.Reader, size int64) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = s.endpoint.Path + "/" + s.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = sigV4EscapePath(u.Path)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+		req.Body = io.NopCloser(body)
+		if size == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	req.Header.Set("X-Amz-Content-Sha256", sigV4UnsignedPayload)
+	s.signer.sign(req, s.now())
+	return s.client.Do(req)
+}
+
+// sizeBody returns body with its length, spooling to a temp file when the
+// length cannot be determined. cleanup must always be called.
+func sizeBody(body io.Reader) (io.Reader, int64, func(), error) {
+	noop := func() {}
+	switch b := body.(type) {
+	case *bytes.Reader:
+		return b, int64(b.Len()), noop, nil
+	case *strings.Reader:
+		return b, int64(b.Len()), noop, nil
+	case *bytes.Buffer:
+		return b, int64(b.Len()), noop, nil
+	case *os.File:
+		if info, err := b.Stat(); err == nil && info.Mode().IsRegular() {
+			if offset, err := b.Seek(0, io.SeekCurrent); err == nil {
+				return b, info.Size() - offset, noop, nil
+			}
+		}
+	}
+
+	spool, err := os.CreateTemp("", "s3-put-*")
+	if err != nil {
+		return nil, 0, noop, err
+	}
+	cleanup := func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}
+	size, err := io.Copy(spool, body)
+	if err == nil {
+		_, err = spool.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		cleanup()
+		return nil, 0, noop, err
+	}
+	return spool, size, cleanup, nil
+}
+
+// s3StatusError builds an InfrastructureError from a failed response,
+// including the S3 error code when the body carries one.
+func s3StatusError(op, key string, resp *http.Response) domerr.ErrorType {
+	var body struct {
+		Code string `xml:"Code"`
+	}
+	_ = xml.NewDecoder(io.LimitReader(resp.Body, s3MaxErrorBytes)).Decode(&body)
+	detail := resp.Status
+	if body.Code != "" {
+		detail += " (" + body.Code + ")"
+	}
+	return withRetryAfter(apperr.NewInfrastructureErrorTrace(
+		fmt.Sprintf("s3 %s %q failed: unexpected status %s", op, key, detail)), resp)
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterSecrets tests the SecretPort adapters.
+func TestInfrastructureAdapterSecrets(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Secrets")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: EnvSecrets
+	// ========================================================================
+
+	env := NewEnvSecrets("GREETER_")
+	env.lookup = func(name string) (string, bool) {
+		if name == "GREETER_SMTP_PASSWORD" {
+			return "s3cret", true
+		}
+		return "", false
+	}
+	tf.RunTest("Env - variable name mapping",
+		env.VariableName("smtp/password") == "GREETER_SMTP_PASSWORD")
+
+	r1 := env.Get(ctx, "smtp/password")
+	tf.RunTest("Env - existing key IsOk", r1.IsOk())
+	tf.RunTest("Env - existing key value", r1.IsOk() && string(r1.Value().Bytes()) == "s3cret")
+	tf.RunTest("Env - missing key IsError", env.Get(ctx, "kafka/password").IsError())
+
+	secret := r1.Value()
+	tf.RunTest("Secret - printing is redacted",
+		fmt.Sprintf("%v %s %#v", secret, secret, secret) == "[REDACTED] [REDACTED] [REDACTED]")
+	secret.Release()
+	tf.RunTest("Secret - Release zeroizes", secret.IsEmpty())
+
+	// ========================================================================
+	// Test: VaultSecrets against a fake KV v2 server
+	// ========================================================================
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "tok" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/greeter/smtp" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"data":{"password":"vault-pw","port":25}}}`)
+	}))
+	defer srv.Close()
+
+	vault := NewVaultSecrets(srv.URL+"/", "tok", "secret")
+	r2 := vault.Get(ctx, "greeter/smtp/password")
+	tf.RunTest("Vault - existing field IsOk", r2.IsOk())
+	tf.RunTest("Vault - existing field value", r2.IsOk() && string(r2.Value().Bytes()) == "vault-pw")
+	tf.RunTest("Vault - missing field IsError", vault.Get(ctx, "greeter/smtp/user").IsError())
+	tf.RunTest("Vault - non-string field IsError", vault.Get(ctx, "greeter/smtp/port").IsError())
+	tf.RunTest("Vault - missing secret IsError", vault.Get(ctx, "greeter/kafka/password").IsError())
+	tf.RunTest("Vault - malformed key IsError", vault.Get(ctx, "password").IsError())
+
+	badToken := NewVaultSecrets(srv.URL, "wrong", "secret")
+	tf.RunTest("Vault - forbidden IsError", badToken.Get(ctx, "greeter/smtp/password").IsError())
+
+	tf.Summary(t)
+}
@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Sentry-compatible error reporter (envelope protocol, no SDK)
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// sentryClientName identifies this adapter in the X-Sentry-Auth header.
+const sentryClientName = "hybrid_lib_go/1.0"
+
+// SentryReporter is an ErrorReporterPort adapter that posts events to any
+// Sentry-compatible ingestion endpoint (Sentry, GlitchTip, ...) using the
+// envelope protocol directly (no SDK).
+//
+// Mapping:
+//   - One envelope per report, containing one "event" item
+//   - Panics are level "fatal" with mechanism handled=false; other errors
+//     are level "error"
+//   - ErrorKind becomes the exception type, Message its value
+//   - Stack (runtime/debug.Stack format) becomes stacktrace frames
+//   - Metadata becomes event tags
+//   - The release set with WithSentryRelease becomes the event release
+//
+// Implements: outbound.ErrorReporterPort
+type SentryReporter struct {
+	dsn      string
+	endpoint string
+	key      string
+	dsnErr   error
+	client   *http.Client
+	cfgErr   error
+	release  string
+	clock    outbound.ClockPort
+	entropy  io.Reader
+}
+
+// NewSentryReporter creates a reporter for dsn, e.g.
+// "https://<public-key>@o0.ingest.sentry.io/<project-id>".
+//
+// An invalid DSN or option is not fatal at construction; every Report then
+// returns Err(InfrastructureError) describing the problem.
+//
+// Options: WithHTTPClient, WithTimeout (default 10s), WithHTTPClock
+// (envelope send times, and event times of reports without OccurredAt),
+// WithHTTPEntropy (event IDs), WithSentryRelease.
+func NewSentryReporter(dsn string, opts ...HTTPOption) *SentryReporter {
+	endpoint, key, err := parseSentryDSN(dsn)
+	cfg, cfgErr := newHTTPConfig(opts)
+	return &SentryReporter{
+		dsn:      dsn,
+		endpoint: endpoint,
+		key:      key,
+		dsnErr:   err,
+		client:   cfg.client,
+		cfgErr:   cfgErr,
+		release:  cfg.release,
+		clock:    cfg.clock,
+		entropy:  cfg.entropy,
+	}
+}
+
+// WithSentryRelease tags every event a SentryReporter sends with release
+// (e.g. "hybrid_lib_go@1.2.3", see version.Info.Release). Other adapters
+// ignore it.
+func WithSentryRelease(release string) HTTPOption {
+	return func(c *httpConfig) { c.release = release }
+}
+
+// parseSentryDSN returns the envelope endpoint and public key for dsn.
+func parseSentryDSN(dsn string) (endpoint, key string, err error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", "", fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return "", "", errors.New("missing public key")
+	}
+	path := strings.Trim(u.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	prefix, project := "", path
+	if slash >= 0 {
+		prefix, project = "/"+path[:slash], path[slash+1:]
+	}
+	if project == "" {
+		return "", "", errors.New("missing project id")
+	}
+	return fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, prefix, project), u.User.Username(), nil
+}
+
+// sentryEvent is the subset of the Sentry event payload we send.
+type sentryEvent struct {
+	EventID   string            `json:"event_id"`
+	Timestamp string            `json:"timestamp"`
+	Platform  string            `json:"platform"`
+	Level     string            `json:"level"`
+	Logger    string            `json:"logger"`
+	Message   sentryMessage     `json:"message"`
+	Exception sentryExceptions  `json:"exception"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	Release   string            `json:"release,omitempty"`
+}
+
+type sentryMessage struct {
+	Formatted string `json:"formatted"`
+}
+
+type sentryExceptions struct {
+	Values []sentryException `json:"values"`
+}
+
+type sentryException struct {
+	Type       string            `json:"type"`
+	Value      string            `json:"value"`
+	Mechanism  sentryMechanism   `json:"mechanism"`
+	Stacktrace *sentryStacktrace `json:"stacktrace,omitempty"`
+}
+
+type sentryMechanism struct {
+	Type    string `json:"type"`
+	Handled bool   `json:"handled"`
+}
+
+type sentryStacktrace struct {
+	Frames []sentryFrame `json:"frames"`
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	AbsPath  string `json:"abs_path"`
+	Lineno   int    `json:"lineno"`
+}
+
+// Report sends report as a Sentry event.
+//
+// Contract:
+//   - Returns Ok(Unit) on a 2xx response
+//   - Returns Err(InfrastructureError) on invalid DSN, transport failure,
+//     non-2xx status (including 429 rate limiting), or ctx cancellation
+//   - A 429/503 Retry-After header is carried as the error's RetryAfter hint
+func (s *SentryReporter) Report(ctx context.Context, report model.ErrorReport) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("sentry report panicked: %v", r)))
+		}
+	}()
+
+	if s.dsnErr != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sentry report failed: invalid DSN: %v", s.dsnErr)))
+	}
+	if s.cfgErr != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sentry report failed: reporter misconfigured: %v", s.cfgErr)))
+	}
+
+	eventID, err := newRandomHex128(s.entropy)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sentry report failed: %v", err)))
+	}
+
+	envelope, err := s.envelope(eventID, report)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sentry report failed: %v", err)))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(envelope))
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sentry report failed: %v", err)))
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf(
+		"Sentry sentry_version=7, sentry_key=%s, sentry_client=%s", s.key, sentryClientName))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("sentry report failed: %v", err)))
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return domerr.Err[model.Unit](withRetryAfter(apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("sentry report failed: unexpected status %s", resp.Status)), resp))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// envelope encodes the envelope header, item header and event payload.
+func (s *SentryReporter) envelope(eventID string, report model.ErrorReport) ([]byte, error) {
+	level, mechanism := "error", "generic"
+	if report.Panicked {
+		level, mechanism = "fatal", "panic"
+	}
+	occurred := report.OccurredAt
+	if occurred.IsZero() {
+		occurred = s.clock.Now()
+	}
+
+	exception := sentryException{
+		Type:      report.Error.Kind.String(),
+		Value:     report.Error.Message,
+		Mechanism: sentryMechanism{Type: mechanism, Handled: !report.Panicked},
+	}
+	if frames := parseGoStack(report.Stack); len(frames) > 0 {
+		exception.Stacktrace = &sentryStacktrace{Frames: frames}
+	}
+
+	payload, err := json.Marshal(sentryEvent{
+		EventID:   eventID,
+		Timestamp: occurred.UTC().Format(time.RFC3339Nano),
+		Platform:  "go",
+		Level:     level,
+		Logger:    "hybrid_lib_go",
+		Message:   sentryMessage{Formatted: report.Error.Message},
+		Exception: sentryExceptions{Values: []sentryException{exception}},
+		Tags:      report.Metadata,
+		Release:   s.release,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"event_id": eventID,
+		"sent_at":  s.clock.Now().UTC().Format(time.RFC3339Nano),
+		"dsn":      s.dsn,
+	})
+	if err != nil {
+		return nil, err
+	}
+	item := fmt.Sprintf(`{"type":"event","length":%d,"content_type":"application/json"}`, len(payload))
+
+	var buf bytes.Buffer
+	buf.Write(header)
+	buf.WriteByte('\n')
+	buf.WriteString(item)
+	buf.WriteByte('\n')
+	buf.Write(payload)
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// parseGoStack converts runtime/debug.Stack output into Sentry frames,
+// ordered oldest call first as Sentry expects. Frames of the stack capture
+// machinery (runtime/debug.Stack, panic) are dropped.
+//
+// Input format:
+//
+//	goroutine 1 [running]:
+//	main.f(...)
+//		/src/main.go:10 +0x1d
+func parseGoStack(stack string) []sentryFrame {
+	lines := strings.Split(strings.TrimSpace(stack), "\n")
+	var frames []sentryFrame
+	for i := 1; i+1 < len(lines); i += 2 {
+		function := strings.TrimSpace(lines[i])
+		if paren := strings.LastIndex(function, "("); paren > 0 {
+			function = function[:paren]
+		}
+		location := strings.TrimSpace(lines[i+1])
+		if sp := strings.LastIndex(location, " +0x"); sp >= 0 {
+			location = location[:sp]
+		}
+		colon := strings.LastIndex(location, ":")
+		if colon < 0 {
+			continue
+		}
+		lineno := 0
+		fmt.Sscanf(location[colon+1:], "%d", &lineno)
+
+		if function == "runtime/debug.Stack" || function == "panic" {
+			continue
+		}
+		frames = append(frames, sentryFrame{Function: function, AbsPath: location[:colon], Lineno: lineno})
+	}
+
+	for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+	return frames
+}
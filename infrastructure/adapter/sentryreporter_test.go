@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterSentryReporter tests the Sentry envelope adapter.
+func TestInfrastructureAdapterSentryReporter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SentryReporter")
+	ctx := context.Background()
+
+	var gotPath, gotAuth, gotBody string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotPath, gotAuth, gotBody = r.URL.Path, r.Header.Get("X-Sentry-Auth"), string(body)
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "7")
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	dsn := strings.Replace(srv.URL, "http://", "http://pubkey@", 1) + "/sentry/42"
+
+	// ========================================================================
+	// Test: DSN parsing
+	// ========================================================================
+
+	endpoint, key, err := parseSentryDSN("https://abc@o1.ingest.sentry.io/7")
+	tf.RunTest("DSN - endpoint", err == nil && endpoint == "https://o1.ingest.sentry.io/api/7/envelope/")
+	tf.RunTest("DSN - public key", key == "abc")
+	_, _, err = parseSentryDSN("https://o1.ingest.sentry.io/7")
+	tf.RunTest("DSN without key - error", err != nil)
+	tf.RunTest("Invalid DSN - Report IsError",
+		NewSentryReporter("ftp://k@h/1").Report(ctx, model.ErrorReport{}).IsError())
+
+	// ========================================================================
+	// Test: Panic report envelope
+	// ========================================================================
+
+	reporter := NewSentryReporter(dsn, WithSentryRelease("hybrid_lib_go@1.2.3"))
+	r1 := reporter.Report(ctx, model.ErrorReport{
+		Error:      domerr.NewInfrastructureError("greet panicked: boom"),
+		Panicked:   true,
+		Stack:      string(debug.Stack()),
+		Metadata:   map[string]string{"operation": "greet"},
+		OccurredAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	tf.RunTest("Report - IsOk", r1.IsOk())
+	tf.RunTest("Report - envelope endpoint", gotPath == "/sentry/api/42/envelope/")
+	tf.RunTest("Report - auth header", strings.Contains(gotAuth, "sentry_key=pubkey"))
+
+	lines := strings.Split(strings.TrimSpace(gotBody), "\n")
+	var item struct {
+		Type   string `json:"type"`
+		Length int    `json:"length"`
+	}
+	var event sentryEvent
+	parsed := len(lines) == 3 &&
+		json.Unmarshal([]byte(lines[1]), &item) == nil &&
+		json.Unmarshal([]byte(lines[2]), &event) == nil
+	tf.RunTest("Envelope - three lines parse", parsed)
+	tf.RunTest("Envelope - item length matches payload", parsed && item.Type == "event" && item.Length == len(lines[2]))
+	tf.RunTest("Event - fatal level for panic", event.Level == "fatal")
+	tf.RunTest("Event - exception type and value", len(event.Exception.Values) == 1 &&
+		event.Exception.Values[0].Type == "InfrastructureError" &&
+		event.Exception.Values[0].Value == "greet panicked: boom")
+	tf.RunTest("Event - unhandled mechanism", len(event.Exception.Values) == 1 &&
+		!event.Exception.Values[0].Mechanism.Handled)
+	tf.RunTest("Event - tags", event.Tags["operation"] == "greet")
+	tf.RunTest("Event - timestamp", event.Timestamp == "2025-01-02T03:04:05Z")
+	tf.RunTest("Event - release", event.Release == "hybrid_lib_go@1.2.3")
+
+	frames := []sentryFrame{}
+	if len(event.Exception.Values) == 1 && event.Exception.Values[0].Stacktrace != nil {
+		frames = event.Exception.Values[0].Stacktrace.Frames
+	}
+	tf.RunTest("Event - stack frames, newest last", len(frames) > 1 &&
+		strings.HasSuffix(frames[len(frames)-1].Function, "TestInfrastructureAdapterSentryReporter") &&
+		frames[len(frames)-1].Lineno > 0)
+	tf.RunTest("Event - random event ID", len(event.EventID) == 32 && event.EventID != strings.Repeat("0", 32))
+
+	// ========================================================================
+	// Test: Event IDs from WithHTTPEntropy
+	// ========================================================================
+
+	zeroIDs := NewSentryReporter(dsn, WithHTTPEntropy(bytes.NewReader(make([]byte, 16))))
+	tf.RunTest("Entropy - IsOk", zeroIDs.Report(ctx, model.ErrorReport{Error: domerr.NewInfrastructureError("x")}).IsOk())
+	var zeroEvent sentryEvent
+	if lines := strings.Split(strings.TrimSpace(gotBody), "\n"); len(lines) == 3 {
+		json.Unmarshal([]byte(lines[2]), &zeroEvent)
+	}
+	tf.RunTest("Entropy - event ID from reader", zeroEvent.EventID == strings.Repeat("0", 32))
+	tf.RunTest("Entropy - exhausted reader IsError",
+		zeroIDs.Report(ctx, model.ErrorReport{Error: domerr.NewInfrastructureError("x")}).IsError())
+
+	// ========================================================================
+	// Test: Rejected reports
+	// ========================================================================
+
+	status = http.StatusTooManyRequests
+	r2 := reporter.Report(ctx, model.ErrorReport{Error: domerr.NewInfrastructureError("x")})
+	tf.RunTest("Rate limited - IsError", r2.IsError())
+	tf.RunTest("Rate limited - RetryAfter hint", r2.IsError() && r2.ErrorInfo().RetryAfter == 7*time.Second)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	status = http.StatusOK
+	tf.RunTest("Cancelled - IsError",
+		reporter.Report(cancelled, model.ErrorReport{Error: domerr.NewInfrastructureError("x")}).IsError())
+
+	tf.Summary(t)
+}
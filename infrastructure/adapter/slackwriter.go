@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Slack incoming-webhook output adapter
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// notifyMaxErrorBody caps how much of an error response is read into the
+// error message.
+const notifyMaxErrorBody = 4 << 10
+
+// SlackWriter is a WriterPort adapter that posts each message to a Slack
+// channel through an incoming webhook.
+//
+// Throttling:
+//   - Slack answers 429 with Retry-After when a webhook posts too fast;
+//     the hint is carried on the error (see middleware.Retry)
+//   - Until that deadline passes, Write fails fast with the remaining wait
+//     as RetryAfter, without calling Slack
+//
+// The webhook URL is a credential: it is held as a model.Secret and never
+// appears in error messages.
+//
+// Implements: outbound.WriterPort
+type SlackWriter struct {
+	webhook  model.Secret
+	client   *http.Client
+	cfgErr   error
+	throttle throttle
+	now      func() time.Time
+}
+
+// NewSlackWriter creates a writer for the incoming webhook URL, typically
+// fetched from a SecretPort. The writer keeps its own copy of webhook, so
+// the caller may Release it.
+//
+// Options: WithHTTPClient, WithTimeout (default 10s).
+func NewSlackWriter(webhook model.Secret, opts ...HTTPOption) *SlackWriter {
+	cfg, cfgErr := newHTTPConfig(opts, redactInCassette(string(webhook.Bytes())))
+	if cfgErr == nil && webhook.IsEmpty() {
+		cfgErr = errors.New("empty webhook URL")
+	}
+	return &SlackWriter{
+		webhook: model.NewSecret(bytes.Clone(webhook.Bytes())),
+		client:  cfg.client,
+		cfgErr:  cfgErr,
+		now:     time.Now,
+	}
+}
+
+// slackPayload is the incoming-webhook message body.
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// Write posts message to the webhook's channel. Slack's control characters
+// (&, <, >) are escaped, so the message is shown literally.
+//
+// Contract:
+//   - Returns Ok(Unit) on a 2xx response
+//   - Returns Err(InfrastructureError) on transport failure, non-2xx status,
+//     while throttled, or on ctx cancellation
+//   - A 429/503 Retry-After header is carried as the error's RetryAfter hint
+func (s *SlackWriter) Write(ctx context.Context, message string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("slack write panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("slack write cancelled: %v", context.Cause(ctx))))
+	}
+	if s.cfgErr != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("slack write failed: writer misconfigured: %v", s.cfgErr)))
+	}
+	if wait := s.throttle.remaining(s.now()); wait > 0 {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("slack write failed: throttled for %s", wait.Round(time.Millisecond))).WithRetryAfter(wait))
+	}
+
+	payload, err := json.Marshal(slackPayload{Text: slackEscape(message)})
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("slack write failed: %v", err)))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, string(s.webhook.Bytes()), bytes.NewReader(payload))
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("slack write failed: %v", redactURLError(err))))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("slack write failed: %v", redactURLError(err))))
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, notifyMaxErrorBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		failure := withRetryAfter(apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("slack write failed: unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))), resp)
+		s.throttle.note(failure, s.now())
+		return domerr.Err[model.Unit](failure)
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// slackEscape escapes the three characters Slack treats as markup.
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// redactURLError strips the request URL from transport errors, for
+// adapters whose URL carries a credential.
+func redactURLError(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		return fmt.Errorf("%s: %w", ue.Op, ue.Err)
+	}
+	return err
+}
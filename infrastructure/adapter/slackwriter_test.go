@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/porttest"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterSlackWriter tests the Slack incoming-webhook adapter.
+func TestInfrastructureAdapterSlackWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SlackWriter")
+	ctx := context.Background()
+
+	var calls int
+	var gotPath, gotType, gotText string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var p slackPayload
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &p)
+		gotPath, gotType, gotText = r.URL.Path, r.Header.Get("Content-Type"), p.Text
+		switch status {
+		case http.StatusTooManyRequests:
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(status)
+		case http.StatusNotFound:
+			w.WriteHeader(status)
+			_, _ = io.WriteString(w, "no_service\n")
+		default:
+			_, _ = io.WriteString(w, "ok")
+		}
+	}))
+	defer srv.Close()
+	webhook := srv.URL + "/services/T0/B0/secret-token"
+
+	// ========================================================================
+	// Test: Posting
+	// ========================================================================
+
+	secret := model.NewSecret([]byte(webhook))
+	sw := NewSlackWriter(secret)
+	secret.Release()
+	tf.RunTest("Write - IsOk after caller released secret", sw.Write(ctx, "Hello, <Alice> & co!").IsOk())
+	tf.RunTest("Write - webhook path", gotPath == "/services/T0/B0/secret-token")
+	tf.RunTest("Write - JSON body", gotType == "application/json")
+	tf.RunTest("Write - markup escaped", gotText == "Hello, &lt;Alice&gt; &amp; co!")
+
+	// ========================================================================
+	// Test: Failures never leak the webhook
+	// ========================================================================
+
+	status = http.StatusNotFound
+	r1 := NewSlackWriter(model.NewSecret([]byte(webhook))).Write(ctx, "x")
+	tf.RunTest("404 - IsError with Slack reason", r1.IsError() &&
+		strings.Contains(r1.ErrorInfo().Message, "404 Not Found: no_service"))
+
+	unreachable := NewSlackWriter(model.NewSecret([]byte("http://127.0.0.1:1/services/secret-token")))
+	r2 := unreachable.Write(ctx, "x")
+	tf.RunTest("Transport error - IsError", r2.IsError())
+	tf.RunTest("Transport error - webhook redacted", !strings.Contains(r2.ErrorInfo().Message, "secret-token"))
+	tf.RunTest("Empty webhook - misconfigured", strings.Contains(
+		NewSlackWriter(model.Secret{}).Write(ctx, "x").ErrorInfo().Message, "misconfigured"))
+
+	// ========================================================================
+	// Test: Transport failures carry a stack trace when enabled
+	// ========================================================================
+
+	domerr.SetTraceDepth(16)
+	traced := unreachable.Write(ctx, "x")
+	domerr.SetTraceDepth(0)
+	tf.RunTest("Trace enabled - Write in trace",
+		strings.Contains(traced.ErrorInfo().StackTrace().String(), "(*SlackWriter).Write"))
+	tf.RunTest("Trace disabled - no trace", r2.ErrorInfo().StackTrace().IsEmpty())
+
+	// ========================================================================
+	// Test: Throttling
+	// ========================================================================
+
+	status = http.StatusTooManyRequests
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttled := NewSlackWriter(model.NewSecret([]byte(webhook)))
+	throttled.now = func() time.Time { return now }
+	r3 := throttled.Write(ctx, "x")
+	tf.RunTest("429 - RetryAfter hint", r3.IsError() && r3.ErrorInfo().RetryAfter == 30*time.Second)
+
+	status, calls = http.StatusOK, 0
+	now = now.Add(10 * time.Second)
+	r4 := throttled.Write(ctx, "x")
+	tf.RunTest("While throttled - fails fast", r4.IsError() && calls == 0)
+	tf.RunTest("While throttled - remaining wait", r4.ErrorInfo().RetryAfter == 20*time.Second)
+	now = now.Add(20 * time.Second)
+	tf.RunTest("After deadline - sends again", throttled.Write(ctx, "x").IsOk() && calls == 1)
+
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterSlackWriterContract runs the WriterPort
+// conformance suite against SlackWriter and a local webhook.
+func TestInfrastructureAdapterSlackWriterContract(t *testing.T) {
+	porttest.TestWriterPortContract(t, func(t *testing.T) outbound.WriterPort {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, "ok")
+		}))
+		t.Cleanup(srv.Close)
+		return NewSlackWriter(model.NewSecret([]byte(srv.URL)))
+	})
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: SMS output adapter for Twilio-style HTTP APIs
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// smsMaxBodyRunes is the longest message body the API accepts; longer
+// bodies are rejected rather than silently truncated.
+const smsMaxBodyRunes = 1600
+
+// SMSWriter is a WriterPort adapter that sends each message as an SMS to
+// one recipient through a Twilio-compatible REST API:
+//
+//	POST {baseURL}/2010-04-01/Accounts/{accountSID}/Messages.json
+//	Authorization: Basic accountSID:authToken
+//	To=...&From=...&Body=...
+//
+// Throttling works as for SlackWriter: a 429 Retry-After hint is carried
+// on the error and later Writes fail fast until it has passed.
+//
+// Implements: outbound.WriterPort
+type SMSWriter struct {
+	endpoint   string
+	accountSID string
+	authToken  model.Secret
+	from       string
+	to         string
+	client     *http.Client
+	cfgErr     error
+	throttle   throttle
+	now        func() time.Time
+}
+
+// NewSMSWriter creates a writer sending from the number (or messaging
+// service ID) from to the number to, both in E.164 form ("+15551234567").
+// baseURL is e.g. "https://api.twilio.com". The writer keeps its own copy
+// of authToken, so the caller may Release it.
+//
+// Options: WithHTTPClient, WithTimeout (default 10s).
+func NewSMSWriter(baseURL, accountSID string, authToken model.Secret, from, to string, opts ...HTTPOption) *SMSWriter {
+	cfg, cfgErr := newHTTPConfig(opts, redactInCassette(accountSID))
+	if cfgErr == nil {
+		switch {
+		case accountSID == "" || authToken.IsEmpty():
+			cfgErr = errors.New("missing account SID or auth token")
+		case from == "" || to == "":
+			cfgErr = errors.New("missing sender or recipient")
+		}
+	}
+	return &SMSWriter{
+		endpoint: fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json",
+			strings.TrimRight(baseURL, "/"), url.PathEscape(accountSID)),
+		accountSID: accountSID,
+		authToken:  model.NewSecret(bytes.Clone(authToken.Bytes())),
+		from:       from,
+		to:         to,
+		client:     cfg.client,
+		cfgErr:     cfgErr,
+		now:        time.Now,
+	}
+}
+
+// smsErrorResponse is the API's error body.
+type smsErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Write sends message as one SMS.
+//
+// Contract:
+//   - Returns Ok(Unit) once the API accepted the message (2xx); delivery
+//     to the handset is asynchronous and not confirmed
+//   - Returns Err(InfrastructureError) if message exceeds 1600 characters,
+//     on transport failure, non-2xx status, while throttled, or on ctx
+//     cancellation
+//   - A 429/503 Retry-After header is carried as the error's RetryAfter hint
+func (s *SMSWriter) Write(ctx context.Context, message string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				fmt.Sprintf("sms write panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sms write cancelled: %v", context.Cause(ctx))))
+	}
+	if s.cfgErr != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sms write failed: writer misconfigured: %v", s.cfgErr)))
+	}
+	if n := utf8.RuneCountInString(message); n > smsMaxBodyRunes {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sms write failed: message is %d characters, limit %d", n, smsMaxBodyRunes)))
+	}
+	if wait := s.throttle.remaining(s.now()); wait > 0 {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sms write failed: throttled for %s", wait.Round(time.Millisecond))).WithRetryAfter(wait))
+	}
+
+	form := url.Values{"To": {s.to}, "From": {s.from}, "Body": {message}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			fmt.Sprintf("sms write failed: %v", err)))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(s.accountSID, string(s.authToken.Bytes()))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("sms write failed: %v", err)))
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, notifyMaxErrorBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail := strings.TrimSpace(string(body))
+		var apiErr smsErrorResponse
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+			detail = fmt.Sprintf("%s (code %d)", apiErr.Message, apiErr.Code)
+		}
+		failure := withRetryAfter(apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("sms write failed: unexpected status %s: %s", resp.Status, detail)), resp)
+		s.throttle.note(failure, s.now())
+		return domerr.Err[model.Unit](failure)
+	}
+	return domerr.Ok(model.UnitValue)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/porttest"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterSMSWriter tests the Twilio-style SMS adapter.
+func TestInfrastructureAdapterSMSWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SMSWriter")
+	ctx := context.Background()
+
+	var calls int
+	var gotPath, gotUser, gotPass, gotTo, gotFrom, gotBody string
+	status := http.StatusCreated
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		gotPath = r.URL.Path
+		gotUser, gotPass, _ = r.BasicAuth()
+		_ = r.ParseForm()
+		gotTo, gotFrom, gotBody = r.PostForm.Get("To"), r.PostForm.Get("From"), r.PostForm.Get("Body")
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "5")
+		}
+		w.WriteHeader(status)
+		if status == http.StatusBadRequest {
+			_, _ = io.WriteString(w, `{"code":21211,"message":"Invalid 'To' Phone Number","status":400}`)
+		}
+	}))
+	defer srv.Close()
+	newWriter := func() *SMSWriter {
+		return NewSMSWriter(srv.URL+"/", "AC123", model.NewSecret([]byte("tok")), "+15550001111", "+15552223333")
+	}
+
+	// ========================================================================
+	// Test: Sending
+	// ========================================================================
+
+	sms := newWriter()
+	tf.RunTest("Write - IsOk", sms.Write(ctx, "Hello, Alice!").IsOk())
+	tf.RunTest("Write - messages endpoint", gotPath == "/2010-04-01/Accounts/AC123/Messages.json")
+	tf.RunTest("Write - basic auth", gotUser == "AC123" && gotPass == "tok")
+	tf.RunTest("Write - form fields", gotTo == "+15552223333" && gotFrom == "+15550001111" &&
+		gotBody == "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Failures
+	// ========================================================================
+
+	calls = 0
+	r1 := sms.Write(ctx, strings.Repeat("é", smsMaxBodyRunes+1))
+	tf.RunTest("Too long - IsError without request", r1.IsError() && calls == 0 &&
+		strings.Contains(r1.ErrorInfo().Message, "limit 1600"))
+	tf.RunTest("Limit counts characters", sms.Write(ctx, strings.Repeat("é", smsMaxBodyRunes)).IsOk())
+
+	status = http.StatusBadRequest
+	r2 := sms.Write(ctx, "x")
+	tf.RunTest("400 - API message", r2.IsError() &&
+		strings.Contains(r2.ErrorInfo().Message, "Invalid 'To' Phone Number (code 21211)"))
+
+	missing := NewSMSWriter(srv.URL, "AC123", model.Secret{}, "+1", "+2")
+	tf.RunTest("Missing token - misconfigured", strings.Contains(missing.Write(ctx, "x").ErrorInfo().Message, "misconfigured"))
+
+	status = http.StatusTooManyRequests
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	throttled := newWriter()
+	throttled.now = func() time.Time { return now }
+	r3 := throttled.Write(ctx, "x")
+	tf.RunTest("429 - RetryAfter hint", r3.IsError() && r3.ErrorInfo().RetryAfter == 5*time.Second)
+	calls = 0
+	tf.RunTest("While throttled - fails fast", throttled.Write(ctx, "x").IsError() && calls == 0)
+
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterSMSWriterContract runs the WriterPort
+// conformance suite against SMSWriter and a local API.
+func TestInfrastructureAdapterSMSWriterContract(t *testing.T) {
+	porttest.TestWriterPortContract(t, func(t *testing.T) outbound.WriterPort {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		}))
+		t.Cleanup(srv.Close)
+		return NewSMSWriter(srv.URL, "AC1", model.NewSecret([]byte("tok")), "+1", "+2")
+	})
+}
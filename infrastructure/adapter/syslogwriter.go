@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Syslog (RFC 5424) output adapter
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// SyslogFacility is the RFC 5424 facility code.
+type SyslogFacility int
+
+// Commonly used facilities (RFC 5424, section 6.2.1).
+const (
+	FacilityUser   SyslogFacility = 1
+	FacilityDaemon SyslogFacility = 3
+	FacilityLocal0 SyslogFacility = 16
+	FacilityLocal1 SyslogFacility = 17
+	FacilityLocal2 SyslogFacility = 18
+	FacilityLocal3 SyslogFacility = 19
+	FacilityLocal4 SyslogFacility = 20
+	FacilityLocal5 SyslogFacility = 21
+	FacilityLocal6 SyslogFacility = 22
+	FacilityLocal7 SyslogFacility = 23
+)
+
+// SyslogSeverity is the RFC 5424 severity code (0 = most severe).
+type SyslogSeverity int
+
+// Severities (RFC 5424, section 6.2.1).
+const (
+	SeverityEmergency SyslogSeverity = iota
+	SeverityAlert
+	SeverityCritical
+	SeverityError
+	SeverityWarning
+	SeverityNotice
+	SeverityInfo
+	SeverityDebug
+)
+
+// SeverityForKind maps an error kind to a syslog severity:
+//   - ValidationError     -> Warning (bad input, the system is healthy)
+//   - OverloadedError     -> Warning (load was shed, nothing failed)
+//   - StaleVersionError   -> Warning (a concurrent update won; re-read)
+//   - InfrastructureError -> Error   (an external dependency failed)
+func SeverityForKind(kind domerr.ErrorKind) SyslogSeverity {
+	switch kind {
+	case domerr.ValidationError, domerr.OverloadedError, domerr.StaleVersionError:
+		return SeverityWarning
+	default:
+		return SeverityError
+	}
+}
+
+// syslogDialTimeout bounds connection establishment when ctx has no deadline.
+const syslogDialTimeout = 5 * time.Second
+
+// SyslogWriter is an infrastructure adapter that sends each message as an
+// RFC 5424 syslog record.
+//
+// Transports:
+//   - "unixgram" to /dev/log: the local syslog daemon; on systemd hosts this
+//     socket is served by journald, so records land in the journal
+//   - "udp": one record per datagram
+//   - "tcp": octet-counting framing (RFC 6587, "LEN SP MSG")
+//
+// Connection Handling:
+//   - Dialed lazily on first Write and reused
+//   - A failed send drops the connection and retries once on a fresh one,
+//     so a restarted daemon is picked up without restarting the process
+//   - Over TCP a record written just after the daemon closed the connection
+//     can be accepted locally and lost; the drop is detected on the next write
+//
+// Concurrency: safe for concurrent use.
+//
+// Implements: outbound.WriterPort
+type SyslogWriter struct {
+	network  string
+	addr     string
+	appName  string
+	facility SyslogFacility
+	hostname string
+	pid      int
+	clock    outbound.ClockPort
+	dial     dialConfig
+	cfgErr   error
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogWriter creates a syslog writer. Use NewLocalSyslogWriter for the
+// local daemon.
+//
+// Parameters:
+//   - network/addr: "udp"/"tcp" with "host:port", or "unixgram"/"unix" with a socket path
+//   - appName: the APP-NAME field (e.g. the CLI name)
+//   - facility: e.g. FacilityUser or FacilityLocal0
+//   - opts: WithDialTimeout (default 5s), WithDialClock
+//
+// Usage:
+//
+//	writer := adapter.NewSyslogWriter("udp", "logs.example.com:514", "greeter", adapter.FacilityLocal0)
+//	uc := usecase.NewGreetUseCase[*adapter.SyslogWriter](writer)
+func NewSyslogWriter(network, addr, appName string, facility SyslogFacility, opts ...DialOption) *SyslogWriter {
+	hostname, _ := os.Hostname()
+	dial, cfgErr := newDialConfig(syslogDialTimeout, opts)
+	return &SyslogWriter{
+		network:  network,
+		addr:     addr,
+		appName:  appName,
+		facility: facility,
+		hostname: hostname,
+		pid:      os.Getpid(),
+		clock:    dial.clock,
+		dial:     dial,
+		cfgErr:   cfgErr,
+	}
+}
+
+// NewLocalSyslogWriter creates a syslog writer for the local daemon
+// (journald or rsyslog) listening on /dev/log.
+func NewLocalSyslogWriter(appName string, facility SyslogFacility, opts ...DialOption) *SyslogWriter {
+	return NewSyslogWriter("unixgram", "/dev/log", appName, facility, opts...)
+}
+
+// Write sends message at Info severity.
+//
+// Contract:
+//   - Returns Ok(Unit) once the record was handed to the transport
+//   - Returns Err(InfrastructureError) if ctx is cancelled or sending fails
+//     after one reconnect attempt
+//   - Never panics (panics are caught and converted to Err)
+func (sw *SyslogWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return sw.WriteSeverity(ctx, SeverityInfo, message)
+}
+
+// WriteError logs err at the severity mapped from its kind (SeverityForKind).
+func (sw *SyslogWriter) WriteError(ctx context.Context, err domerr.ErrorType) domerr.Result[model.Unit] {
+	return sw.WriteSeverity(ctx, SeverityForKind(err.Kind), err.Error())
+}
+
+// WriteSeverity sends message with an explicit severity.
+func (sw *SyslogWriter) WriteSeverity(ctx context.Context, severity SyslogSeverity, message string) domerr.Result[model.Unit] {
+	return domerr.MapTo(sw.send(ctx, severity, message), func(int) model.Unit { return model.UnitValue })
+}
+
+// WriteWithReceipt sends message at Info severity and returns the record
+// size and a destination of the form "syslog+<network>://<addr>".
+//
+// Implements: outbound.ReceiptWriterPort
+func (sw *SyslogWriter) WriteWithReceipt(ctx context.Context, message string) domerr.Result[model.WriteReceipt] {
+	return domerr.MapTo(sw.send(ctx, SeverityInfo, message), func(n int) model.WriteReceipt {
+		return model.WriteReceipt{
+			Bytes:       n,
+			Destination: fmt.Sprintf("syslog+%s://%s", sw.network, sw.addr),
+			WrittenAt:   sw.clock.Now(),
+		}
+	})
+}
+
+// send formats and transmits one record, returning its size in bytes.
+func (sw *SyslogWriter) send(ctx context.Context, severity SyslogSeverity, message string) (result domerr.Result[int]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[int](apperr.NewInfrastructureError(
+				fmt.Sprintf("syslog write panicked: %v", r)))
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[int](apperr.NewInfrastructureError(
+			fmt.Sprintf("syslog write cancelled: %v", context.Cause(ctx))))
+	}
+	if sw.cfgErr != nil {
+		return domerr.Err[int](apperr.NewInfrastructureError(
+			fmt.Sprintf("syslog write failed: writer misconfigured: %v", sw.cfgErr)))
+	}
+
+	record := sw.format(severity, message)
+	if sw.network == "tcp" || sw.network == "tcp4" || sw.network == "tcp6" {
+		record = append([]byte(strconv.Itoa(len(record))+" "), record...)
+	}
+
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	err := sw.sendLocked(ctx, record)
+	if err != nil {
+		// Drop and retry once: the daemon may have restarted.
+		sw.dropLocked()
+		err = sw.sendLocked(ctx, record)
+	}
+	if err != nil {
+		sw.dropLocked()
+		return domerr.Err[int](apperr.NewInfrastructureError(
+			fmt.Sprintf("syslog write failed: %v", err)))
+	}
+	return domerr.Ok(len(record))
+}
+
+// Close releases the underlying connection, if any.
+func (sw *SyslogWriter) Close() error {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return sw.dropLocked()
+}
+
+// sendLocked dials if needed and writes one record. Caller holds sw.mu.
+func (sw *SyslogWriter) sendLocked(ctx context.Context, record []byte) error {
+	if sw.conn == nil {
+		dialer := net.Dialer{Timeout: sw.dial.timeout}
+		conn, err := dialer.DialContext(ctx, sw.network, sw.addr)
+		if err != nil {
+			return err
+		}
+		sw.conn = conn
+	}
+
+	deadline, _ := ctx.Deadline() // zero value clears any previous deadline
+	if err := sw.conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	_, err := sw.conn.Write(record)
+	return err
+}
+
+// dropLocked closes and forgets the connection. Caller holds sw.mu.
+func (sw *SyslogWriter) dropLocked() error {
+	if sw.conn == nil {
+		return nil
+	}
+	err := sw.conn.Close()
+	sw.conn = nil
+	return err
+}
+
+// format renders an RFC 5424 record:
+//
+//	<PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (sw *SyslogWriter) format(severity SyslogSeverity, message string) []byte {
+	pri := int(sw.facility)*8 + int(severity)
+	ts := sw.clock.Now().UTC().Format("2006-01-02T15:04:05.000000Z07:00")
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s %d - - %s",
+		pri, ts,
+		syslogHeaderField(sw.hostname, 255),
+		syslogHeaderField(sw.appName, 48),
+		sw.pid, message))
+}
+
+// syslogHeaderField makes s a valid header field: printable US-ASCII
+// without spaces, at most limit characters, "-" when empty.
+func syslogHeaderField(s string, limit int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, s)
+	if len(s) > limit {
+		s = s[:limit]
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
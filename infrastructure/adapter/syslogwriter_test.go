@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// js/wasm only simulates sockets in-process; reconnects are not observable.
+//go:build !js
+
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_lib_go/application/port/porttest"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// newTestSyslogWriter pins the variable header fields.
+func newTestSyslogWriter(network, addr string) *SyslogWriter {
+	sw := NewSyslogWriter(network, addr, "greeter app", FacilityLocal0,
+		WithDialClock(NewFrozenClock(time.Date(2025, 1, 2, 3, 4, 5, 6000, time.UTC))))
+	sw.hostname = "host"
+	sw.pid = 42
+	return sw
+}
+
+// TestInfrastructureAdapterSyslogWriter tests the RFC 5424 syslog adapter.
+func TestInfrastructureAdapterSyslogWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SyslogWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: UDP record format and severity mapping
+	// ========================================================================
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer pc.Close()
+	recv := func() string {
+		buf := make([]byte, 2048)
+		_ = pc.SetReadDeadline(time.Now().Add(2 * time.Second))
+		n, _, err := pc.ReadFrom(buf)
+		if err != nil {
+			return ""
+		}
+		return string(buf[:n])
+	}
+
+	udp := newTestSyslogWriter("udp", pc.LocalAddr().String())
+	defer udp.Close()
+	tf.RunTest("UDP Write - IsOk", udp.Write(ctx, "Hello, Alice!").IsOk())
+	tf.RunTest("UDP Write - RFC 5424 record",
+		recv() == "<134>1 2025-01-02T03:04:05.000006Z host greeterapp 42 - - Hello, Alice!")
+
+	udp.WriteError(ctx, domerr.NewValidationError("name empty"))
+	tf.RunTest("WriteError validation - Warning priority", strings.HasPrefix(recv(), "<132>1 "))
+	udp.WriteError(ctx, domerr.NewInfrastructureError("disk full"))
+	tf.RunTest("WriteError infrastructure - Error priority", strings.HasPrefix(recv(), "<131>1 "))
+
+	receipt := udp.WriteWithReceipt(ctx, "Hi")
+	record := recv()
+	tf.RunTest("WriteWithReceipt - record size", receipt.IsOk() && receipt.Value().Bytes == len(record))
+	tf.RunTest("WriteWithReceipt - destination", receipt.IsOk() &&
+		receipt.Value().Destination == "syslog+udp://"+pc.LocalAddr().String())
+
+	// ========================================================================
+	// Test: TCP octet-counting framing and reconnect
+	// ========================================================================
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+	frames := make(chan string, 4)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			rd := bufio.NewReader(conn)
+			size, err := rd.ReadString(' ')
+			if err != nil {
+				conn.Close()
+				continue
+			}
+			n, _ := strconv.Atoi(strings.TrimSpace(size))
+			msg := make([]byte, n)
+			_, _ = rd.Read(msg)
+			frames <- string(msg)
+			// Drop the connection after every record to force reconnects.
+			conn.Close()
+		}
+	}()
+
+	tcp := newTestSyslogWriter("tcp", ln.Addr().String())
+	defer tcp.Close()
+	tf.RunTest("TCP Write - IsOk", tcp.Write(ctx, "first").IsOk())
+	tf.RunTest("TCP Write - framed record", strings.HasSuffix(<-frames, " - - first"))
+
+	// The server closed the connection; the writer must notice and redial.
+	time.Sleep(50 * time.Millisecond)
+	delivered := false
+	for i := 0; i < 3 && !delivered; i++ {
+		if tcp.Write(ctx, "second").IsOk() {
+			select {
+			case f := <-frames:
+				delivered = strings.HasSuffix(f, " - - second")
+			case <-time.After(500 * time.Millisecond):
+			}
+		}
+	}
+	tf.RunTest("TCP reconnect - record delivered", delivered)
+
+	// ========================================================================
+	// Test: Failure paths
+	// ========================================================================
+
+	unreachable := newTestSyslogWriter("unixgram", "/nonexistent/syslog.sock")
+	tf.RunTest("Unreachable daemon - IsError", unreachable.Write(ctx, "x").IsError())
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.RunTest("Cancelled - IsError", udp.Write(cancelled, "x").IsError())
+
+	tf.RunTest("Header field - empty becomes dash", syslogHeaderField(" ", 48) == "-")
+	tf.RunTest("Header field - truncated", len(syslogHeaderField(strings.Repeat("a", 60), 48)) == 48)
+
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterSyslogWriterContract runs the WriterPort
+// conformance suite against SyslogWriter over UDP.
+func TestInfrastructureAdapterSyslogWriterContract(t *testing.T) {
+	porttest.TestWriterPortContract(t, func(t *testing.T) outbound.WriterPort {
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("listen: %v", err)
+		}
+		t.Cleanup(func() { _ = pc.Close() })
+		sw := NewSyslogWriter("udp", pc.LocalAddr().String(), "contract", FacilityUser)
+		t.Cleanup(func() { _ = sw.Close() })
+		return sw
+	})
+}
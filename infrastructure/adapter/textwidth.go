@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Terminal display width of text (wide characters, emoji, marks)
+
+package adapter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DisplayWidth returns the number of terminal columns s occupies:
+//   - East Asian wide and fullwidth characters (CJK, Hangul, kana) and
+//     emoji take two columns
+//   - Combining marks, variation selectors, emoji skin-tone modifiers and
+//     format characters (zero-width joiner, ...) take none
+//   - A ZWJ emoji sequence ("👩‍💻") and a regional-indicator flag ("🇯🇵")
+//     take two columns in total
+//   - Control characters take none; everything else takes one
+//
+// The tables cover the common ranges rather than all of Unicode's
+// EastAsianWidth data; rare characters count as one column.
+func DisplayWidth(s string) int {
+	width := 0
+	joined := false   // previous rune was a ZWJ
+	flagOpen := false // previous rune opened a regional-indicator pair
+	for _, r := range s {
+		switch {
+		case r == '‍':
+			joined = true
+			continue
+		case joined:
+			// The rune after a ZWJ renders inside the preceding glyph.
+		case isRegionalIndicator(r):
+			if !flagOpen {
+				width += 2
+			}
+			flagOpen = !flagOpen
+			continue
+		default:
+			width += runeWidth(r)
+		}
+		joined, flagOpen = false, false
+	}
+	return width
+}
+
+// PadRight pads s with spaces to width columns (by DisplayWidth), for
+// aligning columns containing non-ASCII names. s is returned unchanged if
+// it is already as wide.
+func PadRight(s string, width int) string {
+	if pad := width - DisplayWidth(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// widthPrefix returns the byte length of the longest prefix of s that fits
+// in width columns. At least one rune (with any zero-width runes after it)
+// is taken, so callers always make progress.
+func widthPrefix(s string, width int) int {
+	end, used := 0, 0
+	for i, r := range s {
+		w := runeWidth(r)
+		if end > 0 && used+w > width {
+			return end
+		}
+		end, used = i+utf8.RuneLen(r), used+w
+	}
+	return end
+}
+
+// runeWidth is the display width of a single rune, without the sequence
+// rules (ZWJ, flags) DisplayWidth applies.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7f && r < 0xa0):
+		return 0
+	case r < 0x300:
+		return 1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf), r >= 0x1f3fb && r <= 0x1f3ff:
+		return 0
+	case isWide(r):
+		return 2
+	}
+	return 1
+}
+
+// wideRanges are the East Asian Wide/Fullwidth and emoji-presentation
+// blocks, in ascending order.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115f},   // Hangul Jamo initial consonants
+	{0x231a, 0x231b},   // watch, hourglass
+	{0x2e80, 0x303e},   // CJK radicals, punctuation
+	{0x3041, 0x33ff},   // kana, CJK compatibility
+	{0x3400, 0x4dbf},   // CJK Extension A
+	{0x4e00, 0x9fff},   // CJK Unified Ideographs
+	{0xa000, 0xa4cf},   // Yi
+	{0xac00, 0xd7a3},   // Hangul syllables
+	{0xf900, 0xfaff},   // CJK compatibility ideographs
+	{0xfe30, 0xfe4f},   // CJK compatibility forms
+	{0xff00, 0xff60},   // fullwidth forms
+	{0xffe0, 0xffe6},   // fullwidth signs
+	{0x1f300, 0x1f64f}, // pictographs, emoticons
+	{0x1f680, 0x1f6ff}, // transport and map symbols
+	{0x1f900, 0x1f9ff}, // supplemental symbols and pictographs
+	{0x1fa70, 0x1faff}, // symbols and pictographs extended-A
+	{0x20000, 0x3fffd}, // CJK Extensions B and later
+}
+
+func isWide(r rune) bool {
+	for _, wr := range wideRanges {
+		if r < wr[0] {
+			return false
+		}
+		if r <= wr[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
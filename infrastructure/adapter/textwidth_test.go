@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/domain/test"
+)
+
+// TestInfrastructureAdapterTextWidth tests display width and width-aware wrapping.
+func TestInfrastructureAdapterTextWidth(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.TextWidth")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: DisplayWidth
+	// ========================================================================
+
+	tf.RunTest("ASCII - one per byte", DisplayWidth("Alice") == 5)
+	tf.RunTest("Latin accents - one each", DisplayWidth("Zoë") == 3)
+	tf.RunTest("Combining mark - zero", DisplayWidth("Zoe\u0308") == 3)
+	tf.RunTest("CJK - two each", DisplayWidth("山田太郎") == 8)
+	tf.RunTest("Hangul - two each", DisplayWidth("김민준") == 6)
+	tf.RunTest("Fullwidth - two", DisplayWidth("Ａ") == 2)
+	tf.RunTest("Emoji - two", DisplayWidth("👋") == 2)
+	tf.RunTest("Emoji skin tone - two", DisplayWidth("👋🏽") == 2)
+	tf.RunTest("ZWJ sequence - two", DisplayWidth("👩‍💻") == 2)
+	tf.RunTest("Flag - two", DisplayWidth("🇯🇵") == 2)
+	tf.RunTest("Two flags - four", DisplayWidth("🇯🇵🇫🇷") == 4)
+	tf.RunTest("Control - zero", DisplayWidth("a\x1bb") == 2)
+
+	// ========================================================================
+	// Test: Alignment
+	// ========================================================================
+
+	tf.RunTest("PadRight CJK - aligned", PadRight("山田", 6) == "山田  ")
+	tf.RunTest("PadRight ASCII - aligned", PadRight("Bob", 6) == "Bob   ")
+	tf.RunTest("PadRight wide enough - unchanged", PadRight("Alice", 3) == "Alice")
+
+	// ========================================================================
+	// Test: Wrapping counts columns
+	// ========================================================================
+
+	tf.RunTest("wrapText CJK - by columns", wrapText("山田 太郎", 4) == "山田\n太郎")
+	tf.RunTest("wrapText CJK - splits long word", wrapText("山田太郎", 4) == "山田\n太郎")
+	tf.RunTest("wrapText - keeps mark with base", wrapText("Zoe\u0308Zoe\u0308", 3) == "Zoe\u0308\nZoe\u0308")
+	tf.RunTest("wrapText - wide char in narrow line", wrapText("山", 1) == "山")
+
+	var buf bytes.Buffer
+	NewWriter(&buf, WithWrap(13)).Write(ctx, "Hello, 山田太郎 and Bob!")
+	tf.RunTest("Write - wrapped by columns", buf.String() == "Hello,\n山田太郎 and\nBob!\n")
+
+	tf.Summary(t)
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: HashiCorp Vault (KV v2) secret adapter
+
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_lib_go/application/error"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+)
+
+// vaultMaxResponseBytes caps how much of a Vault response is read.
+const vaultMaxResponseBytes = 1 << 20
+
+// VaultSecrets is a SecretPort adapter for the HashiCorp Vault KV version 2
+// secrets engine, using Vault's HTTP API directly (no SDK).
+//
+// Key Mapping:
+//   - The last path segment is the field, the rest is the secret path
+//   - Example: key "greeter/smtp/password" reads field "password" of secret
+//     "greeter/smtp" via GET {addr}/v1/{mount}/data/greeter/smtp
+//
+// Scope:
+//   - Token authentication only; token renewal and other auth methods are
+//     left to the deployment (e.g. Vault Agent writing a token file)
+//   - Reads the latest secret version only
+//
+// Implements: outbound.SecretPort
+type VaultSecrets struct {
+	addr   string
+	token  string
+	mount  string
+	client *http.Client
+	cfgErr error
+}
+
+// NewVaultSecrets creates a Vault KV v2 secret adapter.
+//
+// Parameters:
+//   - addr: Vault base URL, e.g. "https://vault.internal:8200"
+//   - token: Vault token sent as X-Vault-Token
+//   - mount: KV v2 mount path, e.g. "secret"
+//   - opts: WithHTTPClient, WithTimeout (default 10s)
+func NewVaultSecrets(addr, token, mount string, opts ...HTTPOption) *VaultSecrets {
+	cfg, cfgErr := newHTTPConfig(opts, redactInCassette(token), scrubInCassette(scrubVaultResponse))
+	return &VaultSecrets{
+		addr:   strings.TrimRight(addr, "/"),
+		token:  token,
+		mount:  strings.Trim(mount, "/"),
+		client: cfg.client,
+		cfgErr: cfgErr,
+	}
+}
+
+// vaultKVResponse is the subset of the KV v2 read response we need.
+type vaultKVResponse struct {
+	Data struct {
+		Data map[string]any `json:"data"`
+	} `json:"data"`
+}
+
+// Get reads the secret field addressed by key.
+//
+// Contract:
+//   - Returns Ok(Secret) if the secret exists and the field is a string
+//   - Returns Err(InfrastructureError) if the key is malformed, the secret or
+//     field is missing, Vault rejects the request, or ctx is cancelled
+//   - A 429/503 Retry-After header is carried as the error's RetryAfter hint
+func (v *VaultSecrets) Get(ctx context.Context, key string) (result domerr.Result[model.Secret]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Secret](apperr.NewInfrastructureError(
+				fmt.Sprintf("vault secret panicked: %v", r)))
+		}
+	}()
+
+	if v.cfgErr != nil {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("vault secret %q: adapter misconfigured: %v", key, v.cfgErr)))
+	}
+
+	key = strings.Trim(key, "/")
+	slash := strings.LastIndex(key, "/")
+	if slash <= 0 || slash == len(key)-1 {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("vault secret %q: key must be <path>/<field>", key)))
+	}
+	path, field := key[:slash], key[slash+1:]
+
+	endpoint := fmt.Sprintf("%s/v1/%s/data/%s", v.addr, url.PathEscape(v.mount), escapeVaultPath(path))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("vault secret %q: %v", key, err)))
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("vault secret %q: %v", key, err)))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("secret %q not found", key)))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return domerr.Err[model.Secret](withRetryAfter(apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("vault secret %q: unexpected status %s", key, resp.Status)), resp))
+	}
+
+	var body vaultKVResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, vaultMaxResponseBytes)).Decode(&body); err != nil {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureErrorTrace(
+			fmt.Sprintf("vault secret %q: decode response: %v", key, err)))
+	}
+
+	raw, ok := body.Data.Data[field]
+	if !ok {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("secret %q not found: field %q missing", key, field)))
+	}
+	value, ok := raw.(string)
+	if !ok {
+		return domerr.Err[model.Secret](apperr.NewInfrastructureError(
+			fmt.Sprintf("vault secret %q: field %q is not a string", key, field)))
+	}
+	return domerr.Ok(model.NewSecret([]byte(value)))
+}
+
+// scrubVaultResponse replaces every secret value in a KV v2 read response
+// with "REDACTED" so a cassette never records it. Bodies that are not such
+// a response (errors, empty secrets) are returned unchanged.
+func scrubVaultResponse(body []byte) []byte {
+	var doc map[string]any
+	if json.Unmarshal(body, &doc) != nil {
+		return body
+	}
+	outer, _ := doc["data"].(map[string]any)
+	inner, _ := outer["data"].(map[string]any)
+	if len(inner) == 0 {
+		return body
+	}
+	for field := range inner {
+		inner[field] = cassetteRedacted
+	}
+	scrubbed, err := json.Marshal(doc)
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+// escapeVaultPath escapes each segment of a slash-separated secret path.
+func escapeVaultPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// Command errtaxonomy scans the library source for error construction
+// call sites and prints a Markdown report of every error kind the library
+// can produce, cross-referenced with the ErrorKind registry and the kind
+// mappings (e.g. the cexport C return codes).
+//
+// Usage (from the test module):
+//
+//	go run ./cmd/errtaxonomy                     # report on the repository
+//	go run ./cmd/errtaxonomy -o taxonomy.md      # write the report to a file
+//	go run ./cmd/errtaxonomy -strict             # fail on any finding
+//
+// Exit codes: 0 = report written (no findings with -strict), 1 = findings
+// with -strict, 2 = usage/IO error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abitofhelp/hybrid_lib_go/test/taxonomy"
+)
+
+func main() {
+	root := flag.String("root", "..", "repository root to scan")
+	out := flag.String("o", "", "output file (default: stdout)")
+	strict := flag.Bool("strict", false, "exit 1 if the report has findings")
+	flag.Parse()
+
+	report, err := taxonomy.Scan(*root)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "errtaxonomy: %v\n", err)
+		os.Exit(2)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "errtaxonomy: %v\n", err)
+			os.Exit(2)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := report.Write(w); err != nil {
+		fmt.Fprintf(os.Stderr, "errtaxonomy: %v\n", err)
+		os.Exit(2)
+	}
+
+	if *strict && len(report.Findings) > 0 {
+		fmt.Fprintf(os.Stderr, "errtaxonomy: %d finding(s)\n", len(report.Findings))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// Command perfcheck runs the perf scenarios and fails if any metric regresses
+// beyond a threshold compared to a committed baseline.
+//
+// Usage (from the test module):
+//
+//	go run ./cmd/perfcheck                       # compare against perf/baseline.json
+//	go run ./cmd/perfcheck -threshold 25         # allow up to 25% regression
+//	go run ./cmd/perfcheck -update               # re-record the baseline
+//	go run ./cmd/perfcheck -count 10             # more runs for noisy machines
+//
+// Exit codes: 0 = within threshold, 1 = regression found, 2 = usage/IO error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/abitofhelp/hybrid_lib_go/test/perf"
+)
+
+func main() {
+	baselinePath := flag.String("baseline", "perf/baseline.json", "baseline JSON file")
+	threshold := flag.Float64("threshold", 15, "allowed regression in percent")
+	update := flag.Bool("update", false, "write current results as the new baseline")
+	count := flag.Int("count", 5, "runs per scenario; the best run is kept")
+	flag.Parse()
+
+	current := perf.Measure(*count)
+	for _, s := range perf.Scenarios() {
+		r := current[s.Name]
+		fmt.Printf("%-16s %12d ns/op %8d allocs/op %10d B/op\n", s.Name, r.NsPerOp, r.AllocsPerOp, r.BytesPerOp)
+	}
+
+	if *update {
+		if err := current.Save(*baselinePath); err != nil {
+			fmt.Fprintf(os.Stderr, "perfcheck: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("baseline written to %s\n", *baselinePath)
+		return
+	}
+
+	baseline, err := perf.LoadBaseline(*baselinePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "perfcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	regressions := perf.Compare(baseline, current, *threshold)
+	if len(regressions) == 0 {
+		fmt.Printf("OK: no regression above %.1f%%\n", *threshold)
+		return
+	}
+	for _, r := range regressions {
+		fmt.Fprintf(os.Stderr, "REGRESSION %s\n", r)
+	}
+	os.Exit(1)
+}
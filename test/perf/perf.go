@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: perf
+// Description: Benchmark scenarios and baseline regression checks
+
+// Package perf provides repeatable benchmark scenarios for the greet stack
+// and a baseline comparison used to guard the "zero overhead" claims of the
+// static-dispatch design.
+//
+// Scenarios exercise the real use case wired to a ConsoleWriter that writes
+// to io.Discard, so measurements cover domain validation, orchestration and
+// the adapter, but not terminal I/O.
+//
+// Usage:
+//
+//	// As Go benchmarks
+//	go test -bench . -benchmem ./perf/...
+//
+//	// As a regression gate against the committed baseline
+//	go run ./cmd/perfcheck -baseline perf/baseline.json -threshold 15
+package perf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	"github.com/abitofhelp/hybrid_lib_go/infrastructure/adapter"
+)
+
+const (
+	// BulkSize is the number of greetings per op in the bulk scenario.
+	BulkSize = 10_000
+
+	// Concurrency is the number of goroutines per op in the concurrent scenario.
+	Concurrency = 100
+)
+
+// Scenario is a named benchmark body.
+type Scenario struct {
+	Name string
+	Run  func(b *testing.B)
+}
+
+// Scenarios returns every benchmark scenario in a stable order.
+func Scenarios() []Scenario {
+	return []Scenario{
+		{Name: "single_greet", Run: benchSingle},
+		{Name: "bulk_10k", Run: benchBulk},
+		{Name: "concurrent_100", Run: benchConcurrent},
+	}
+}
+
+// newUseCase wires the greet use case to a discarding console writer.
+func newUseCase() *usecase.GreetUseCase[*adapter.ConsoleWriter] {
+	return usecase.NewGreetUseCase[*adapter.ConsoleWriter](adapter.NewWriter(io.Discard))
+}
+
+func benchSingle(b *testing.B) {
+	uc := newUseCase()
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if r := uc.Execute(ctx, cmd); r.IsError() {
+			b.Fatal(r.ErrorInfo())
+		}
+	}
+}
+
+func benchBulk(b *testing.B) {
+	uc := newUseCase()
+	ctx := context.Background()
+	cmds := make([]command.GreetCommand, BulkSize)
+	for i := range cmds {
+		cmds[i] = command.NewGreetCommand(fmt.Sprintf("Person %d", i))
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, cmd := range cmds {
+			if r := uc.Execute(ctx, cmd); r.IsError() {
+				b.Fatal(r.ErrorInfo())
+			}
+		}
+	}
+}
+
+func benchConcurrent(b *testing.B) {
+	uc := newUseCase()
+	ctx := context.Background()
+	cmd := command.NewGreetCommand("Alice")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var wg sync.WaitGroup
+		wg.Add(Concurrency)
+		for g := 0; g < Concurrency; g++ {
+			go func() {
+				defer wg.Done()
+				uc.Execute(ctx, cmd)
+			}()
+		}
+		wg.Wait()
+	}
+}
+
+// Result is the measured cost of one scenario.
+type Result struct {
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+// Baseline maps scenario names to their reference results.
+type Baseline map[string]Result
+
+// Measure runs every scenario count times with testing.Benchmark and keeps
+// the best (lowest) value of each metric, which filters out most scheduler
+// and GC noise on shared machines.
+func Measure(count int) Baseline {
+	if count < 1 {
+		count = 1
+	}
+	out := make(Baseline)
+	for _, s := range Scenarios() {
+		var best Result
+		for i := 0; i < count; i++ {
+			r := testing.Benchmark(s.Run)
+			cur := Result{
+				NsPerOp:     r.NsPerOp(),
+				AllocsPerOp: r.AllocsPerOp(),
+				BytesPerOp:  r.AllocedBytesPerOp(),
+			}
+			if i == 0 {
+				best = cur
+				continue
+			}
+			best.NsPerOp = min(best.NsPerOp, cur.NsPerOp)
+			best.AllocsPerOp = min(best.AllocsPerOp, cur.AllocsPerOp)
+			best.BytesPerOp = min(best.BytesPerOp, cur.BytesPerOp)
+		}
+		out[s.Name] = best
+	}
+	return out
+}
+
+// LoadBaseline reads a baseline JSON file.
+func LoadBaseline(path string) (Baseline, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is an operator-supplied flag
+	if err != nil {
+		return nil, err
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// Save writes the baseline as indented JSON.
+func (b Baseline) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// Regression describes one metric that got worse than allowed.
+type Regression struct {
+	Scenario string
+	Metric   string
+	Baseline int64
+	Current  int64
+	Percent  float64
+}
+
+// String formats the regression for reports.
+func (r Regression) String() string {
+	return fmt.Sprintf("%s %s: %d -> %d (+%.1f%%)", r.Scenario, r.Metric, r.Baseline, r.Current, r.Percent)
+}
+
+// Compare returns every metric in current that exceeds its baseline by more
+// than thresholdPercent. Scenarios missing from baseline are ignored so new
+// scenarios can be added before the baseline is refreshed.
+//
+// Allocation counts are compared with the same threshold, but any increase
+// from a zero baseline counts as a regression (zero-allocation paths must
+// stay zero).
+func Compare(baseline, current Baseline, thresholdPercent float64) []Regression {
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out []Regression
+	for _, name := range names {
+		base, ok := baseline[name]
+		if !ok {
+			continue
+		}
+		cur := current[name]
+		metrics := []struct {
+			name      string
+			base, cur int64
+		}{
+			{"ns/op", base.NsPerOp, cur.NsPerOp},
+			{"allocs/op", base.AllocsPerOp, cur.AllocsPerOp},
+			{"B/op", base.BytesPerOp, cur.BytesPerOp},
+		}
+		for _, m := range metrics {
+			if m.cur <= m.base {
+				continue
+			}
+			if m.base == 0 {
+				out = append(out, Regression{name, m.name, m.base, m.cur, 100})
+				continue
+			}
+			pct := float64(m.cur-m.base) / float64(m.base) * 100
+			if pct > thresholdPercent {
+				out = append(out, Regression{name, m.name, m.base, m.cur, pct})
+			}
+		}
+	}
+	return out
+}
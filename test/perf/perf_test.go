@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package perf_test
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/test/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+// BenchmarkScenarios runs every perf scenario as a sub-benchmark.
+func BenchmarkScenarios(b *testing.B) {
+	for _, s := range perf.Scenarios() {
+		b.Run(s.Name, s.Run)
+	}
+}
+
+func TestCompare_FlagsOnlyRegressionsAboveThreshold(t *testing.T) {
+	baseline := perf.Baseline{
+		"a": {NsPerOp: 100, AllocsPerOp: 2, BytesPerOp: 64},
+		"b": {NsPerOp: 100, AllocsPerOp: 0, BytesPerOp: 0},
+	}
+	current := perf.Baseline{
+		"a":   {NsPerOp: 109, AllocsPerOp: 2, BytesPerOp: 128},
+		"b":   {NsPerOp: 90, AllocsPerOp: 1, BytesPerOp: 0},
+		"new": {NsPerOp: 1_000_000},
+	}
+
+	regressions := perf.Compare(baseline, current, 10)
+
+	if assert.Len(t, regressions, 2) {
+		assert.Equal(t, "a", regressions[0].Scenario)
+		assert.Equal(t, "B/op", regressions[0].Metric)
+		assert.Equal(t, "b", regressions[1].Scenario)
+		assert.Equal(t, "allocs/op", regressions[1].Metric)
+	}
+}
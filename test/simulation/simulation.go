@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: simulation
+// Description: Deterministic, seeded simulation of the greet stack
+
+// Package simulation drives the greet stack through long randomized command
+// sequences and checks invariants after every step.
+//
+// A Harness wires the real use case (behind the Retry middleware) to
+// deterministic fakes:
+//   - Clock: virtual time, advanced by the harness and the writer
+//   - ChaosWriter: a WriterPort that fails at a seeded rate
+//   - Bus: an in-memory event bus publishing a GreetingRecord per delivery
+//
+// Everything random flows from Config.Seed, so a failing seed replays
+// exactly.
+//
+// Invariants (checked per step, then over the whole run):
+//   - Ok => exactly one new delivery, and one event describing it
+//   - Err => no new delivery and no event
+//   - The error kind matches what the generated command should produce
+//   - History (events received) matches deliveries one-to-one, in order
+//   - Event timestamps never go backwards
+//
+// Usage:
+//
+//	report := simulation.New(simulation.Config{Seed: 42, Steps: 1000}).Run()
+//	if len(report.Violations) > 0 { ... }
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/abitofhelp/hybrid_lib_go/application/command"
+	"github.com/abitofhelp/hybrid_lib_go/application/middleware"
+	"github.com/abitofhelp/hybrid_lib_go/application/model"
+	"github.com/abitofhelp/hybrid_lib_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_lib_go/domain/error"
+	"github.com/abitofhelp/hybrid_lib_go/domain/valueobject"
+)
+
+// Config controls a simulation run.
+type Config struct {
+	Seed             int64
+	Steps            int
+	WriteFailureRate float64 // probability a single Write fails
+	CancelRate       float64 // probability a command runs with a cancelled ctx
+	Attempts         int     // Retry middleware attempts per command
+}
+
+// Report summarizes a run. Two runs with the same Config produce equal Reports.
+type Report struct {
+	Seed             int64
+	Steps            int
+	Ok               int
+	ValidationErrors int
+	InfraErrors      int
+	Writes           int // Write calls, including failed attempts
+	Violations       []string
+}
+
+// ============================================================================
+// Fakes
+// ============================================================================
+
+// Clock is a virtual clock; it only moves when advanced.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// Now returns the current virtual time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// ChaosWriter is a WriterPort that fails at a seeded rate and records
+// every message it delivers.
+type ChaosWriter struct {
+	rng       *rand.Rand
+	clock     *Clock
+	failRate  float64
+	calls     int
+	delivered []string
+}
+
+// Write delivers message unless ctx is cancelled or the dice say fail.
+func (w *ChaosWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	w.calls++
+	w.clock.Advance(time.Millisecond)
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(
+			fmt.Sprintf("chaos write cancelled: %v", context.Cause(ctx))))
+	}
+	if w.rng.Float64() < w.failRate {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("chaos write failed: injected fault"))
+	}
+	w.delivered = append(w.delivered, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// Delivered returns the messages written so far.
+func (w *ChaosWriter) Delivered() []string {
+	return w.delivered
+}
+
+// Bus is a synchronous in-memory event bus.
+type Bus struct {
+	subscribers []func(model.GreetingRecord)
+}
+
+// Subscribe registers fn to receive every published record.
+func (b *Bus) Subscribe(fn func(model.GreetingRecord)) {
+	b.subscribers = append(b.subscribers, fn)
+}
+
+// Publish delivers record to every subscriber in registration order.
+func (b *Bus) Publish(record model.GreetingRecord) {
+	for _, fn := range b.subscribers {
+		fn(record)
+	}
+}
+
+// ============================================================================
+// Harness
+// ============================================================================
+
+// expectation is the outcome a generated command must produce when the
+// writer cooperates.
+type expectation int
+
+const (
+	expectOk expectation = iota
+	expectValidation
+	expectInfra
+)
+
+// Harness owns one simulated stack and its invariant checks.
+type Harness struct {
+	cfg     Config
+	rng     *rand.Rand
+	Clock   *Clock
+	Writer  *ChaosWriter
+	Bus     *Bus
+	History []model.GreetingRecord
+	handler *middleware.Retry[command.GreetCommand, model.Unit, *usecase.GreetUseCase[*ChaosWriter]]
+}
+
+// New wires a harness for cfg. Zero Steps and Attempts default to 1000 and 3.
+func New(cfg Config) *Harness {
+	if cfg.Steps <= 0 {
+		cfg.Steps = 1000
+	}
+	if cfg.Attempts <= 0 {
+		cfg.Attempts = 3
+	}
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	clock := &Clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	writer := &ChaosWriter{rng: rand.New(rand.NewSource(rng.Int63())), clock: clock, failRate: cfg.WriteFailureRate}
+
+	h := &Harness{cfg: cfg, rng: rng, Clock: clock, Writer: writer, Bus: &Bus{}}
+	h.Bus.Subscribe(func(r model.GreetingRecord) { h.History = append(h.History, r) })
+	h.handler = middleware.NewRetry[command.GreetCommand, model.Unit](
+		usecase.NewGreetUseCaseWithClock[*ChaosWriter](writer, clock), cfg.Attempts, 0)
+	return h
+}
+
+// Run executes cfg.Steps randomized commands and returns the Report.
+func (h *Harness) Run() Report {
+	report := Report{Seed: h.cfg.Seed, Steps: h.cfg.Steps}
+	violate := func(step int, format string, args ...any) {
+		report.Violations = append(report.Violations,
+			fmt.Sprintf("seed %d step %d: %s", h.cfg.Seed, step, fmt.Sprintf(format, args...)))
+	}
+
+	for step := 0; step < h.cfg.Steps; step++ {
+		h.Clock.Advance(time.Duration(h.rng.Intn(1000)) * time.Millisecond)
+		name, expect := h.nextName()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		if h.rng.Float64() < h.cfg.CancelRate && expect == expectOk {
+			cancel()
+			expect = expectInfra
+		}
+
+		before, eventsBefore := len(h.Writer.delivered), len(h.History)
+		result := h.handler.Execute(ctx, command.NewGreetCommand(name))
+		cancel()
+		if result.IsOk() {
+			h.publish(name)
+		}
+		delivered, events := len(h.Writer.delivered)-before, len(h.History)-eventsBefore
+
+		switch {
+		case result.IsOk():
+			report.Ok++
+			if expect != expectOk {
+				violate(step, "name %q succeeded, expected an error", name)
+			}
+			if delivered != 1 || events != 1 {
+				violate(step, "Ok with %d deliveries and %d events, want 1 and 1", delivered, events)
+			}
+		case result.ErrorInfo().Kind == domerr.ValidationError:
+			report.ValidationErrors++
+			if expect != expectValidation {
+				violate(step, "name %q rejected as invalid: %s", name, result.ErrorInfo().Message)
+			}
+		default:
+			report.InfraErrors++
+			if expect == expectValidation {
+				violate(step, "invalid name %q failed with %s", name, result.ErrorInfo().Error())
+			}
+		}
+		if result.IsError() && (delivered != 0 || events != 0) {
+			violate(step, "error with %d deliveries and %d events, want none", delivered, events)
+		}
+	}
+
+	report.Writes = h.Writer.calls
+	h.checkHistory(func(format string, args ...any) { violate(h.cfg.Steps, format, args...) })
+	return report
+}
+
+// publish announces a successful greeting on the bus.
+func (h *Harness) publish(name string) {
+	delivered := h.Writer.delivered
+	record := model.NewGreetingRecordBuilder().
+		Name(name).
+		Text(delivered[len(delivered)-1]).
+		Timestamp(h.Clock.Now()).
+		CorrelationID(fmt.Sprintf("sim-%d-%d", h.cfg.Seed, len(delivered))).
+		Build()
+	if record.IsOk() {
+		h.Bus.Publish(record.Value())
+	}
+}
+
+// checkHistory verifies the whole-run invariants.
+func (h *Harness) checkHistory(violate func(format string, args ...any)) {
+	delivered := h.Writer.delivered
+	if len(delivered) != len(h.History) {
+		violate("%d deliveries but %d events", len(delivered), len(h.History))
+		return
+	}
+	for i, record := range h.History {
+		if record.Text != delivered[i] {
+			violate("event %d text %q does not match delivery %q", i, record.Text, delivered[i])
+		}
+		if i > 0 && record.Timestamp.Before(h.History[i-1].Timestamp) {
+			violate("event %d timestamp goes backwards", i)
+		}
+	}
+}
+
+// nextName generates a command name and the outcome it should produce.
+func (h *Harness) nextName() (string, expectation) {
+	switch n := h.rng.Intn(10); {
+	case n == 0:
+		return "", expectValidation
+	case n == 1:
+		return strings.Repeat("x", valueobject.MaxNameLength+1+h.rng.Intn(20)), expectValidation
+	case n == 2:
+		return strings.Repeat("y", valueobject.MaxNameLength), expectOk
+	case n == 3:
+		return "Zoë 李", expectOk
+	default:
+		letters := "abcdefghijklmnopqrstuvwxyz ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		b := make([]byte, 1+h.rng.Intn(30))
+		for i := range b {
+			b[i] = letters[h.rng.Intn(len(letters))]
+		}
+		return string(b), expectOk
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/test/simulation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulation_InvariantsHoldAcrossSeeds(t *testing.T) {
+	seeds := 100
+	if testing.Short() {
+		seeds = 10
+	}
+	for seed := int64(1); seed <= int64(seeds); seed++ {
+		report := simulation.New(simulation.Config{
+			Seed:             seed,
+			Steps:            500,
+			WriteFailureRate: 0.3,
+			CancelRate:       0.05,
+		}).Run()
+
+		if !assert.Empty(t, report.Violations, "seed %d", seed) {
+			return
+		}
+		assert.Equal(t, 500, report.Ok+report.ValidationErrors+report.InfraErrors)
+	}
+}
+
+func TestSimulation_ExercisesEveryOutcome(t *testing.T) {
+	report := simulation.New(simulation.Config{Seed: 7, Steps: 2000, WriteFailureRate: 0.5, CancelRate: 0.05}).Run()
+
+	assert.Empty(t, report.Violations)
+	assert.Positive(t, report.Ok)
+	assert.Positive(t, report.ValidationErrors)
+	assert.Positive(t, report.InfraErrors)
+	assert.Greater(t, report.Writes, report.Ok, "retries should cause extra writes")
+}
+
+func TestSimulation_IsDeterministic(t *testing.T) {
+	cfg := simulation.Config{Seed: 99, Steps: 1000, WriteFailureRate: 0.2, CancelRate: 0.1}
+
+	first := simulation.New(cfg).Run()
+	second := simulation.New(cfg).Run()
+
+	assert.Equal(t, first, second)
+}
+
+func TestSimulation_NoFailuresMeansEveryValidCommandDelivers(t *testing.T) {
+	h := simulation.New(simulation.Config{Seed: 3, Steps: 300})
+	report := h.Run()
+
+	assert.Empty(t, report.Violations)
+	assert.Zero(t, report.InfraErrors)
+	assert.Len(t, h.Writer.Delivered(), report.Ok)
+	assert.Len(t, h.History, report.Ok)
+}
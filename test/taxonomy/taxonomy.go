@@ -0,0 +1,556 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: taxonomy
+// Description: Failure taxonomy report from error construction call sites
+
+// Package taxonomy scans the library source (with go/ast, no type
+// checking) for every place an error is constructed and reports which
+// error kinds the library can produce, cross-referenced with:
+//
+//   - the registry: the ErrorKind constants in domain/error and the cases
+//     ErrorKind.String names
+//   - the mappings: every switch on an ErrorKind and every map literal
+//     keyed by ErrorKind (e.g. the cexport C return codes). A switch is on
+//     an ErrorKind if its tag is a Kind field (err.Kind), a parameter or
+//     variable declared as ErrorKind, or if a case names a declared
+//     ErrorKind constant
+//
+// Findings flag kinds that are produced but unregistered, produced but
+// not handled by a mapping (no case and no default), and call sites whose
+// kind cannot be determined statically.
+//
+// Recognized call sites: calls of NewXError / NewXErrorTrace constructors
+// (from domain/error, application/error or the api facade) and ErrorType
+// composite literals. The constructors' own bodies are not call sites, nor
+// are literals copying the kind of an existing error (Kind: err.Kind):
+// they re-wrap a kind already counted where that error was constructed.
+//
+// Usage:
+//
+//	report, err := taxonomy.Scan("..")
+//	report.Write(os.Stdout)
+//	if len(report.Findings) > 0 { ... }
+package taxonomy
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// kindTypeName is the error kind type the registry is read from.
+const kindTypeName = "ErrorKind"
+
+// constructorPattern matches error constructors; group 1 is the kind.
+var constructorPattern = regexp.MustCompile(`^New([A-Z][A-Za-z]*Error)(Trace)?$`)
+
+// skipDirs are not library source.
+var skipDirs = map[string]bool{
+	"test": true, "testdata": true, "vendor": true, "backup": true,
+	"docs": true, "scripts": true, "tools": true,
+}
+
+// Site is one place an error is constructed.
+type Site struct {
+	Kind    string // "" if not statically known
+	Pos     string // path:line, relative to the scan root
+	Package string // directory, relative to the scan root
+	Message string // literal message or format; "" if computed
+}
+
+// Mapping is a switch or map literal translating kinds to something else.
+type Mapping struct {
+	Name       string // enclosing function, or path:line for top-level maps
+	Pos        string
+	Cases      []string // kinds handled explicitly
+	HasDefault bool
+}
+
+// Handles reports whether m gives kind a result.
+func (m Mapping) Handles(kind string) bool {
+	return m.HasDefault || slices.Contains(m.Cases, kind)
+}
+
+// Report is the result of a Scan.
+type Report struct {
+	Kinds      []string // declared ErrorKind constants, in declaration order
+	Registered []string // kinds ErrorKind.String names
+	Sites      []Site
+	Mappings   []Mapping
+	Findings   []string
+}
+
+// Scan parses every non-test Go file under root and builds the report.
+func Scan(root string) (*Report, error) {
+	r := &Report{}
+	fset := token.NewFileSet()
+	var files []sourceFile
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != root && (skipDirs[name] || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, sourceFile{rel: filepath.ToSlash(rel), file: file})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("taxonomy: %w", err)
+	}
+
+	// The registry is read first: recognizing a switch by its cases needs
+	// every declared kind, wherever the file declaring them sorts.
+	for _, f := range files {
+		r.scanRegistry(f.file)
+	}
+	if len(r.Kinds) == 0 {
+		return nil, fmt.Errorf("taxonomy: no %s constants found under %s", kindTypeName, root)
+	}
+	for _, f := range files {
+		r.scanFile(fset, f.rel, f.file)
+	}
+
+	r.Findings = r.check()
+	return r, nil
+}
+
+// sourceFile is a parsed file and its path relative to the scan root.
+type sourceFile struct {
+	rel  string
+	file *ast.File
+}
+
+// scanRegistry collects the declared kinds and the kinds ErrorKind.String
+// names from file.
+func (r *Report) scanRegistry(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			r.Kinds = append(r.Kinds, kindConstants(decl)...)
+		case *ast.FuncDecl:
+			if isKindString(decl) {
+				r.Registered = append(r.Registered, caseKinds(decl.Body)...)
+			}
+		}
+	}
+}
+
+// scanFile collects call sites and mappings from file.
+func (r *Report) scanFile(fset *token.FileSet, rel string, file *ast.File) {
+	pkg := filepath.ToSlash(filepath.Dir(rel))
+	pos := func(n ast.Node) string {
+		return fmt.Sprintf("%s:%d", rel, fset.Position(n.Pos()).Line)
+	}
+
+	for _, decl := range file.Decls {
+		enclosing := ""
+		var kindVars []string
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			if isKindString(fn) || constructorPattern.MatchString(fn.Name.Name) {
+				continue // the registry and the constructors themselves
+			}
+			enclosing = funcName(pkg, fn)
+			kindVars = kindVariables(fn)
+		}
+		ast.Inspect(decl, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CallExpr:
+				if kind, ok := constructorKind(n.Fun); ok {
+					r.Sites = append(r.Sites, Site{Kind: kind, Pos: pos(n), Package: pkg, Message: message(n.Args)})
+				}
+			case *ast.CompositeLit:
+				if isNamed(n.Type, "ErrorType") {
+					if site, ok := literalSite(n, pos(n), pkg); ok {
+						r.Sites = append(r.Sites, site)
+					}
+				} else if m, ok := n.Type.(*ast.MapType); ok && isNamed(m.Key, kindTypeName) {
+					r.Mappings = append(r.Mappings, mapMapping(n, cmp.Or(enclosing, pos(n)), pos(n)))
+				}
+			case *ast.SwitchStmt:
+				if r.isKindSwitch(n, kindVars) {
+					r.Mappings = append(r.Mappings, switchMapping(n, enclosing, pos(n)))
+				}
+			}
+			return true
+		})
+	}
+}
+
+// isKindSwitch reports whether sw switches on an ErrorKind: its tag is a
+// Kind field or one of kindVars, or a case names a declared kind.
+func (r *Report) isKindSwitch(sw *ast.SwitchStmt, kindVars []string) bool {
+	switch tag := sw.Tag.(type) {
+	case nil:
+		return false
+	case *ast.SelectorExpr:
+		if tag.Sel.Name == "Kind" {
+			return true
+		}
+	case *ast.Ident:
+		if slices.Contains(kindVars, tag.Name) {
+			return true
+		}
+	}
+	for _, stmt := range sw.Body.List {
+		for _, e := range stmt.(*ast.CaseClause).List {
+			if slices.Contains(r.Kinds, lastName(e)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// check cross-references sites with the registry and mappings.
+func (r *Report) check() []string {
+	var findings []string
+	for _, kind := range r.Kinds {
+		if !slices.Contains(r.Registered, kind) {
+			findings = append(findings, fmt.Sprintf("kind %s is declared but ErrorKind.String does not name it", kind))
+		}
+	}
+	for _, kind := range r.Produced() {
+		if !slices.Contains(r.Kinds, kind) {
+			findings = append(findings, fmt.Sprintf("kind %s is produced but not a declared ErrorKind", kind))
+		}
+		for _, m := range r.Mappings {
+			if m.Handles(kind) {
+				continue
+			}
+			where := m.Name
+			if m.Name != m.Pos {
+				where += " (" + m.Pos + ")"
+			}
+			findings = append(findings, fmt.Sprintf("kind %s is produced but unmapped by %s", kind, where))
+		}
+	}
+	for _, s := range r.Sites {
+		if s.Kind == "" {
+			findings = append(findings, fmt.Sprintf("%s: error kind is not statically known", s.Pos))
+		}
+	}
+	return findings
+}
+
+// Produced returns the statically known kinds with at least one call
+// site, in declaration order followed by undeclared kinds.
+func (r *Report) Produced() []string {
+	var produced []string
+	for _, s := range r.Sites {
+		if s.Kind != "" && !slices.Contains(produced, s.Kind) {
+			produced = append(produced, s.Kind)
+		}
+	}
+	rank := func(kind string) int {
+		if i := slices.Index(r.Kinds, kind); i >= 0 {
+			return i
+		}
+		return len(r.Kinds)
+	}
+	slices.SortStableFunc(produced, func(a, b string) int { return rank(a) - rank(b) })
+	return produced
+}
+
+// Write renders the report as Markdown.
+func (r *Report) Write(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# Failure taxonomy\n\n## Kinds\n\n")
+	b.WriteString("| Kind | Registered | Call sites |")
+	for _, m := range r.Mappings {
+		fmt.Fprintf(&b, " %s |", m.Name)
+	}
+	b.WriteString("\n|---|---|---|")
+	b.WriteString(strings.Repeat("---|", len(r.Mappings)))
+	b.WriteString("\n")
+
+	kinds := slices.Clone(r.Kinds)
+	for _, kind := range r.Produced() {
+		if !slices.Contains(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	for _, kind := range kinds {
+		fmt.Fprintf(&b, "| %s | %s | %d |", kind, yesNo(slices.Contains(r.Registered, kind)), r.count(kind))
+		for _, m := range r.Mappings {
+			switch {
+			case slices.Contains(m.Cases, kind):
+				b.WriteString(" case |")
+			case m.HasDefault:
+				b.WriteString(" default |")
+			default:
+				b.WriteString(" **unmapped** |")
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n## Call sites\n")
+	for _, kind := range append(kinds, "") {
+		var lines []string
+		for _, s := range r.Sites {
+			if s.Kind == kind {
+				lines = append(lines, fmt.Sprintf("- %s `%s`", s.Pos, orDash(s.Message)))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n### %s\n\n%s\n", orUnknown(kind), strings.Join(lines, "\n"))
+	}
+
+	b.WriteString("\n## Findings\n\n")
+	if len(r.Findings) == 0 {
+		b.WriteString("None.\n")
+	}
+	for _, f := range r.Findings {
+		fmt.Fprintf(&b, "- %s\n", f)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (r *Report) count(kind string) int {
+	n := 0
+	for _, s := range r.Sites {
+		if s.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// ============================================================================
+// AST helpers
+// ============================================================================
+
+// kindConstants returns the names of constants of type ErrorKind in decl,
+// including untyped specs continuing an iota group.
+func kindConstants(decl *ast.GenDecl) []string {
+	if decl.Tok != token.CONST {
+		return nil
+	}
+	var names []string
+	inGroup := false
+	for _, spec := range decl.Specs {
+		vs := spec.(*ast.ValueSpec)
+		switch {
+		case vs.Type != nil:
+			inGroup = isNamed(vs.Type, kindTypeName)
+		case len(vs.Values) > 0:
+			inGroup = false
+		}
+		if inGroup {
+			for _, n := range vs.Names {
+				names = append(names, n.Name)
+			}
+		}
+	}
+	return names
+}
+
+// kindVariables returns the names of fn's parameters and local variables
+// declared with type ErrorKind.
+func kindVariables(fn *ast.FuncDecl) []string {
+	var names []string
+	add := func(typ ast.Expr, idents []*ast.Ident) {
+		if isNamed(typ, kindTypeName) {
+			for _, id := range idents {
+				names = append(names, id.Name)
+			}
+		}
+	}
+	for _, field := range fn.Type.Params.List {
+		add(field.Type, field.Names)
+	}
+	if fn.Body != nil {
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if vs, ok := n.(*ast.ValueSpec); ok {
+				add(vs.Type, vs.Names)
+			}
+			return true
+		})
+	}
+	return names
+}
+
+// isKindString reports whether fn is ErrorKind's String method.
+func isKindString(fn *ast.FuncDecl) bool {
+	return fn.Name.Name == "String" && fn.Recv != nil && len(fn.Recv.List) == 1 &&
+		isNamed(fn.Recv.List[0].Type, kindTypeName) && fn.Body != nil
+}
+
+// caseKinds returns the identifiers named by the case clauses in body.
+func caseKinds(body *ast.BlockStmt) []string {
+	var kinds []string
+	ast.Inspect(body, func(n ast.Node) bool {
+		if cc, ok := n.(*ast.CaseClause); ok {
+			for _, e := range cc.List {
+				if name := lastName(e); name != "" {
+					kinds = append(kinds, name)
+				}
+			}
+		}
+		return true
+	})
+	return kinds
+}
+
+// constructorKind returns the kind an error constructor call produces.
+func constructorKind(fun ast.Expr) (string, bool) {
+	m := constructorPattern.FindStringSubmatch(lastName(fun))
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+// literalSite describes an ErrorType composite literal; ok is false if the
+// literal passes through another error's kind.
+func literalSite(lit *ast.CompositeLit, pos, pkg string) (site Site, ok bool) {
+	site = Site{Pos: pos, Package: pkg}
+	for _, elt := range lit.Elts {
+		kv, isKV := elt.(*ast.KeyValueExpr)
+		if !isKV {
+			continue
+		}
+		switch lastName(kv.Key) {
+		case "Kind":
+			if sel, isSel := kv.Value.(*ast.SelectorExpr); isSel && sel.Sel.Name == "Kind" {
+				return Site{}, false
+			}
+			if name := lastName(kv.Value); strings.HasSuffix(name, "Error") {
+				site.Kind = name
+			}
+		case "Message":
+			site.Message = message([]ast.Expr{kv.Value})
+		}
+	}
+	return site, true
+}
+
+// switchMapping describes a switch on an ErrorKind.
+func switchMapping(sw *ast.SwitchStmt, name, pos string) Mapping {
+	m := Mapping{Name: name, Pos: pos}
+	for _, stmt := range sw.Body.List {
+		cc := stmt.(*ast.CaseClause)
+		if cc.List == nil {
+			m.HasDefault = true
+		}
+		for _, e := range cc.List {
+			if kind := lastName(e); kind != "" {
+				m.Cases = append(m.Cases, kind)
+			}
+		}
+	}
+	return m
+}
+
+// mapMapping describes a map literal keyed by ErrorKind.
+func mapMapping(lit *ast.CompositeLit, name, pos string) Mapping {
+	m := Mapping{Name: name, Pos: pos}
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if kind := lastName(kv.Key); kind != "" {
+				m.Cases = append(m.Cases, kind)
+			}
+		}
+	}
+	return m
+}
+
+// message returns the literal message (or format) of a constructor call.
+func message(args []ast.Expr) string {
+	if len(args) == 0 {
+		return ""
+	}
+	switch arg := args[0].(type) {
+	case *ast.BasicLit:
+		if s, err := strconv.Unquote(arg.Value); err == nil {
+			return s
+		}
+	case *ast.CallExpr:
+		if lastName(arg.Fun) == "Sprintf" {
+			return message(arg.Args)
+		}
+	}
+	return ""
+}
+
+// funcName names fn as pkg.Func or pkg.Recv.Method.
+func funcName(pkg string, fn *ast.FuncDecl) string {
+	if fn.Recv != nil && len(fn.Recv.List) == 1 {
+		return fmt.Sprintf("%s.%s.%s", pkg, lastName(fn.Recv.List[0].Type), fn.Name.Name)
+	}
+	return pkg + "." + fn.Name.Name
+}
+
+// isNamed reports whether e names the type name, qualified or not.
+func isNamed(e ast.Expr, name string) bool {
+	return e != nil && lastName(e) == name
+}
+
+// lastName returns the final identifier of an identifier, selector,
+// pointer or generic instantiation expression; "" otherwise.
+func lastName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.StarExpr:
+		return lastName(e.X)
+	case *ast.IndexExpr:
+		return lastName(e.X)
+	case *ast.IndexListExpr:
+		return lastName(e.X)
+	}
+	return ""
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "**no**"
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func orUnknown(kind string) string {
+	if kind == "" {
+		return "Unknown kind"
+	}
+	return kind
+}
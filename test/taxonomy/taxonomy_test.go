@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package taxonomy_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_lib_go/test/taxonomy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTree writes files (path -> source) under a temporary root.
+func writeTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for path, src := range files {
+		full := filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o750))
+		require.NoError(t, os.WriteFile(full, []byte(src), 0o600))
+	}
+	return root
+}
+
+const registry = `package error
+
+type ErrorKind int
+
+const (
+	ValidationError ErrorKind = iota
+	InfrastructureError
+	OverloadedError
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ValidationError:
+		return "ValidationError"
+	case InfrastructureError:
+		return "InfrastructureError"
+	}
+	return "UnknownError"
+}
+
+type ErrorType struct {
+	Kind    ErrorKind
+	Message string
+}
+
+func NewValidationError(message string) ErrorType {
+	return ErrorType{Kind: ValidationError, Message: message}
+}
+`
+
+func TestScan_CrossReferencesSitesRegistryAndMappings(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"domain/error/error.go": registry,
+		"app/app.go": `package app
+
+import "fmt"
+
+func check(name string) error {
+	if name == "" {
+		return domerr.NewValidationError("name is empty")
+	}
+	if len(name) > 9 {
+		return apperr.NewValidationError(fmt.Sprintf("name %q is too long", name))
+	}
+	return apperr.NewInfrastructureErrorTrace(name)
+}
+
+func wrap(e domerr.ErrorType) domerr.ErrorType {
+	return domerr.ErrorType{Kind: e.Kind, Message: "wrapped"}
+}
+
+func lookup(code int) domerr.ErrorType {
+	return domerr.ErrorType{Kind: kinds[code], Message: "looked up"}
+}
+
+func timeout() error { return domerr.NewTimeoutError("slow") }
+`,
+		"app/app_test.go": `package app
+
+func helper() { domerr.NewOverloadedError("ignored in tests") }
+`,
+		"cexport/codes.go": `package cexport
+
+func code(r api.Result[api.Unit]) int {
+	switch r.ErrorInfo().Kind {
+	case api.ValidationError:
+		return 1
+	case api.OverloadedError:
+		return 3
+	}
+	return 0
+}
+
+var levels = map[domerr.ErrorKind]string{domerr.InfrastructureError: "error"}
+`,
+	})
+
+	report, err := taxonomy.Scan(root)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ValidationError", "InfrastructureError", "OverloadedError"}, report.Kinds)
+	assert.Equal(t, []string{"ValidationError", "InfrastructureError"}, report.Registered)
+	assert.Equal(t, []string{"ValidationError", "InfrastructureError", "TimeoutError"}, report.Produced())
+
+	require.Len(t, report.Sites, 5, "constructor bodies, test files and pass-through literals are not call sites")
+	assert.Equal(t, taxonomy.Site{Kind: "ValidationError", Pos: "app/app.go:7", Package: "app", Message: "name is empty"}, report.Sites[0])
+	assert.Equal(t, "name %q is too long", report.Sites[1].Message)
+	assert.Equal(t, "", report.Sites[3].Kind, "kind computed at run time")
+	assert.Equal(t, "app/app.go:20", report.Sites[3].Pos)
+
+	require.Len(t, report.Mappings, 2)
+	assert.Equal(t, "cexport.code", report.Mappings[0].Name)
+	assert.Equal(t, []string{"ValidationError", "OverloadedError"}, report.Mappings[0].Cases)
+	assert.False(t, report.Mappings[0].HasDefault)
+	assert.Equal(t, "cexport/codes.go:13", report.Mappings[1].Name, "top-level map is named by position")
+
+	assert.Equal(t, []string{
+		"kind OverloadedError is declared but ErrorKind.String does not name it",
+		"kind ValidationError is produced but unmapped by cexport/codes.go:13",
+		"kind InfrastructureError is produced but unmapped by cexport.code (cexport/codes.go:4)",
+		"kind TimeoutError is produced but not a declared ErrorKind",
+		"kind TimeoutError is produced but unmapped by cexport.code (cexport/codes.go:4)",
+		"kind TimeoutError is produced but unmapped by cexport/codes.go:13",
+		"app/app.go:20: error kind is not statically known",
+	}, report.Findings)
+}
+
+func TestScan_RecognizesSwitchesOnKindValues(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"adapter/severity.go": `package adapter
+
+func severity(kind domerr.ErrorKind) string {
+	switch kind {
+	case domerr.ValidationError:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+func level(err error) int {
+	var k domerr.ErrorKind = kindOf(err)
+	switch k {
+	case domerr.InfrastructureError:
+		return 3
+	}
+	return 0
+}
+
+func code(err error) int {
+	switch kindOf(err) {
+	case domerr.OverloadedError:
+		return 2
+	}
+	return 0
+}
+
+func color(name string) int {
+	switch name {
+	case "red":
+		return 1
+	}
+	return 0
+}
+`,
+		// Sorts after adapter/: kinds are known before any switch is read.
+		"domain/error/error.go": registry,
+	})
+
+	report, err := taxonomy.Scan(root)
+	require.NoError(t, err)
+
+	require.Len(t, report.Mappings, 3, "a switch on a string is not a mapping")
+	assert.Equal(t, "adapter.severity", report.Mappings[0].Name, "tagged by an ErrorKind parameter")
+	assert.Equal(t, []string{"ValidationError"}, report.Mappings[0].Cases)
+	assert.True(t, report.Mappings[0].HasDefault)
+	assert.Equal(t, "adapter.level", report.Mappings[1].Name, "tagged by an ErrorKind variable")
+	assert.Equal(t, "adapter.code", report.Mappings[2].Name, "recognized by its cases")
+	assert.Equal(t, []string{"OverloadedError"}, report.Mappings[2].Cases)
+}
+
+func TestScan_FailsWithoutRegistry(t *testing.T) {
+	root := writeTree(t, map[string]string{"app/app.go": "package app\n"})
+
+	_, err := taxonomy.Scan(root)
+
+	assert.ErrorContains(t, err, "no ErrorKind constants")
+}
+
+func TestReport_Write_RendersTableSitesAndFindings(t *testing.T) {
+	report, err := taxonomy.Scan("../..")
+	require.NoError(t, err)
+
+	var out strings.Builder
+	require.NoError(t, report.Write(&out))
+	md := out.String()
+
+	assert.Contains(t, md, "| Kind | Registered | Call sites | api/adapter/desktop/cexport.errorCode |")
+	assert.Contains(t, md, " api/adapter/wasm.main |")
+	assert.Contains(t, md, " infrastructure/adapter.SeverityForKind |")
+	assert.Contains(t, md, "| ValidationError | yes |")
+	assert.Regexp(t, `\| InfrastructureError \| yes \| \d+ \| default \|`, md)
+	assert.Contains(t, md, "### StaleVersionError")
+	assert.Contains(t, md, "## Findings")
+	assert.Empty(t, report.Findings, "make error-taxonomy STRICT=1 must pass on the tree")
+	for _, kind := range []string{"ValidationError", "InfrastructureError", "OverloadedError", "StaleVersionError"} {
+		assert.Contains(t, report.Registered, kind)
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: version
+// Description: Build information (link-time values with debug/buildinfo fallback)
+
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// modulePath is the module this package belongs to; its entry in the build
+// info carries the library version when it is built as a dependency.
+const modulePath = "github.com/abitofhelp/hybrid_lib_go"
+
+// Link-time values. Release builds set them with -ldflags, e.g.
+//
+//	go build -ldflags "\
+//	  -X github.com/abitofhelp/hybrid_lib_go/version.LinkedVersion=1.2.3 \
+//	  -X github.com/abitofhelp/hybrid_lib_go/version.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/abitofhelp/hybrid_lib_go/version.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Any left empty is filled from the binary's embedded build info (see Get).
+var (
+	// LinkedVersion overrides Version (e.g. "1.2.3-rc.1" from a release tag).
+	LinkedVersion string
+	// Commit is the VCS revision the binary was built from.
+	Commit string
+	// BuildDate is the build time, preferably RFC 3339 in UTC.
+	BuildDate string
+)
+
+// Info describes the running build.
+type Info struct {
+	Version   string // semantic version, without a leading "v"
+	Commit    string // VCS revision, "" if unknown
+	BuildDate string // build or commit time, "" if unknown
+	Modified  bool   // built from a working tree with uncommitted changes
+	GoVersion string // toolchain that built the binary, e.g. "go1.23.4"
+}
+
+// Get returns the build information of the running binary.
+//
+// Precedence per field: the link-time variable, then debug.ReadBuildInfo
+// (the module version when built as a dependency; vcs.revision, vcs.time
+// and vcs.modified when built inside a checkout), then the generated
+// Version constant. BuildDate falls back to the commit time, as Go does
+// not record the build time itself.
+func Get() Info {
+	info := Info{
+		Version:   LinkedVersion,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.fill(bi)
+	}
+	if info.Version == "" {
+		info.Version = Version
+	}
+	info.Version = strings.TrimPrefix(info.Version, "v")
+	return info
+}
+
+// fill completes the fields that the link-time values left empty.
+func (i *Info) fill(bi *debug.BuildInfo) {
+	if bi.GoVersion != "" {
+		i.GoVersion = bi.GoVersion
+	}
+	if i.Version == "" {
+		i.Version = moduleVersion(bi)
+	}
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			if i.Commit == "" {
+				i.Commit = s.Value
+			}
+		case "vcs.time":
+			if i.BuildDate == "" {
+				i.BuildDate = s.Value
+			}
+		case "vcs.modified":
+			i.Modified = s.Value == "true"
+		}
+	}
+}
+
+// moduleVersion returns this module's version from bi, or "" for a
+// development build ("(devel)", a local replace) or when the module is not
+// listed.
+func moduleVersion(bi *debug.BuildInfo) string {
+	mod := &bi.Main
+	if mod.Path != modulePath {
+		mod = nil
+		for _, dep := range bi.Deps {
+			if dep.Path == modulePath {
+				mod = dep
+				break
+			}
+		}
+	}
+	if mod == nil {
+		return ""
+	}
+	if mod.Replace != nil {
+		mod = mod.Replace
+	}
+	switch mod.Version {
+	case "", "(devel)", "v0.0.0": // local checkout or replace directive
+		return ""
+	}
+	return mod.Version
+}
+
+// ShortCommit returns the first 12 characters of Commit.
+func (i Info) ShortCommit() string {
+	if len(i.Commit) > 12 {
+		return i.Commit[:12]
+	}
+	return i.Commit
+}
+
+// Release returns the release identifier used by error trackers,
+// "hybrid_lib_go@<version>".
+func (i Info) Release() string {
+	return "hybrid_lib_go@" + i.Version
+}
+
+// String formats the information for a --version flag, e.g.
+// "hybrid_lib_go 1.2.3 (commit 0123456789ab, built 2025-01-02T03:04:05Z, go1.23.4)".
+func (i Info) String() string {
+	details := make([]string, 0, 3)
+	if i.Commit != "" {
+		commit := "commit " + i.ShortCommit()
+		if i.Modified {
+			commit += "-dirty"
+		}
+		details = append(details, commit)
+	}
+	if i.BuildDate != "" {
+		details = append(details, "built "+i.BuildDate)
+	}
+	details = append(details, i.GoVersion)
+	return fmt.Sprintf("hybrid_lib_go %s (%s)", i.Version, strings.Join(details, ", "))
+}